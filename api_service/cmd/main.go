@@ -3,23 +3,30 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"expvar"
+	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"strings"
 
 	"github.com/bagdasarian/checklist-app/api_service/config"
+	"github.com/bagdasarian/checklist-app/api_service/internal/caldav"
 	"github.com/bagdasarian/checklist-app/api_service/internal/client"
 	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
 	"github.com/bagdasarian/checklist-app/api_service/internal/producer"
 	"github.com/bagdasarian/checklist-app/api_service/internal/server"
 	"github.com/bagdasarian/checklist-app/api_service/internal/service"
+	"github.com/bagdasarian/checklist-app/api_service/internal/spool"
+	"github.com/bagdasarian/checklist-app/api_service/internal/watch"
 	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 // customHTTPErrorHandler преобразует gRPC ошибку в http method not allowed 405, а не internal server error 501
@@ -38,6 +45,11 @@ func customHTTPErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshale
 		}
 	}
 
+	// Несовпадение версии при условном запросе с If-Match - 412, а не 409
+	if s.Code() == codes.Aborted && r.Header.Get("If-Match") != "" {
+		httpStatus = http.StatusPreconditionFailed
+	}
+
 	w.WriteHeader(httpStatus)
 
 	body := map[string]interface{}{
@@ -55,6 +67,76 @@ func customHTTPErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshale
 	w.Write(buf)
 }
 
+// incomingHeaderMatcher дополнительно пробрасывает в gRPC заголовки Idempotency-Key,
+// X-Request-Id и traceparent
+func incomingHeaderMatcher(key string) (string, bool) {
+	for _, header := range []string{middleware.IdempotencyKeyHeader, server.RequestIDHeader, server.TraceparentHeader} {
+		if strings.EqualFold(key, header) {
+			return header, true
+		}
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher отдает заголовок Content-Disposition выгрузки как есть,
+// остальные метаданные - с префиксом Grpc-Metadata-
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, server.ContentDispositionHeader) {
+		return "Content-Disposition", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// setETagHeader выставляет заголовок ETag для ответов, содержащих версию задачи
+func setETagHeader(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
+	if version := server.ResponseVersion(resp); version > 0 {
+		w.Header().Set("ETag", server.FormatETag(version))
+	}
+	return nil
+}
+
+// brokerPublisher - отправитель событий в брокер, которого можно обернуть спулом
+type brokerPublisher interface {
+	producer.EventPublisher
+	producer.Broker
+}
+
+// newEventPublisher создает отправителя событий для брокера cfg.Events.Backend
+func newEventPublisher(ctx context.Context, cfg *config.Config, redisClient *redis.Client) (producer.EventPublisher, error) {
+	var publisher brokerPublisher
+	switch cfg.Events.Backend {
+	case producer.BackendKafka:
+		publisher = producer.NewProducer(cfg.GetKafkaBrokers(), cfg.Kafka.Topic)
+	case producer.BackendRedis:
+		publisher = producer.NewRedisPublisher(redisClient, cfg.Kafka.Topic, cfg.Events.StreamMaxLen)
+	case producer.BackendNATS:
+		natsPublisher, err := producer.NewNATSPublisher(ctx, cfg.NATS.URL, cfg.NATS.Stream, cfg.Kafka.Topic)
+		if err != nil {
+			return nil, err
+		}
+		publisher = natsPublisher
+	default:
+		return nil, fmt.Errorf("unknown event backend %q", cfg.Events.Backend)
+	}
+
+	if !cfg.Spool.Enabled {
+		return publisher, nil
+	}
+
+	eventSpool, err := spool.Open(cfg.Spool.Dir, cfg.GetSpoolMaxBytes(), cfg.GetSpoolSegmentBytes(), spool.Policy(cfg.Spool.FullPolicy))
+	if err != nil {
+		publisher.Close()
+		return nil, fmt.Errorf("failed to open event spool: %w", err)
+	}
+	spooling := producer.NewSpoolingPublisher(publisher, cfg.Events.Backend, eventSpool)
+	go spooling.Run(ctx)
+
+	// Глубина спула и число отброшенных событий - в /debug/vars
+	expvar.Publish("event_spool", expvar.Func(func() any { return spooling.Stats() }))
+	log.Printf("Event spool enabled at %s: %d events pending", cfg.Spool.Dir, eventSpool.Len())
+	return spooling, nil
+}
+
 func main() {
 	cfg, err := config.Load()
 	if err != nil {
@@ -71,21 +153,59 @@ func main() {
 
 	jwtManager := service.NewJWTManager(cfg.JWT.SecretKey, cfg.GetTokenDuration())
 
-	var kafkaProducer *producer.Producer
+	authInterceptor := middleware.NewAuthInterceptor(jwtManager)
+	unaryInterceptors := []grpc.UnaryServerInterceptor{authInterceptor.Unary(), server.EventMetaInterceptor()}
+
+	// Redis нужен для ключей идемпотентности, токенов синхронизации CalDAV и событий в Redis Streams
+	var redisClient *redis.Client
+	if cfg.Idempotency.Enabled || cfg.CalDAV.Enabled || (cfg.Kafka.Enabled && cfg.Events.Backend == producer.BackendRedis) {
+		redisClient, err = client.NewRedis(ctx, cfg.GetRedisAddr(), cfg.Redis.Password, cfg.Redis.DB)
+		if err != nil {
+			log.Fatalf("Failed to connect to Redis: %v", err)
+		}
+		defer redisClient.Close()
+	}
+
+	// Хаб WatchTasks читает топик Kafka напрямую, поэтому с другим брокером обновления в реальном времени не работают
+	if cfg.Kafka.Enabled && cfg.Stream.Enabled && cfg.Events.Backend != producer.BackendKafka {
+		log.Fatalf("WatchTasks and the WebSocket/SSE bridge require the %s event backend, got %q: set STREAM_ENABLED=false to use it",
+			producer.BackendKafka, cfg.Events.Backend)
+	}
+
+	var eventPublisher producer.EventPublisher
+	var watchHub *watch.Hub
 	if cfg.Kafka.Enabled {
-		kafkaProducer = producer.NewProducer(cfg.GetKafkaBrokers(), cfg.Kafka.Topic)
-		defer kafkaProducer.Close()
-		log.Printf("Kafka producer initialized for topic: %s", cfg.Kafka.Topic)
+		eventPublisher, err = newEventPublisher(ctx, cfg, redisClient)
+		if err != nil {
+			log.Fatalf("Failed to create event publisher: %v", err)
+		}
+		defer eventPublisher.Close()
+		log.Printf("Event publisher initialized: %s, topic %s", cfg.Events.Backend, cfg.Kafka.Topic)
+
+		// Хаб WatchTasks читает тот же топик Kafka, в который пишут producer и outbox db_service
+		if cfg.Stream.Enabled {
+			watchHub = watch.NewHub(cfg.GetKafkaBrokers(), cfg.Kafka.Topic)
+			go watchHub.Run(ctx)
+		} else {
+			log.Println("WatchTasks disabled")
+		}
 	} else {
-		log.Println("Kafka producer disabled")
+		log.Println("Event publisher disabled")
+	}
+
+	var idempotencyInterceptor *middleware.IdempotencyInterceptor
+	if cfg.Idempotency.Enabled {
+		idempotencyInterceptor = middleware.NewIdempotencyInterceptor(redisClient, cfg.GetIdempotencyTTL())
+		unaryInterceptors = append(unaryInterceptors, idempotencyInterceptor.Unary())
+		log.Printf("Idempotency keys enabled, responses are kept for %v", cfg.GetIdempotencyTTL())
 	}
 
-	authInterceptor := middleware.NewAuthInterceptor(jwtManager)
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(authInterceptor.Unary()),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(authInterceptor.Stream()),
 	)
 
-	taskService := server.NewTaskService(dbClient, jwtManager, kafkaProducer)
+	taskService := server.NewTaskService(dbClient, jwtManager, eventPublisher, watchHub, idempotencyInterceptor)
 	pb.RegisterTaskServiceServer(grpcServer, taskService)
 
 	grpcListener, err := net.Listen("tcp", ":"+cfg.GRPC.Port)
@@ -102,6 +222,9 @@ func main() {
 
 	mux := runtime.NewServeMux(
 		runtime.WithErrorHandler(customHTTPErrorHandler),
+		runtime.WithForwardResponseOption(setETagHeader),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
 	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	err = pb.RegisterTaskServiceHandlerFromEndpoint(ctx, mux, ":"+cfg.GRPC.Port, opts)
@@ -109,8 +232,49 @@ func main() {
 		log.Fatalf("Failed to register gateway: %v", err)
 	}
 
+	err = mux.HandlePath(http.MethodPost, "/v1/import", taskService.ImportUploadHandler(mux, authInterceptor))
+	if err != nil {
+		log.Fatalf("Failed to register import handler: %v", err)
+	}
+
+	streamBridge := taskService.StreamBridge(authInterceptor, cfg.Stream.MaxConnectionsPerUser, cfg.GetStreamHeartbeatInterval())
+	err = mux.HandlePath(http.MethodGet, "/v1/stream/ws", streamBridge.WebSocketHandler(mux))
+	if err != nil {
+		log.Fatalf("Failed to register WebSocket handler: %v", err)
+	}
+	err = mux.HandlePath(http.MethodGet, "/v1/stream/sse", streamBridge.SSEHandler(mux))
+	if err != nil {
+		log.Fatalf("Failed to register SSE handler: %v", err)
+	}
+
+	// CalDAV обслуживается отдельно от gRPC Gateway: методы PROPFIND и REPORT в нем не маршрутизируются
+	httpHandler := http.NewServeMux()
+	httpHandler.Handle("/", mux)
+	if cfg.CalDAV.Enabled {
+		syncStore := caldav.NewSyncStore(redisClient, cfg.GetCalDAVSyncTokenTTL())
+		var authCache *caldav.AuthCache
+		if ttl := cfg.GetCalDAVAuthCacheTTL(); ttl > 0 {
+			authCache = caldav.NewAuthCache(ttl)
+		}
+		httpHandler.Handle(caldav.Prefix, taskService.CalDAVHandler(authInterceptor, syncStore, authCache))
+		httpHandler.Handle("/.well-known/caldav", http.RedirectHandler(caldav.Prefix, http.StatusMovedPermanently))
+		log.Printf("CalDAV enabled at %s", caldav.Prefix)
+	}
+
+	// Метрики не требуют авторизации, поэтому отдаются не через публичный порт Gateway
+	if cfg.Admin.Addr != "" {
+		adminHandler := http.NewServeMux()
+		adminHandler.Handle("/debug/vars", expvar.Handler())
+		go func() {
+			log.Printf("Starting admin HTTP server on %s", cfg.Admin.Addr)
+			if err := http.ListenAndServe(cfg.Admin.Addr, adminHandler); err != nil {
+				log.Fatalf("Failed to serve admin HTTP: %v", err)
+			}
+		}()
+	}
+
 	log.Printf("Starting HTTP Gateway server on port %s", cfg.HTTP.Port)
-	if err := http.ListenAndServe(":"+cfg.HTTP.Port, mux); err != nil {
+	if err := http.ListenAndServe(":"+cfg.HTTP.Port, httpHandler); err != nil {
 		log.Fatalf("Failed to serve HTTP: %v", err)
 	}
 }
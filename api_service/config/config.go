@@ -26,11 +26,63 @@ type Config struct {
 		Port string `yaml:"port" env:"HTTP_PORT" env-default:"8080"`
 	} `yaml:"http"`
 
+	// Служебный HTTP-сервер с /debug/vars. По умолчанию слушает только localhost; пустой адрес отключает его
+	Admin struct {
+		Addr string `yaml:"addr" env:"ADMIN_ADDR" env-default:"127.0.0.1:6060"`
+	} `yaml:"admin"`
+
+	Redis struct {
+		Host     string `yaml:"host" env:"REDIS_HOST" env-default:"localhost"`
+		Port     string `yaml:"port" env:"REDIS_PORT" env-default:"6379"`
+		Password string `yaml:"password" env:"REDIS_PASSWORD" env-default:""`
+		DB       int    `yaml:"db" env:"REDIS_DB" env-default:"0"`
+	} `yaml:"redis"`
+
+	Idempotency struct {
+		Enabled bool `yaml:"enabled" env:"IDEMPOTENCY_ENABLED" env-default:"true"`
+		TTL     int  `yaml:"ttl" env:"IDEMPOTENCY_TTL" env-default:"86400"` // в секундах
+	} `yaml:"idempotency"`
+
+	CalDAV struct {
+		Enabled      bool `yaml:"enabled" env:"CALDAV_ENABLED" env-default:"true"`
+		SyncTokenTTL int  `yaml:"sync_token_ttl" env:"CALDAV_SYNC_TOKEN_TTL" env-default:"2592000"` // в секундах
+		AuthCacheTTL int  `yaml:"auth_cache_ttl" env:"CALDAV_AUTH_CACHE_TTL" env-default:"60"`      // в секундах, 0 - без кэша
+	} `yaml:"caldav"`
+
+	// WatchTasks и мосты WebSocket/SSE; работают только с брокером kafka
+	Stream struct {
+		Enabled               bool `yaml:"enabled" env:"STREAM_ENABLED" env-default:"true"`
+		MaxConnectionsPerUser int  `yaml:"max_connections_per_user" env:"STREAM_MAX_CONNECTIONS_PER_USER" env-default:"5"`
+		HeartbeatInterval     int  `yaml:"heartbeat_interval" env:"STREAM_HEARTBEAT_INTERVAL" env-default:"25"` // в секундах
+	} `yaml:"stream"`
+
 	Kafka struct {
 		Brokers []string `yaml:"brokers" env:"KAFKA_BROKERS" env-default:"localhost:9092"`
 		Topic   string   `yaml:"topic" env:"KAFKA_TOPIC" env-default:"task-events"`
-		Enabled bool     `yaml:"enabled" env:"KAFKA_ENABLED" env-default:"true"`
+		Enabled bool     `yaml:"enabled" env:"KAFKA_ENABLED" env-default:"true"` // отправка событий в брокер Events.Backend
 	} `yaml:"kafka"`
+
+	// Брокер событий задач: kafka, redis (Redis Streams) или nats (NATS JetStream).
+	// Поток Redis и subject NATS называются как топик Kafka.
+	Events struct {
+		Backend      string `yaml:"backend" env:"EVENT_BACKEND" env-default:"kafka"`
+		StreamMaxLen int64  `yaml:"stream_max_len" env:"REDIS_STREAM_MAX_LEN" env-default:"1000000"` // приблизительная длина потока Redis
+	} `yaml:"events"`
+
+	NATS struct {
+		URL    string `yaml:"url" env:"NATS_URL" env-default:"nats://localhost:4222"`
+		Stream string `yaml:"stream" env:"NATS_STREAM" env-default:"TASK_EVENTS"`
+	} `yaml:"nats"`
+
+	// Спул событий на диске на время недоступности брокера. FullPolicy - что делать
+	// при заполнении: drop_newest (отбросить новое событие) или drop_oldest (удалить самый старый сегмент)
+	Spool struct {
+		Enabled     bool   `yaml:"enabled" env:"EVENT_SPOOL_ENABLED" env-default:"true"`
+		Dir         string `yaml:"dir" env:"EVENT_SPOOL_DIR" env-default:"/var/spool/api-service/events"`
+		MaxSize     int    `yaml:"max_size" env:"EVENT_SPOOL_MAX_SIZE" env-default:"256"`        // MB
+		SegmentSize int    `yaml:"segment_size" env:"EVENT_SPOOL_SEGMENT_SIZE" env-default:"16"` // MB
+		FullPolicy  string `yaml:"full_policy" env:"EVENT_SPOOL_FULL_POLICY" env-default:"drop_newest"`
+	} `yaml:"spool"`
 }
 
 func Load() (*Config, error) {
@@ -59,6 +111,41 @@ func (c *Config) GetTokenDuration() time.Duration {
 	return duration
 }
 
+func (c *Config) GetRedisAddr() string {
+	return fmt.Sprintf("%s:%s", c.Redis.Host, c.Redis.Port)
+}
+
+func (c *Config) GetIdempotencyTTL() time.Duration {
+	ttl := time.Duration(c.Idempotency.TTL) * time.Second
+	if ttl == 0 {
+		return 24 * time.Hour
+	}
+	return ttl
+}
+
+func (c *Config) GetCalDAVSyncTokenTTL() time.Duration {
+	ttl := time.Duration(c.CalDAV.SyncTokenTTL) * time.Second
+	if ttl == 0 {
+		return 30 * 24 * time.Hour
+	}
+	return ttl
+}
+
+func (c *Config) GetCalDAVAuthCacheTTL() time.Duration {
+	if c.CalDAV.AuthCacheTTL < 0 {
+		return 0
+	}
+	return time.Duration(c.CalDAV.AuthCacheTTL) * time.Second
+}
+
+func (c *Config) GetStreamHeartbeatInterval() time.Duration {
+	interval := time.Duration(c.Stream.HeartbeatInterval) * time.Second
+	if interval == 0 {
+		return 25 * time.Second
+	}
+	return interval
+}
+
 func (c *Config) GetKafkaBrokers() []string {
 	if len(c.Kafka.Brokers) == 0 {
 		return []string{"localhost:9092"}
@@ -66,3 +153,16 @@ func (c *Config) GetKafkaBrokers() []string {
 	return c.Kafka.Brokers
 }
 
+func (c *Config) GetSpoolMaxBytes() int64 {
+	if c.Spool.MaxSize <= 0 {
+		return 256 << 20
+	}
+	return int64(c.Spool.MaxSize) << 20
+}
+
+func (c *Config) GetSpoolSegmentBytes() int64 {
+	if c.Spool.SegmentSize <= 0 {
+		return 16 << 20
+	}
+	return int64(c.Spool.SegmentSize) << 20
+}
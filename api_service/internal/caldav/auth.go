@@ -0,0 +1,89 @@
+package caldav
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+	"time"
+)
+
+// Сколько учетных данных хранит AuthCache; при заполнении кэш очищается
+const maxAuthCacheEntries = 10000
+
+// AuthCache запоминает успешную Basic авторизацию, чтобы не проверять пароль (bcrypt) в db_service
+// на каждый запрос: клиенты CalDAV присылают логин и пароль с каждым запросом синхронизации.
+// Ключ - HMAC-SHA256 логина и пароля со случайным ключом процесса, сами пароли не хранятся.
+// Смена пароля вступает в силу для CalDAV не позже чем через ttl.
+type AuthCache struct {
+	ttl    time.Duration
+	secret []byte
+
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]authCacheEntry
+}
+
+type authCacheEntry struct {
+	userID    string
+	expiresAt time.Time
+}
+
+func NewAuthCache(ttl time.Duration) *AuthCache {
+	secret := make([]byte, 32)
+	rand.Read(secret)
+	return &AuthCache{
+		ttl:     ttl,
+		secret:  secret,
+		entries: make(map[[sha256.Size]byte]authCacheEntry),
+	}
+}
+
+// Get возвращает ID пользователя, если эти логин и пароль недавно прошли проверку
+func (c *AuthCache) Get(username, password string) (string, bool) {
+	key := c.key(username, password)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return "", false
+	}
+	return entry.userID, true
+}
+
+// Add запоминает успешную проверку логина и пароля на ttl
+func (c *AuthCache) Add(username, password, userID string) {
+	key := c.key(username, password)
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxAuthCacheEntries {
+		for k, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxAuthCacheEntries {
+			clear(c.entries)
+		}
+	}
+	c.entries[key] = authCacheEntry{userID: userID, expiresAt: now.Add(c.ttl)}
+}
+
+func (c *AuthCache) key(username, password string) [sha256.Size]byte {
+	mac := hmac.New(sha256.New, c.secret)
+	// Длина логина отделяет его от пароля однозначно
+	mac.Write(binary.BigEndian.AppendUint32(nil, uint32(len(username))))
+	mac.Write([]byte(username))
+	mac.Write([]byte(password))
+
+	var key [sha256.Size]byte
+	copy(key[:], mac.Sum(nil))
+	return key
+}
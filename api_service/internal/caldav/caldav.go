@@ -0,0 +1,101 @@
+// Package caldav содержит протокольную часть минимального CalDAV сервера (RFC 4791):
+// разбор путей и XML запросов, формирование ответов multistatus, фильтры calendar-query
+// и токены sync-collection (RFC 6578). Работа с задачами находится в пакете server.
+package caldav
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Prefix - корень CalDAV на HTTP сервере api_service
+const Prefix = "/dav/"
+
+// DefaultCollection - коллекция для задач без списка; остальные коллекции называются по ID списка
+const DefaultCollection = "tasks"
+
+// Расширение ресурсов задач; имя ресурса - UID задачи
+const resourceExt = ".ics"
+
+// TargetKind - тип ресурса, на который указывает путь запроса
+type TargetKind int
+
+const (
+	TargetRoot       TargetKind = iota // /dav/
+	TargetPrincipal                    // /dav/principal/
+	TargetHome                         // /dav/calendars/
+	TargetCollection                   // /dav/calendars/{collection}/
+	TargetResource                     // /dav/calendars/{collection}/{uid}.ics
+)
+
+// Target - разобранный путь запроса
+type Target struct {
+	Kind       TargetKind
+	Collection string
+	UID        string
+}
+
+// Пути ресурсов CalDAV
+const (
+	PrincipalPath = Prefix + "principal/"
+	HomePath      = Prefix + "calendars/"
+)
+
+// CollectionPath возвращает путь календарной коллекции
+func CollectionPath(collection string) string {
+	return HomePath + url.PathEscape(collection) + "/"
+}
+
+// ResourcePath возвращает путь ресурса задачи
+func ResourcePath(collection, uid string) string {
+	return CollectionPath(collection) + url.PathEscape(uid) + resourceExt
+}
+
+// ParsePath разбирает экранированный путь запроса (URL.EscapedPath) или href из тела REPORT
+func ParsePath(escapedPath string) (Target, bool) {
+	rest, ok := strings.CutPrefix(escapedPath, strings.TrimSuffix(Prefix, "/"))
+	if !ok {
+		return Target{}, false
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(rest, "/") {
+		if segment == "" {
+			continue
+		}
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return Target{}, false
+		}
+		segments = append(segments, unescaped)
+	}
+
+	switch {
+	case len(segments) == 0:
+		return Target{Kind: TargetRoot}, true
+	case len(segments) == 1 && segments[0] == "principal":
+		return Target{Kind: TargetPrincipal}, true
+	case segments[0] != "calendars":
+		return Target{}, false
+	case len(segments) == 1:
+		return Target{Kind: TargetHome}, true
+	case len(segments) == 2:
+		return Target{Kind: TargetCollection, Collection: segments[1]}, true
+	case len(segments) == 3 && strings.HasSuffix(segments[2], resourceExt):
+		return Target{
+			Kind:       TargetResource,
+			Collection: segments[1],
+			UID:        strings.TrimSuffix(segments[2], resourceExt),
+		}, true
+	}
+	return Target{}, false
+}
+
+// ParseHref разбирает href из тела запроса: путь или полный URL
+func ParseHref(href string) (Target, bool) {
+	u, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return Target{}, false
+	}
+	return ParsePath(u.EscapedPath())
+}
@@ -0,0 +1,196 @@
+package caldav
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/ical"
+)
+
+// CompFilter - фильтр компонента из calendar-query (RFC 4791, раздел 9.7.1)
+type CompFilter struct {
+	Name         string       `xml:"name,attr"`
+	IsNotDefined *struct{}    `xml:"urn:ietf:params:xml:ns:caldav is-not-defined"`
+	TimeRange    *TimeRange   `xml:"urn:ietf:params:xml:ns:caldav time-range"`
+	PropFilters  []PropFilter `xml:"urn:ietf:params:xml:ns:caldav prop-filter"`
+	CompFilters  []CompFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+}
+
+// PropFilter - фильтр свойства компонента (RFC 4791, раздел 9.7.2)
+type PropFilter struct {
+	Name         string     `xml:"name,attr"`
+	IsNotDefined *struct{}  `xml:"urn:ietf:params:xml:ns:caldav is-not-defined"`
+	TimeRange    *TimeRange `xml:"urn:ietf:params:xml:ns:caldav time-range"`
+	TextMatch    *TextMatch `xml:"urn:ietf:params:xml:ns:caldav text-match"`
+}
+
+// TimeRange - интервал [start, end) в UTC; пустая граница не ограничивает интервал
+type TimeRange struct {
+	Start string `xml:"start,attr"`
+	End   string `xml:"end,attr"`
+}
+
+// TextMatch - поиск подстроки без учета регистра
+type TextMatch struct {
+	Value           string `xml:",chardata"`
+	NegateCondition string `xml:"negate-condition,attr"`
+}
+
+// MatchCalendar проверяет календарь с одной задачей по фильтру calendar-query.
+// Фильтр верхнего уровня должен относиться к VCALENDAR.
+func (f *CompFilter) MatchCalendar(calendar *ical.Component) (bool, error) {
+	if !strings.EqualFold(f.Name, calendar.Name) {
+		return false, nil
+	}
+	return f.match(calendar)
+}
+
+// match проверяет существующий компонент
+func (f *CompFilter) match(component *ical.Component) (bool, error) {
+	if f.IsNotDefined != nil {
+		return false, nil
+	}
+
+	if f.TimeRange != nil {
+		if !strings.EqualFold(component.Name, "VTODO") {
+			return false, fmt.Errorf("time-range is supported only for VTODO")
+		}
+		ok, err := f.TimeRange.matchTodo(component)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	for _, propFilter := range f.PropFilters {
+		ok, err := propFilter.match(component)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	for _, child := range f.CompFilters {
+		ok, err := child.matchAny(component.Children)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// matchAny проверяет, что среди дочерних компонентов есть подходящий (или что его нет, для is-not-defined)
+func (f *CompFilter) matchAny(children []*ical.Component) (bool, error) {
+	found := false
+	for _, child := range children {
+		if !strings.EqualFold(child.Name, f.Name) {
+			continue
+		}
+		found = true
+		if f.IsNotDefined != nil {
+			continue
+		}
+		ok, err := f.match(child)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	if f.IsNotDefined != nil {
+		return !found, nil
+	}
+	return false, nil
+}
+
+func (f *PropFilter) match(component *ical.Component) (bool, error) {
+	props := component.GetAll(strings.ToUpper(f.Name))
+	if f.IsNotDefined != nil {
+		return len(props) == 0, nil
+	}
+	if len(props) == 0 {
+		return false, nil
+	}
+
+	for _, prop := range props {
+		ok := true
+		if f.TimeRange != nil {
+			t, err := prop.Time()
+			if err != nil {
+				return false, err
+			}
+			start, end, err := f.TimeRange.bounds()
+			if err != nil {
+				return false, err
+			}
+			ok = !t.Before(start) && t.Before(end)
+		}
+		if ok && f.TextMatch != nil {
+			ok = f.TextMatch.match(ical.UnescapeText(prop.Value))
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *TextMatch) match(value string) bool {
+	contains := strings.Contains(strings.ToLower(value), strings.ToLower(strings.TrimSpace(m.Value)))
+	if strings.EqualFold(m.NegateCondition, "yes") {
+		return !contains
+	}
+	return contains
+}
+
+// Формат границ time-range
+const timeRangeLayout = "20060102T150405Z"
+
+func (r *TimeRange) bounds() (time.Time, time.Time, error) {
+	start := time.Unix(0, 0).UTC()
+	end := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	var err error
+	if r.Start != "" {
+		if start, err = time.Parse(timeRangeLayout, r.Start); err != nil {
+			return start, end, fmt.Errorf("invalid time-range start %q", r.Start)
+		}
+	}
+	if r.End != "" {
+		if end, err = time.Parse(timeRangeLayout, r.End); err != nil {
+			return start, end, fmt.Errorf("invalid time-range end %q", r.End)
+		}
+	}
+	return start, end, nil
+}
+
+// matchTodo проверяет пересечение VTODO с интервалом по таблице RFC 4791, раздел 9.9.
+// Сервис не пишет DTSTART и DURATION, поэтому учитываются только DUE, COMPLETED и CREATED.
+func (r *TimeRange) matchTodo(todo *ical.Component) (bool, error) {
+	start, end, err := r.bounds()
+	if err != nil {
+		return false, err
+	}
+
+	due, err := todo.Time("DUE")
+	if err != nil {
+		return false, err
+	}
+	completed, err := todo.Time("COMPLETED")
+	if err != nil {
+		return false, err
+	}
+	created, err := todo.Time("CREATED")
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case due != nil:
+		return (start.Before(*due) || start.Equal(*due)) && end.After(*due), nil
+	case completed != nil && created != nil:
+		return (!start.After(*created) || !start.After(*completed)) &&
+			(!end.Before(*created) || !end.Before(*completed)), nil
+	case completed != nil:
+		return !start.After(*completed) && !end.Before(*completed), nil
+	case created != nil:
+		return end.After(*created), nil
+	}
+	return true, nil
+}
@@ -0,0 +1,85 @@
+package caldav
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Префикс токенов sync-collection; токен должен быть URI (RFC 6578, раздел 3.2)
+const syncTokenPrefix = "http://checklist-app/ns/sync/"
+
+// ErrInvalidSyncToken - токен неизвестен, истек или выдан для другой коллекции
+var ErrInvalidSyncToken = errors.New("invalid sync token")
+
+// SyncStore хранит состояние коллекций (href -> ETag), выданное клиентам вместе с токеном.
+// По сохраненному состоянию sync-collection вычисляет изменения без журнала изменений в db_service.
+type SyncStore struct {
+	redis *redis.Client
+	ttl   time.Duration
+}
+
+func NewSyncStore(redisClient *redis.Client, ttl time.Duration) *SyncStore {
+	return &SyncStore{
+		redis: redisClient,
+		ttl:   ttl,
+	}
+}
+
+// Save сохраняет состояние коллекции и возвращает токен для него.
+// Одинаковое состояние дает одинаковый токен, поэтому токен подходит и для getctag.
+func (s *SyncStore) Save(ctx context.Context, userID, collection string, members map[string]string) (string, error) {
+	token := SyncToken(members)
+
+	data, err := json.Marshal(members)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal sync state: %w", err)
+	}
+	if err := s.redis.Set(ctx, s.key(userID, collection, token), data, s.ttl).Err(); err != nil {
+		return "", fmt.Errorf("failed to save sync state: %w", err)
+	}
+	return token, nil
+}
+
+// Load возвращает состояние коллекции, сохраненное для токена
+func (s *SyncStore) Load(ctx context.Context, userID, collection, token string) (map[string]string, error) {
+	if !strings.HasPrefix(token, syncTokenPrefix) {
+		return nil, ErrInvalidSyncToken
+	}
+
+	data, err := s.redis.Get(ctx, s.key(userID, collection, token)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrInvalidSyncToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sync state: %w", err)
+	}
+
+	var members map[string]string
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sync state: %w", err)
+	}
+	return members, nil
+}
+
+func (s *SyncStore) key(userID, collection, token string) string {
+	return fmt.Sprintf("caldav:sync:%s:%s:%s", userID, collection, strings.TrimPrefix(token, syncTokenPrefix))
+}
+
+// SyncToken вычисляет токен состояния коллекции без сохранения
+func SyncToken(members map[string]string) string {
+	hash := sha256.New()
+	for _, href := range slices.Sorted(maps.Keys(members)) {
+		fmt.Fprintf(hash, "%s %s\n", href, members[href])
+	}
+	return syncTokenPrefix + hex.EncodeToString(hash.Sum(nil))[:32]
+}
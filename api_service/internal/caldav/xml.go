@@ -0,0 +1,316 @@
+package caldav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Пространства имен XML
+const (
+	NamespaceDAV        = "DAV:"
+	NamespaceCalDAV     = "urn:ietf:params:xml:ns:caldav"
+	NamespaceCalServer  = "http://calendarserver.org/ns/"
+	xmlContentType      = "application/xml; charset=utf-8"
+	multistatusRootOpen = `<D:multistatus xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav" xmlns:CS="http://calendarserver.org/ns/">`
+)
+
+// Префиксы, объявленные в корне ответа
+var namespacePrefixes = map[string]string{
+	NamespaceDAV:       "D",
+	NamespaceCalDAV:    "C",
+	NamespaceCalServer: "CS",
+}
+
+// Свойства, которые отдает сервер
+var (
+	PropResourceType         = xml.Name{Space: NamespaceDAV, Local: "resourcetype"}
+	PropDisplayName          = xml.Name{Space: NamespaceDAV, Local: "displayname"}
+	PropCurrentUserPrincipal = xml.Name{Space: NamespaceDAV, Local: "current-user-principal"}
+	PropPrincipalURL         = xml.Name{Space: NamespaceDAV, Local: "principal-URL"}
+	PropPrivileges           = xml.Name{Space: NamespaceDAV, Local: "current-user-privilege-set"}
+	PropSupportedReports     = xml.Name{Space: NamespaceDAV, Local: "supported-report-set"}
+	PropSyncToken            = xml.Name{Space: NamespaceDAV, Local: "sync-token"}
+	PropETag                 = xml.Name{Space: NamespaceDAV, Local: "getetag"}
+	PropContentType          = xml.Name{Space: NamespaceDAV, Local: "getcontenttype"}
+	PropCalendarHomeSet      = xml.Name{Space: NamespaceCalDAV, Local: "calendar-home-set"}
+	PropSupportedComponents  = xml.Name{Space: NamespaceCalDAV, Local: "supported-calendar-component-set"}
+	PropCalendarData         = xml.Name{Space: NamespaceCalDAV, Local: "calendar-data"}
+	PropCTag                 = xml.Name{Space: NamespaceCalServer, Local: "getctag"}
+)
+
+// Условия, которые возвращаются в теле ошибок (RFC 4791, раздел 1.3)
+var (
+	ErrorValidSyncToken     = xml.Name{Space: NamespaceDAV, Local: "valid-sync-token"}
+	ErrorValidCalendarData  = xml.Name{Space: NamespaceCalDAV, Local: "valid-calendar-data"}
+	ErrorSupportedComponent = xml.Name{Space: NamespaceCalDAV, Local: "supported-calendar-component"}
+	ErrorValidObject        = xml.Name{Space: NamespaceCalDAV, Local: "valid-calendar-object-resource"}
+	ErrorNoUIDConflict      = xml.Name{Space: NamespaceCalDAV, Local: "no-uid-conflict"}
+	ErrorValidFilter        = xml.Name{Space: NamespaceCalDAV, Local: "valid-filter"}
+	ErrorSupportedReport    = xml.Name{Space: NamespaceDAV, Local: "supported-report"}
+	ErrorSupportedSyncLevel = xml.Name{Space: NamespaceDAV, Local: "sync-traversal-supported"}
+)
+
+// Props - значения свойств ресурса в виде внутреннего XML элемента
+type Props map[xml.Name]string
+
+// SetText записывает текстовое значение свойства
+func (p Props) SetText(name xml.Name, text string) {
+	p[name] = escapeXML(text)
+}
+
+// SetHref записывает свойство с одним href
+func (p Props) SetHref(name xml.Name, href string) {
+	p[name] = "<D:href>" + escapeXML(href) + "</D:href>"
+}
+
+// PropRequest - запрошенные свойства из PROPFIND или REPORT
+type PropRequest struct {
+	AllProp  bool
+	PropName bool
+	Names    []xml.Name
+}
+
+// Has проверяет, запрошено ли свойство явно
+func (r PropRequest) Has(name xml.Name) bool {
+	for _, n := range r.Names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// propNames собирает имена дочерних элементов <D:prop>
+type propNames []xml.Name
+
+func (p *propNames) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			*p = append(*p, t.Name)
+			if err := d.Skip(); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+type propfindXML struct {
+	XMLName  xml.Name  `xml:"DAV: propfind"`
+	AllProp  *struct{} `xml:"DAV: allprop"`
+	PropName *struct{} `xml:"DAV: propname"`
+	Prop     propNames `xml:"DAV: prop"`
+}
+
+// ParsePropFind разбирает тело PROPFIND; пустое тело означает allprop
+func ParsePropFind(body []byte) (PropRequest, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return PropRequest{AllProp: true}, nil
+	}
+
+	var req propfindXML
+	if err := xml.Unmarshal(body, &req); err != nil {
+		return PropRequest{}, fmt.Errorf("invalid propfind body: %w", err)
+	}
+	return PropRequest{
+		AllProp:  req.AllProp != nil,
+		PropName: req.PropName != nil,
+		Names:    req.Prop,
+	}, nil
+}
+
+// Виды REPORT
+const (
+	ReportCalendarQuery    = "calendar-query"
+	ReportCalendarMultiget = "calendar-multiget"
+	ReportSyncCollection   = "sync-collection"
+)
+
+// Report - разобранное тело REPORT
+type Report struct {
+	Kind      string
+	Props     PropRequest
+	Filter    *CompFilter // calendar-query
+	Hrefs     []string    // calendar-multiget
+	SyncToken string      // sync-collection
+	SyncLevel string      // sync-collection
+}
+
+type reportXML struct {
+	XMLName   xml.Name
+	AllProp   *struct{}  `xml:"DAV: allprop"`
+	Prop      propNames  `xml:"DAV: prop"`
+	Filter    *filterXML `xml:"urn:ietf:params:xml:ns:caldav filter"`
+	Hrefs     []string   `xml:"DAV: href"`
+	SyncToken string     `xml:"DAV: sync-token"`
+	SyncLevel string     `xml:"DAV: sync-level"`
+}
+
+type filterXML struct {
+	CompFilter CompFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+}
+
+// ParseReport разбирает тело REPORT. Для неподдерживаемых отчетов Kind пустой.
+func ParseReport(body []byte) (*Report, error) {
+	var req reportXML
+	if err := xml.Unmarshal(body, &req); err != nil {
+		return nil, fmt.Errorf("invalid report body: %w", err)
+	}
+
+	report := &Report{
+		Props:     PropRequest{AllProp: req.AllProp != nil, Names: req.Prop},
+		Hrefs:     req.Hrefs,
+		SyncToken: strings.TrimSpace(req.SyncToken),
+		SyncLevel: strings.TrimSpace(req.SyncLevel),
+	}
+	switch req.XMLName {
+	case xml.Name{Space: NamespaceCalDAV, Local: ReportCalendarQuery}:
+		report.Kind = ReportCalendarQuery
+		if req.Filter != nil {
+			report.Filter = &req.Filter.CompFilter
+		}
+	case xml.Name{Space: NamespaceCalDAV, Local: ReportCalendarMultiget}:
+		report.Kind = ReportCalendarMultiget
+	case xml.Name{Space: NamespaceDAV, Local: ReportSyncCollection}:
+		report.Kind = ReportSyncCollection
+	}
+	return report, nil
+}
+
+// Multistatus собирает ответ 207 Multi-Status
+type Multistatus struct {
+	buf       bytes.Buffer
+	syncToken string
+}
+
+func NewMultistatus() *Multistatus {
+	return &Multistatus{}
+}
+
+// AddProps добавляет ресурс с запрошенными свойствами: найденные - с 200, остальные - с 404
+func (m *Multistatus) AddProps(href string, props Props, req PropRequest) {
+	var found, missing []xml.Name
+	switch {
+	case req.AllProp:
+		for name := range props {
+			// calendar-data не входит в allprop (RFC 4791, раздел 9.6)
+			if name != PropCalendarData {
+				found = append(found, name)
+			}
+		}
+	case req.PropName:
+		for name := range props {
+			found = append(found, name)
+		}
+	default:
+		for _, name := range req.Names {
+			if _, ok := props[name]; ok {
+				found = append(found, name)
+			} else {
+				missing = append(missing, name)
+			}
+		}
+	}
+
+	if req.AllProp || req.PropName {
+		// Порядок свойств в ответе не должен зависеть от обхода map
+		slices.SortFunc(found, func(a, b xml.Name) int {
+			return strings.Compare(a.Space+" "+a.Local, b.Space+" "+b.Local)
+		})
+	}
+
+	m.buf.WriteString("<D:response><D:href>" + escapeXML(href) + "</D:href>")
+	if len(found) > 0 {
+		m.buf.WriteString("<D:propstat><D:prop>")
+		for _, name := range found {
+			value := props[name]
+			if req.PropName {
+				value = ""
+			}
+			writeElement(&m.buf, name, value)
+		}
+		m.buf.WriteString("</D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat>")
+	}
+	if len(missing) > 0 {
+		m.buf.WriteString("<D:propstat><D:prop>")
+		for _, name := range missing {
+			writeElement(&m.buf, name, "")
+		}
+		m.buf.WriteString("</D:prop><D:status>HTTP/1.1 404 Not Found</D:status></D:propstat>")
+	}
+	m.buf.WriteString("</D:response>")
+}
+
+// AddStatus добавляет ресурс только со статусом (например, 404 для удаленных в sync-collection)
+func (m *Multistatus) AddStatus(href string, code int) {
+	fmt.Fprintf(&m.buf, "<D:response><D:href>%s</D:href><D:status>HTTP/1.1 %d %s</D:status></D:response>",
+		escapeXML(href), code, http.StatusText(code))
+}
+
+// SetSyncToken задает новый токен в ответе sync-collection
+func (m *Multistatus) SetSyncToken(token string) {
+	m.syncToken = token
+}
+
+// Write отправляет ответ клиенту
+func (m *Multistatus) Write(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", xmlContentType)
+	w.WriteHeader(http.StatusMultiStatus)
+	io.WriteString(w, xml.Header+multistatusRootOpen)
+	w.Write(m.buf.Bytes())
+	if m.syncToken != "" {
+		io.WriteString(w, "<D:sync-token>"+escapeXML(m.syncToken)+"</D:sync-token>")
+	}
+	io.WriteString(w, "</D:multistatus>")
+}
+
+// WriteError отправляет ошибку с нарушенным условием в теле <D:error>
+func WriteError(w http.ResponseWriter, code int, condition xml.Name, inner string) {
+	w.Header().Set("Content-Type", xmlContentType)
+	w.WriteHeader(code)
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header + `<D:error xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">`)
+	writeElement(&buf, condition, inner)
+	buf.WriteString("</D:error>")
+	w.Write(buf.Bytes())
+}
+
+// HrefXML возвращает элемент <D:href>
+func HrefXML(href string) string {
+	return "<D:href>" + escapeXML(href) + "</D:href>"
+}
+
+// writeElement пишет элемент с префиксом известного пространства имен
+// или с собственным объявлением xmlns для остальных
+func writeElement(buf *bytes.Buffer, name xml.Name, inner string) {
+	tag := name.Local
+	attrs := ""
+	if prefix, ok := namespacePrefixes[name.Space]; ok {
+		tag = prefix + ":" + name.Local
+	} else if name.Space != "" {
+		attrs = ` xmlns="` + escapeXML(name.Space) + `"`
+	}
+
+	if inner == "" {
+		buf.WriteString("<" + tag + attrs + "/>")
+		return
+	}
+	buf.WriteString("<" + tag + attrs + ">" + inner + "</" + tag + ">")
+}
+
+func escapeXML(text string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(text))
+	return buf.String()
+}
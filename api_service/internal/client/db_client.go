@@ -56,6 +56,10 @@ func (c *DBClient) GetTasks(ctx context.Context, req *dbpb.GetTasksRequest) (*db
 	return c.client.GetTasks(ctx, req)
 }
 
+func (c *DBClient) GetTask(ctx context.Context, req *dbpb.GetTaskRequest) (*dbpb.GetTaskResponse, error) {
+	return c.client.GetTask(ctx, req)
+}
+
 func (c *DBClient) DeleteTask(ctx context.Context, req *dbpb.DeleteTaskRequest) (*dbpb.DeleteTaskResponse, error) {
 	return c.client.DeleteTask(ctx, req)
 }
@@ -64,3 +68,154 @@ func (c *DBClient) CompleteTask(ctx context.Context, req *dbpb.CompleteTaskReque
 	return c.client.CompleteTask(ctx, req)
 }
 
+func (c *DBClient) UpdateTask(ctx context.Context, req *dbpb.UpdateTaskRequest) (*dbpb.UpdateTaskResponse, error) {
+	return c.client.UpdateTask(ctx, req)
+}
+
+func (c *DBClient) ArchiveTask(ctx context.Context, req *dbpb.ArchiveTaskRequest) (*dbpb.ArchiveTaskResponse, error) {
+	return c.client.ArchiveTask(ctx, req)
+}
+
+func (c *DBClient) UnarchiveTask(ctx context.Context, req *dbpb.UnarchiveTaskRequest) (*dbpb.UnarchiveTaskResponse, error) {
+	return c.client.UnarchiveTask(ctx, req)
+}
+
+func (c *DBClient) GetArchivePolicy(ctx context.Context, req *dbpb.GetArchivePolicyRequest) (*dbpb.GetArchivePolicyResponse, error) {
+	return c.client.GetArchivePolicy(ctx, req)
+}
+
+func (c *DBClient) SetArchivePolicy(ctx context.Context, req *dbpb.SetArchivePolicyRequest) (*dbpb.SetArchivePolicyResponse, error) {
+	return c.client.SetArchivePolicy(ctx, req)
+}
+
+func (c *DBClient) ExportTasks(ctx context.Context, req *dbpb.ExportTasksRequest) (dbpb.DatabaseService_ExportTasksClient, error) {
+	return c.client.ExportTasks(ctx, req)
+}
+
+func (c *DBClient) ImportTasks(ctx context.Context, req *dbpb.ImportTasksRequest) (*dbpb.ImportTasksResponse, error) {
+	return c.client.ImportTasks(ctx, req)
+}
+
+func (c *DBClient) GetCalendarToken(ctx context.Context, req *dbpb.GetCalendarTokenRequest) (*dbpb.GetCalendarTokenResponse, error) {
+	return c.client.GetCalendarToken(ctx, req)
+}
+
+func (c *DBClient) ResetCalendarToken(ctx context.Context, req *dbpb.ResetCalendarTokenRequest) (*dbpb.ResetCalendarTokenResponse, error) {
+	return c.client.ResetCalendarToken(ctx, req)
+}
+
+func (c *DBClient) GetCalendarTokenOwner(ctx context.Context, req *dbpb.GetCalendarTokenOwnerRequest) (*dbpb.GetCalendarTokenOwnerResponse, error) {
+	return c.client.GetCalendarTokenOwner(ctx, req)
+}
+
+func (c *DBClient) GetTaskHistory(ctx context.Context, req *dbpb.GetTaskHistoryRequest) (*dbpb.GetTaskHistoryResponse, error) {
+	return c.client.GetTaskHistory(ctx, req)
+}
+
+func (c *DBClient) RevertTask(ctx context.Context, req *dbpb.RevertTaskRequest) (*dbpb.RevertTaskResponse, error) {
+	return c.client.RevertTask(ctx, req)
+}
+
+func (c *DBClient) CreateList(ctx context.Context, req *dbpb.CreateListRequest) (*dbpb.CreateListResponse, error) {
+	return c.client.CreateList(ctx, req)
+}
+
+func (c *DBClient) GetLists(ctx context.Context, req *dbpb.GetListsRequest) (*dbpb.GetListsResponse, error) {
+	return c.client.GetLists(ctx, req)
+}
+
+func (c *DBClient) DeleteList(ctx context.Context, req *dbpb.DeleteListRequest) (*dbpb.DeleteListResponse, error) {
+	return c.client.DeleteList(ctx, req)
+}
+
+func (c *DBClient) CreateTemplate(ctx context.Context, req *dbpb.CreateTemplateRequest) (*dbpb.CreateTemplateResponse, error) {
+	return c.client.CreateTemplate(ctx, req)
+}
+
+func (c *DBClient) GetTemplates(ctx context.Context, req *dbpb.GetTemplatesRequest) (*dbpb.GetTemplatesResponse, error) {
+	return c.client.GetTemplates(ctx, req)
+}
+
+func (c *DBClient) GetTemplate(ctx context.Context, req *dbpb.GetTemplateRequest) (*dbpb.GetTemplateResponse, error) {
+	return c.client.GetTemplate(ctx, req)
+}
+
+func (c *DBClient) UpdateTemplate(ctx context.Context, req *dbpb.UpdateTemplateRequest) (*dbpb.UpdateTemplateResponse, error) {
+	return c.client.UpdateTemplate(ctx, req)
+}
+
+func (c *DBClient) DeleteTemplate(ctx context.Context, req *dbpb.DeleteTemplateRequest) (*dbpb.DeleteTemplateResponse, error) {
+	return c.client.DeleteTemplate(ctx, req)
+}
+
+func (c *DBClient) InstantiateTemplate(ctx context.Context, req *dbpb.InstantiateTemplateRequest) (*dbpb.InstantiateTemplateResponse, error) {
+	return c.client.InstantiateTemplate(ctx, req)
+}
+
+func (c *DBClient) SaveListAsTemplate(ctx context.Context, req *dbpb.SaveListAsTemplateRequest) (*dbpb.SaveListAsTemplateResponse, error) {
+	return c.client.SaveListAsTemplate(ctx, req)
+}
+
+func (c *DBClient) CreateWebhook(ctx context.Context, req *dbpb.CreateWebhookRequest) (*dbpb.CreateWebhookResponse, error) {
+	return c.client.CreateWebhook(ctx, req)
+}
+
+func (c *DBClient) GetWebhooks(ctx context.Context, req *dbpb.GetWebhooksRequest) (*dbpb.GetWebhooksResponse, error) {
+	return c.client.GetWebhooks(ctx, req)
+}
+
+func (c *DBClient) GetWebhook(ctx context.Context, req *dbpb.GetWebhookRequest) (*dbpb.GetWebhookResponse, error) {
+	return c.client.GetWebhook(ctx, req)
+}
+
+func (c *DBClient) UpdateWebhook(ctx context.Context, req *dbpb.UpdateWebhookRequest) (*dbpb.UpdateWebhookResponse, error) {
+	return c.client.UpdateWebhook(ctx, req)
+}
+
+func (c *DBClient) DeleteWebhook(ctx context.Context, req *dbpb.DeleteWebhookRequest) (*dbpb.DeleteWebhookResponse, error) {
+	return c.client.DeleteWebhook(ctx, req)
+}
+
+func (c *DBClient) GetWebhookDeliveries(ctx context.Context, req *dbpb.GetWebhookDeliveriesRequest) (*dbpb.GetWebhookDeliveriesResponse, error) {
+	return c.client.GetWebhookDeliveries(ctx, req)
+}
+
+func (c *DBClient) RedeliverWebhook(ctx context.Context, req *dbpb.RedeliverWebhookRequest) (*dbpb.RedeliverWebhookResponse, error) {
+	return c.client.RedeliverWebhook(ctx, req)
+}
+
+func (c *DBClient) CreateReminder(ctx context.Context, req *dbpb.CreateReminderRequest) (*dbpb.CreateReminderResponse, error) {
+	return c.client.CreateReminder(ctx, req)
+}
+
+func (c *DBClient) GetReminders(ctx context.Context, req *dbpb.GetRemindersRequest) (*dbpb.GetRemindersResponse, error) {
+	return c.client.GetReminders(ctx, req)
+}
+
+func (c *DBClient) DeleteReminder(ctx context.Context, req *dbpb.DeleteReminderRequest) (*dbpb.DeleteReminderResponse, error) {
+	return c.client.DeleteReminder(ctx, req)
+}
+
+func (c *DBClient) GetDigestSettings(ctx context.Context, req *dbpb.GetDigestSettingsRequest) (*dbpb.GetDigestSettingsResponse, error) {
+	return c.client.GetDigestSettings(ctx, req)
+}
+
+func (c *DBClient) SetDigestSettings(ctx context.Context, req *dbpb.SetDigestSettingsRequest) (*dbpb.SetDigestSettingsResponse, error) {
+	return c.client.SetDigestSettings(ctx, req)
+}
+
+func (c *DBClient) GetDigest(ctx context.Context, req *dbpb.GetDigestRequest) (*dbpb.GetDigestResponse, error) {
+	return c.client.GetDigest(ctx, req)
+}
+
+func (c *DBClient) GetStats(ctx context.Context, req *dbpb.GetStatsRequest) (*dbpb.GetStatsResponse, error) {
+	return c.client.GetStats(ctx, req)
+}
+
+func (c *DBClient) GetSettings(ctx context.Context, req *dbpb.GetSettingsRequest) (*dbpb.GetSettingsResponse, error) {
+	return c.client.GetSettings(ctx, req)
+}
+
+func (c *DBClient) UpdateSettings(ctx context.Context, req *dbpb.UpdateSettingsRequest) (*dbpb.UpdateSettingsResponse, error) {
+	return c.client.UpdateSettings(ctx, req)
+}
@@ -12,8 +12,47 @@ type DBClientInterface interface {
 	GetUser(ctx context.Context, req *dbpb.GetUserRequest) (*dbpb.GetUserResponse, error)
 	CreateTask(ctx context.Context, req *dbpb.CreateTaskRequest) (*dbpb.CreateTaskResponse, error)
 	GetTasks(ctx context.Context, req *dbpb.GetTasksRequest) (*dbpb.GetTasksResponse, error)
+	GetTask(ctx context.Context, req *dbpb.GetTaskRequest) (*dbpb.GetTaskResponse, error)
 	DeleteTask(ctx context.Context, req *dbpb.DeleteTaskRequest) (*dbpb.DeleteTaskResponse, error)
 	CompleteTask(ctx context.Context, req *dbpb.CompleteTaskRequest) (*dbpb.CompleteTaskResponse, error)
+	UpdateTask(ctx context.Context, req *dbpb.UpdateTaskRequest) (*dbpb.UpdateTaskResponse, error)
+	ArchiveTask(ctx context.Context, req *dbpb.ArchiveTaskRequest) (*dbpb.ArchiveTaskResponse, error)
+	UnarchiveTask(ctx context.Context, req *dbpb.UnarchiveTaskRequest) (*dbpb.UnarchiveTaskResponse, error)
+	GetArchivePolicy(ctx context.Context, req *dbpb.GetArchivePolicyRequest) (*dbpb.GetArchivePolicyResponse, error)
+	SetArchivePolicy(ctx context.Context, req *dbpb.SetArchivePolicyRequest) (*dbpb.SetArchivePolicyResponse, error)
+	ExportTasks(ctx context.Context, req *dbpb.ExportTasksRequest) (dbpb.DatabaseService_ExportTasksClient, error)
+	ImportTasks(ctx context.Context, req *dbpb.ImportTasksRequest) (*dbpb.ImportTasksResponse, error)
+	GetCalendarToken(ctx context.Context, req *dbpb.GetCalendarTokenRequest) (*dbpb.GetCalendarTokenResponse, error)
+	ResetCalendarToken(ctx context.Context, req *dbpb.ResetCalendarTokenRequest) (*dbpb.ResetCalendarTokenResponse, error)
+	GetCalendarTokenOwner(ctx context.Context, req *dbpb.GetCalendarTokenOwnerRequest) (*dbpb.GetCalendarTokenOwnerResponse, error)
+	GetTaskHistory(ctx context.Context, req *dbpb.GetTaskHistoryRequest) (*dbpb.GetTaskHistoryResponse, error)
+	RevertTask(ctx context.Context, req *dbpb.RevertTaskRequest) (*dbpb.RevertTaskResponse, error)
+	CreateList(ctx context.Context, req *dbpb.CreateListRequest) (*dbpb.CreateListResponse, error)
+	GetLists(ctx context.Context, req *dbpb.GetListsRequest) (*dbpb.GetListsResponse, error)
+	DeleteList(ctx context.Context, req *dbpb.DeleteListRequest) (*dbpb.DeleteListResponse, error)
+	CreateTemplate(ctx context.Context, req *dbpb.CreateTemplateRequest) (*dbpb.CreateTemplateResponse, error)
+	GetTemplates(ctx context.Context, req *dbpb.GetTemplatesRequest) (*dbpb.GetTemplatesResponse, error)
+	GetTemplate(ctx context.Context, req *dbpb.GetTemplateRequest) (*dbpb.GetTemplateResponse, error)
+	UpdateTemplate(ctx context.Context, req *dbpb.UpdateTemplateRequest) (*dbpb.UpdateTemplateResponse, error)
+	DeleteTemplate(ctx context.Context, req *dbpb.DeleteTemplateRequest) (*dbpb.DeleteTemplateResponse, error)
+	InstantiateTemplate(ctx context.Context, req *dbpb.InstantiateTemplateRequest) (*dbpb.InstantiateTemplateResponse, error)
+	SaveListAsTemplate(ctx context.Context, req *dbpb.SaveListAsTemplateRequest) (*dbpb.SaveListAsTemplateResponse, error)
+	CreateWebhook(ctx context.Context, req *dbpb.CreateWebhookRequest) (*dbpb.CreateWebhookResponse, error)
+	GetWebhooks(ctx context.Context, req *dbpb.GetWebhooksRequest) (*dbpb.GetWebhooksResponse, error)
+	GetWebhook(ctx context.Context, req *dbpb.GetWebhookRequest) (*dbpb.GetWebhookResponse, error)
+	UpdateWebhook(ctx context.Context, req *dbpb.UpdateWebhookRequest) (*dbpb.UpdateWebhookResponse, error)
+	DeleteWebhook(ctx context.Context, req *dbpb.DeleteWebhookRequest) (*dbpb.DeleteWebhookResponse, error)
+	GetWebhookDeliveries(ctx context.Context, req *dbpb.GetWebhookDeliveriesRequest) (*dbpb.GetWebhookDeliveriesResponse, error)
+	RedeliverWebhook(ctx context.Context, req *dbpb.RedeliverWebhookRequest) (*dbpb.RedeliverWebhookResponse, error)
+	CreateReminder(ctx context.Context, req *dbpb.CreateReminderRequest) (*dbpb.CreateReminderResponse, error)
+	GetReminders(ctx context.Context, req *dbpb.GetRemindersRequest) (*dbpb.GetRemindersResponse, error)
+	DeleteReminder(ctx context.Context, req *dbpb.DeleteReminderRequest) (*dbpb.DeleteReminderResponse, error)
+	GetDigestSettings(ctx context.Context, req *dbpb.GetDigestSettingsRequest) (*dbpb.GetDigestSettingsResponse, error)
+	SetDigestSettings(ctx context.Context, req *dbpb.SetDigestSettingsRequest) (*dbpb.SetDigestSettingsResponse, error)
+	GetDigest(ctx context.Context, req *dbpb.GetDigestRequest) (*dbpb.GetDigestResponse, error)
+	GetStats(ctx context.Context, req *dbpb.GetStatsRequest) (*dbpb.GetStatsResponse, error)
+	GetSettings(ctx context.Context, req *dbpb.GetSettingsRequest) (*dbpb.GetSettingsResponse, error)
+	UpdateSettings(ctx context.Context, req *dbpb.UpdateSettingsRequest) (*dbpb.UpdateSettingsResponse, error)
 	Close() error
 }
 
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// NewRedis создает клиент Redis и проверяет подключение
+func NewRedis(ctx context.Context, addr, password string, db int) (*redis.Client, error) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: password,
+		DB:       db,
+	})
+
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+
+	log.Printf("Successfully connected to Redis at %s", addr)
+	return rdb, nil
+}
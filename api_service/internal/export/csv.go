@@ -0,0 +1,72 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"strings"
+
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+)
+
+// Колонки CSV выгрузки; теги разделяются точкой с запятой
+var csvHeader = []string{
+	"id", "parent_id", "list", "title", "description", "completed", "tags",
+	"due_at", "created_at", "completed_at", "archived_at", "version",
+}
+
+// TagSeparator разделяет теги в одной ячейке CSV
+const TagSeparator = ";"
+
+// csvWriter выводит CSV, по одной задаче на строку
+type csvWriter struct {
+	buf bytes.Buffer
+	w   *csv.Writer
+}
+
+func newCSVWriter() *csvWriter {
+	cw := &csvWriter{}
+	cw.w = csv.NewWriter(&cw.buf)
+	return cw
+}
+
+func (w *csvWriter) ContentType() string   { return "text/csv; charset=utf-8" }
+func (w *csvWriter) FileExtension() string { return "csv" }
+
+func (w *csvWriter) Begin() ([]byte, error) {
+	return w.record(csvHeader)
+}
+
+func (w *csvWriter) Write(task *pb.Task, listName string) ([]byte, error) {
+	return w.record([]string{
+		task.Id,
+		task.ParentId,
+		listName,
+		task.Title,
+		task.Description,
+		strconv.FormatBool(task.Completed),
+		strings.Join(task.Tags, TagSeparator),
+		formatTime(task.DueAt),
+		formatTime(task.CreatedAt),
+		formatTime(task.CompletedAt),
+		formatTime(task.ArchivedAt),
+		strconv.FormatInt(task.Version, 10),
+	})
+}
+
+func (w *csvWriter) End() ([]byte, error) {
+	return nil, nil
+}
+
+// record кодирует одну строку CSV без завершающего перевода строки
+func (w *csvWriter) record(fields []string) ([]byte, error) {
+	w.buf.Reset()
+	if err := w.w.Write(fields); err != nil {
+		return nil, err
+	}
+	w.w.Flush()
+	if err := w.w.Error(); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(bytes.Clone(w.buf.Bytes()), []byte("\n")), nil
+}
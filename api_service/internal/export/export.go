@@ -0,0 +1,51 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Поддерживаемые форматы выгрузки
+const (
+	FormatJSON     = "json"
+	FormatCSV      = "csv"
+	FormatMarkdown = "markdown"
+)
+
+// Writer превращает поток задач в части файла выгрузки.
+// Каждая часть - одна или несколько строк файла без завершающего перевода строки;
+// при склейке частей между ними ставится "\n". Метод может вернуть nil,
+// если для задачи пока нечего выводить.
+type Writer interface {
+	ContentType() string
+	FileExtension() string
+	Begin() ([]byte, error)
+	Write(task *pb.Task, listName string) ([]byte, error)
+	End() ([]byte, error)
+}
+
+// NewWriter возвращает Writer для формата; пустой формат означает JSON
+func NewWriter(format string) (Writer, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", FormatJSON:
+		return &jsonWriter{}, nil
+	case FormatCSV:
+		return newCSVWriter(), nil
+	case FormatMarkdown, "md":
+		return &markdownWriter{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q, expected json, csv or markdown", format)
+	}
+}
+
+// formatTime форматирует время в RFC 3339, для nil возвращает пустую строку
+func formatTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339)
+}
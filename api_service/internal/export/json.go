@@ -0,0 +1,74 @@
+package export
+
+import (
+	"encoding/json"
+
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+)
+
+// jsonTask - задача в JSON выгрузке
+type jsonTask struct {
+	ID          string   `json:"id"`
+	ParentID    string   `json:"parent_id,omitempty"`
+	ListID      string   `json:"list_id,omitempty"`
+	List        string   `json:"list,omitempty"`
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	Completed   bool     `json:"completed"`
+	Tags        []string `json:"tags,omitempty"`
+	DueAt       string   `json:"due_at,omitempty"`
+	CreatedAt   string   `json:"created_at"`
+	CompletedAt string   `json:"completed_at,omitempty"`
+	ArchivedAt  string   `json:"archived_at,omitempty"`
+	Version     int64    `json:"version"`
+}
+
+// jsonWriter выводит JSON массив, по одной задаче на строку.
+// Последняя задача придерживается до следующей, чтобы знать, нужна ли после нее запятая.
+type jsonWriter struct {
+	pending []byte
+}
+
+func (w *jsonWriter) ContentType() string   { return "application/json" }
+func (w *jsonWriter) FileExtension() string { return "json" }
+
+func (w *jsonWriter) Begin() ([]byte, error) {
+	return []byte("["), nil
+}
+
+func (w *jsonWriter) Write(task *pb.Task, listName string) ([]byte, error) {
+	data, err := json.Marshal(jsonTask{
+		ID:          task.Id,
+		ParentID:    task.ParentId,
+		ListID:      task.ListId,
+		List:        listName,
+		Title:       task.Title,
+		Description: task.Description,
+		Completed:   task.Completed,
+		Tags:        task.Tags,
+		DueAt:       formatTime(task.DueAt),
+		CreatedAt:   formatTime(task.CreatedAt),
+		CompletedAt: formatTime(task.CompletedAt),
+		ArchivedAt:  formatTime(task.ArchivedAt),
+		Version:     task.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var out []byte
+	if w.pending != nil {
+		out = append(w.pending, ',')
+	}
+	w.pending = append([]byte("  "), data...)
+	return out, nil
+}
+
+func (w *jsonWriter) End() ([]byte, error) {
+	if w.pending == nil {
+		return []byte("]"), nil
+	}
+	out := append(w.pending, "\n]"...)
+	w.pending = nil
+	return out, nil
+}
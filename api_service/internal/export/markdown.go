@@ -0,0 +1,68 @@
+package export
+
+import (
+	"strings"
+
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+)
+
+// markdownWriter выводит чек-лист: раздел на каждый список, подзадачи с отступом
+type markdownWriter struct {
+	started  bool
+	listName string
+}
+
+func (w *markdownWriter) ContentType() string   { return "text/markdown; charset=utf-8" }
+func (w *markdownWriter) FileExtension() string { return "md" }
+
+func (w *markdownWriter) Begin() ([]byte, error) {
+	return []byte("# Tasks"), nil
+}
+
+func (w *markdownWriter) Write(task *pb.Task, listName string) ([]byte, error) {
+	var b strings.Builder
+	indent := ""
+	if task.ParentId != "" {
+		indent = "  "
+	} else if listName != w.listName || !w.started {
+		// Задачи без списка идут первыми и выводятся без заголовка
+		if listName != "" {
+			b.WriteString("\n## " + singleLine(listName) + "\n\n")
+		} else {
+			b.WriteString("\n")
+		}
+		w.listName = listName
+	}
+	w.started = true
+
+	mark := "[ ]"
+	if task.Completed {
+		mark = "[x]"
+	}
+	b.WriteString(indent + "- " + mark + " " + singleLine(task.Title))
+	if task.DueAt != nil {
+		b.WriteString(" (due " + task.DueAt.AsTime().UTC().Format("2006-01-02 15:04") + ")")
+	}
+	for _, tag := range task.Tags {
+		b.WriteString(" #" + strings.ReplaceAll(tag, " ", "_"))
+	}
+	if task.ArchivedAt != nil {
+		b.WriteString(" _archived_")
+	}
+
+	if description := strings.TrimSpace(task.Description); description != "" {
+		for _, line := range strings.Split(description, "\n") {
+			b.WriteString("\n" + indent + "  " + strings.TrimRight(line, "\r"))
+		}
+	}
+	return []byte(b.String()), nil
+}
+
+func (w *markdownWriter) End() ([]byte, error) {
+	return nil, nil
+}
+
+// singleLine заменяет переводы строк пробелами, чтобы не сломать разметку
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,339 @@
+// Package ical читает и пишет данные в формате iCalendar (RFC 5545)
+// в объеме, нужном для задач: компоненты VCALENDAR и VTODO.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Максимальная длина строки в октетах без CRLF; длинные строки переносятся
+const maxLineOctets = 75
+
+// Форматы даты и времени iCalendar
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405"
+	utcLayout      = "20060102T150405Z"
+)
+
+// Property - свойство компонента. Value хранится в том виде, в каком оно
+// записано в файле; для текстовых значений используйте Text и AddText.
+type Property struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// Component - компонент iCalendar (VCALENDAR, VTODO и т.д.)
+type Component struct {
+	Name       string
+	Properties []Property
+	Children   []*Component
+}
+
+func NewComponent(name string) *Component {
+	return &Component{Name: name}
+}
+
+// Add добавляет свойство со значением без экранирования (даты, числа, UID)
+func (c *Component) Add(name, value string) {
+	c.Properties = append(c.Properties, Property{Name: name, Value: value})
+}
+
+// AddText добавляет текстовое свойство, экранируя спецсимволы
+func (c *Component) AddText(name, text string) {
+	c.Add(name, EscapeText(text))
+}
+
+// AddTime добавляет свойство с датой и временем в UTC
+func (c *Component) AddTime(name string, t time.Time) {
+	c.Add(name, t.UTC().Format(utcLayout))
+}
+
+// Get возвращает первое свойство с указанным именем
+func (c *Component) Get(name string) *Property {
+	for i := range c.Properties {
+		if c.Properties[i].Name == name {
+			return &c.Properties[i]
+		}
+	}
+	return nil
+}
+
+// GetAll возвращает все свойства с указанным именем
+func (c *Component) GetAll(name string) []Property {
+	var props []Property
+	for _, prop := range c.Properties {
+		if prop.Name == name {
+			props = append(props, prop)
+		}
+	}
+	return props
+}
+
+// Text возвращает текстовое значение свойства без экранирования или пустую строку
+func (c *Component) Text(name string) string {
+	if prop := c.Get(name); prop != nil {
+		return UnescapeText(prop.Value)
+	}
+	return ""
+}
+
+// Time разбирает значение свойства как дату или дату со временем.
+// Возвращает nil, если свойства нет.
+func (c *Component) Time(name string) (*time.Time, error) {
+	prop := c.Get(name)
+	if prop == nil {
+		return nil, nil
+	}
+	t, err := prop.Time()
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Time разбирает значение DATE или DATE-TIME. Время без зоны считается временем
+// зоны из параметра TZID, а если его нет или зона неизвестна - UTC.
+func (p *Property) Time() (time.Time, error) {
+	value := strings.TrimSpace(p.Value)
+
+	loc := time.UTC
+	if tzid := p.Params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(strings.Trim(tzid, "/")); err == nil {
+			loc = l
+		}
+	}
+
+	switch {
+	case strings.HasSuffix(value, "Z"):
+		if t, err := time.Parse(utcLayout, value); err == nil {
+			return t, nil
+		}
+	case len(value) == len(dateLayout):
+		if t, err := time.ParseInLocation(dateLayout, value, loc); err == nil {
+			return t, nil
+		}
+	default:
+		if t, err := time.ParseInLocation(dateTimeLayout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid %s value %q", p.Name, p.Value)
+}
+
+// Decode читает все компоненты верхнего уровня (обычно один VCALENDAR)
+func Decode(r io.Reader) ([]*Component, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var roots []*Component
+	var stack []*Component
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		prop, err := parseLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		switch prop.Name {
+		case "BEGIN":
+			component := NewComponent(strings.ToUpper(prop.Value))
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, component)
+			} else {
+				roots = append(roots, component)
+			}
+			stack = append(stack, component)
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].Name != strings.ToUpper(prop.Value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", i+1, prop.Value)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("line %d: property %s outside of a component", i+1, prop.Name)
+			}
+			current := stack[len(stack)-1]
+			current.Properties = append(current.Properties, prop)
+		}
+	}
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("component %s is not closed", stack[len(stack)-1].Name)
+	}
+	return roots, nil
+}
+
+// Encode записывает компонент с вложенными компонентами, перенося длинные строки
+func Encode(w io.Writer, c *Component) error {
+	bw := bufio.NewWriter(w)
+	writeComponent(bw, c)
+	return bw.Flush()
+}
+
+func writeComponent(w *bufio.Writer, c *Component) {
+	writeLine(w, "BEGIN:"+c.Name)
+	for _, prop := range c.Properties {
+		var line strings.Builder
+		line.WriteString(prop.Name)
+		for _, name := range slices.Sorted(maps.Keys(prop.Params)) {
+			value := prop.Params[name]
+			line.WriteString(";" + name + "=")
+			if strings.ContainsAny(value, ";:,") {
+				value = `"` + value + `"`
+			}
+			line.WriteString(value)
+		}
+		line.WriteString(":" + prop.Value)
+		writeLine(w, line.String())
+	}
+	for _, child := range c.Children {
+		writeComponent(w, child)
+	}
+	writeLine(w, "END:"+c.Name)
+}
+
+// writeLine пишет строку, перенося ее по 75 октетов без разрыва символов UTF-8
+func writeLine(w *bufio.Writer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		// Пробел в начале строки продолжения тоже занимает октет
+		limit = maxLineOctets - 1
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+// unfold склеивает строки продолжения (начинаются с пробела или табуляции)
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 10<<20)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if len(line) > 0 && (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read calendar: %w", err)
+	}
+	return lines, nil
+}
+
+// parseLine разбирает строку вида NAME;PARAM=value;PARAM="quoted value":VALUE
+func parseLine(line string) (Property, error) {
+	prop := Property{}
+
+	end := strings.IndexAny(line, ";:")
+	if end <= 0 {
+		return prop, fmt.Errorf("invalid content line %q", line)
+	}
+	prop.Name = strings.ToUpper(line[:end])
+
+	rest := line[end:]
+	for strings.HasPrefix(rest, ";") {
+		rest = rest[1:]
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return prop, fmt.Errorf("invalid parameter in %s", prop.Name)
+		}
+		name := strings.ToUpper(rest[:eq])
+		rest = rest[eq+1:]
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			closing := strings.IndexByte(rest[1:], '"')
+			if closing < 0 {
+				return prop, fmt.Errorf("unterminated quoted parameter in %s", prop.Name)
+			}
+			value = rest[1 : closing+1]
+			rest = rest[closing+2:]
+		} else {
+			next := strings.IndexAny(rest, ";:")
+			if next < 0 {
+				return prop, fmt.Errorf("missing value in %s", prop.Name)
+			}
+			value = rest[:next]
+			rest = rest[next:]
+		}
+
+		if prop.Params == nil {
+			prop.Params = make(map[string]string)
+		}
+		prop.Params[name] = value
+	}
+
+	if !strings.HasPrefix(rest, ":") {
+		return prop, fmt.Errorf("missing value in %s", prop.Name)
+	}
+	prop.Value = rest[1:]
+	return prop, nil
+}
+
+// EscapeText экранирует текст для значения свойства
+func EscapeText(text string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	return replacer.Replace(text)
+}
+
+// UnescapeText снимает экранирование текстового значения
+func UnescapeText(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i+1 == len(value) {
+			b.WriteByte(value[i])
+			continue
+		}
+		i++
+		switch value[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(value[i])
+		}
+	}
+	return b.String()
+}
+
+// SplitText разбивает список текстовых значений (например, CATEGORIES) по неэкранированным запятым
+func SplitText(value string) []string {
+	var items []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case ',':
+			items = append(items, UnescapeText(value[start:i]))
+			start = i + 1
+		}
+	}
+	return append(items, UnescapeText(value[start:]))
+}
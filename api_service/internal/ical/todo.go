@@ -0,0 +1,122 @@
+package ical
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+)
+
+// ProductID - значение PRODID календарей, которые формирует сервис
+const ProductID = "-//checklist-app//Checklist//EN"
+
+// Тег приоритета задачи. В iCalendar приоритет - число от 1 (высший) до 9 (низший),
+// в тегах он хранится буквой, как в Todo.txt: priority:A соответствует 1, priority:I - 9.
+// Также понимаются теги priority:high, priority:medium, priority:low и priority:1..9.
+const PriorityTagPrefix = "priority:"
+
+// NewCalendar создает пустой VCALENDAR
+func NewCalendar(name string) *Component {
+	calendar := NewComponent("VCALENDAR")
+	calendar.Add("VERSION", "2.0")
+	calendar.Add("PRODID", ProductID)
+	calendar.Add("CALSCALE", "GREGORIAN")
+	if name != "" {
+		calendar.AddText("X-WR-CALNAME", name)
+	}
+	return calendar
+}
+
+// TodoFromTask преобразует задачу в VTODO. parentUID - UID родительской задачи
+// для RELATED-TO, stamp - время формирования календаря (DTSTAMP).
+func TodoFromTask(task *pb.Task, parentUID string, stamp time.Time) *Component {
+	todo := NewComponent("VTODO")
+	todo.AddText("UID", task.IcalUid)
+	todo.AddTime("DTSTAMP", stamp)
+	if task.CreatedAt != nil {
+		todo.AddTime("CREATED", task.CreatedAt.AsTime())
+	}
+	todo.AddText("SUMMARY", task.Title)
+	if task.Description != "" {
+		todo.AddText("DESCRIPTION", task.Description)
+	}
+	if task.DueAt != nil {
+		todo.AddTime("DUE", task.DueAt.AsTime())
+	}
+
+	if task.Completed {
+		todo.Add("STATUS", "COMPLETED")
+		if task.CompletedAt != nil {
+			todo.AddTime("COMPLETED", task.CompletedAt.AsTime())
+		}
+	} else {
+		todo.Add("STATUS", "NEEDS-ACTION")
+	}
+
+	priority, categories := PriorityFromTags(task.Tags)
+	if priority > 0 {
+		todo.Add("PRIORITY", strconv.Itoa(priority))
+	}
+	if len(categories) > 0 {
+		escaped := make([]string, len(categories))
+		for i, category := range categories {
+			escaped[i] = EscapeText(category)
+		}
+		todo.Add("CATEGORIES", strings.Join(escaped, ","))
+	}
+	if parentUID != "" {
+		todo.AddText("RELATED-TO", parentUID)
+	}
+	return todo
+}
+
+// PriorityFromTags находит приоритет среди тегов и возвращает остальные теги.
+// Если тега приоритета нет, возвращается 0.
+func PriorityFromTags(tags []string) (int, []string) {
+	priority := 0
+	rest := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if value, ok := strings.CutPrefix(tag, PriorityTagPrefix); ok {
+			if p := parsePriority(value); p > 0 {
+				if priority == 0 {
+					priority = p
+				}
+				continue
+			}
+		}
+		rest = append(rest, tag)
+	}
+	return priority, rest
+}
+
+// PriorityTag возвращает тег для приоритета iCalendar или пустую строку для 0 (не задан)
+func PriorityTag(priority int) string {
+	if priority < 1 || priority > 9 {
+		return ""
+	}
+	return PriorityTagPrefix + string(rune('A'+priority-1))
+}
+
+func parsePriority(value string) int {
+	switch strings.ToLower(value) {
+	case "high":
+		return 1
+	case "medium":
+		return 5
+	case "low":
+		return 9
+	}
+	if len(value) != 1 {
+		return 0
+	}
+	switch c := value[0]; {
+	case c >= 'A' && c <= 'I':
+		return int(c-'A') + 1
+	case c >= 'a' && c <= 'i':
+		return int(c-'a') + 1
+	case c >= '1' && c <= '9':
+		return int(c - '0')
+	}
+	return 0
+}
@@ -0,0 +1,135 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Названия колонок CSV; совпадают с выгрузкой /v1/export, допускаются распространенные синонимы
+var (
+	csvTitleColumns       = []string{"title", "name", "task", "content", "subject"}
+	csvDescriptionColumns = []string{"description", "notes", "note"}
+	csvCompletedColumns   = []string{"completed", "done", "status"}
+	csvCompletedAtColumns = []string{"completed_at", "completed date"}
+	csvDueColumns         = []string{"due_at", "due", "due_date", "due date"}
+	csvTagsColumns        = []string{"tags", "labels"}
+	csvListColumns        = []string{"list", "list_name", "project"}
+	csvIDColumns          = []string{"id"}
+	csvParentColumns      = []string{"parent_id", "parent"}
+)
+
+// parseCSV разбирает CSV с заголовком. Строки нумеруются как записи файла, заголовок - строка 1.
+// Теги в одной ячейке разделяются точкой с запятой или запятой.
+func parseCSV(r io.Reader) (*Result, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if findColumn(columns, csvTitleColumns) < 0 {
+		return nil, fmt.Errorf("CSV header must contain a title column")
+	}
+
+	result := &Result{}
+	links := newLinker()
+	row := 1
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		row++
+		if err != nil {
+			result.Total++
+			result.addError(row, "invalid CSV: %v", err)
+			continue
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+		result.Total++
+
+		get := func(names []string) string {
+			if i := findColumn(columns, names); i >= 0 && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		task, err := csvTask(get)
+		if err != nil {
+			result.addError(row, "%v", err)
+			continue
+		}
+		task.Row = row
+		if msg := task.validate(); msg != "" {
+			result.addError(row, "%s", msg)
+			continue
+		}
+		if msg := links.add(task, get(csvIDColumns), get(csvParentColumns)); msg != "" {
+			result.addError(row, "%s", msg)
+		}
+	}
+
+	links.link(result)
+	return result, nil
+}
+
+// csvTask собирает задачу из значений колонок
+func csvTask(get func([]string) string) (*Task, error) {
+	task := &Task{
+		Title:       get(csvTitleColumns),
+		Description: get(csvDescriptionColumns),
+		ListName:    get(csvListColumns),
+	}
+
+	var err error
+	if task.Completed, err = parseBool(get(csvCompletedColumns)); err != nil {
+		return nil, err
+	}
+	if task.CompletedAt, err = parseTime(get(csvCompletedAtColumns)); err != nil {
+		return nil, fmt.Errorf("completed_at: %w", err)
+	}
+	if task.DueAt, err = parseTime(get(csvDueColumns)); err != nil {
+		return nil, fmt.Errorf("due_at: %w", err)
+	}
+
+	tags := strings.FieldsFunc(get(csvTagsColumns), func(r rune) bool { return r == ';' || r == ',' })
+	for _, tag := range tags {
+		task.addTag(tag)
+	}
+	return task, nil
+}
+
+// findColumn возвращает индекс первой найденной колонки или -1
+func findColumn(columns map[string]int, names []string) int {
+	for _, name := range names {
+		if i, ok := columns[name]; ok {
+			return i
+		}
+	}
+	return -1
+}
+
+func isBlankRecord(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,151 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/ical"
+)
+
+// parseICal разбирает компоненты VTODO из календарей .ics, остальные компоненты
+// (VEVENT, VTIMEZONE) пропускаются. PRIORITY становится тегом priority:A..I,
+// CATEGORIES - тегами, RELATED-TO связывает подзадачу с родителем по UID.
+// Строка ошибки - номер VTODO в файле, начиная с 1.
+func parseICal(r io.Reader) (*Result, error) {
+	components, err := ical.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid iCalendar: %w", err)
+	}
+
+	var todos []*ical.Component
+	found := false
+	for _, component := range components {
+		if component.Name != "VCALENDAR" {
+			continue
+		}
+		found = true
+		for _, child := range component.Children {
+			if child.Name == "VTODO" {
+				todos = append(todos, child)
+			}
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("invalid iCalendar: no VCALENDAR found")
+	}
+
+	result := &Result{}
+	links := newLinker()
+	for i, todo := range todos {
+		row := i + 1
+		result.Total++
+
+		task, err := icalTask(todo)
+		if err != nil {
+			result.addError(row, "%v", err)
+			continue
+		}
+		task.Row = row
+		if msg := task.validate(); msg != "" {
+			result.addError(row, "%s", msg)
+			continue
+		}
+
+		if msg := links.add(task, task.UID, icalParentUID(todo)); msg != "" {
+			result.addError(row, "%s", msg)
+		}
+	}
+
+	links.link(result)
+	return result, nil
+}
+
+// ErrUnsupportedComponent - в календарном объекте есть компоненты кроме VTODO и VTIMEZONE
+var ErrUnsupportedComponent = errors.New("calendar object must contain only VTODO components")
+
+// ParseCalendarObject разбирает один календарный объект CalDAV (RFC 4791, раздел 4.1):
+// VCALENDAR ровно с одной задачей VTODO, у которой есть UID.
+// Возвращает задачу и UID родителя из RELATED-TO.
+func ParseCalendarObject(r io.Reader) (*Task, string, error) {
+	components, err := ical.Decode(r)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid iCalendar: %w", err)
+	}
+	if len(components) != 1 || components[0].Name != "VCALENDAR" {
+		return nil, "", fmt.Errorf("calendar object must contain exactly one VCALENDAR")
+	}
+
+	var todo *ical.Component
+	for _, child := range components[0].Children {
+		switch child.Name {
+		case "VTIMEZONE":
+		case "VTODO":
+			if todo != nil {
+				return nil, "", fmt.Errorf("calendar object must contain exactly one VTODO")
+			}
+			todo = child
+		default:
+			return nil, "", ErrUnsupportedComponent
+		}
+	}
+	if todo == nil {
+		return nil, "", ErrUnsupportedComponent
+	}
+
+	task, err := icalTask(todo)
+	if err != nil {
+		return nil, "", err
+	}
+	if task.UID == "" {
+		return nil, "", fmt.Errorf("UID is required")
+	}
+	if msg := task.validate(); msg != "" {
+		return nil, "", errors.New(msg)
+	}
+	return task, icalParentUID(todo), nil
+}
+
+// icalTask преобразует VTODO в задачу
+func icalTask(todo *ical.Component) (*Task, error) {
+	task := &Task{
+		Title:       strings.TrimSpace(todo.Text("SUMMARY")),
+		Description: todo.Text("DESCRIPTION"),
+		UID:         strings.TrimSpace(todo.Text("UID")),
+	}
+
+	var err error
+	if task.DueAt, err = todo.Time("DUE"); err != nil {
+		return nil, err
+	}
+	if task.CompletedAt, err = todo.Time("COMPLETED"); err != nil {
+		return nil, err
+	}
+	task.Completed = strings.EqualFold(todo.Text("STATUS"), "COMPLETED") || task.CompletedAt != nil
+
+	if prop := todo.Get("PRIORITY"); prop != nil {
+		priority, err := strconv.Atoi(strings.TrimSpace(prop.Value))
+		if err != nil || priority < 0 || priority > 9 {
+			return nil, fmt.Errorf("invalid PRIORITY value %q", prop.Value)
+		}
+		task.addTag(ical.PriorityTag(priority))
+	}
+	for _, prop := range todo.GetAll("CATEGORIES") {
+		for _, category := range ical.SplitText(prop.Value) {
+			task.addTag(category)
+		}
+	}
+	return task, nil
+}
+
+// icalParentUID возвращает UID родительской задачи из RELATED-TO (RELTYPE=PARENT по умолчанию)
+func icalParentUID(todo *ical.Component) string {
+	for _, prop := range todo.GetAll("RELATED-TO") {
+		if reltype := prop.Params["RELTYPE"]; reltype == "" || strings.EqualFold(reltype, "PARENT") {
+			return strings.TrimSpace(ical.UnescapeText(prop.Value))
+		}
+	}
+	return ""
+}
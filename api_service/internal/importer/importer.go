@@ -0,0 +1,243 @@
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Поддерживаемые форматы импорта
+const (
+	FormatCSV     = "csv"
+	FormatTodoTxt = "todotxt"
+	FormatJSON    = "json"
+	FormatICal    = "ics"
+)
+
+// Ограничения импорта
+const (
+	MaxFileSize   = 10 << 20 // 10 МБ
+	MaxTasks      = 5000
+	maxTitleLen   = 255
+	maxListLen    = 255
+	maxUIDLen     = 255
+	maxTagsPerRow = 50
+)
+
+// Некоторые программы (например, Excel) пишут BOM в начало UTF-8 файла
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// Task - задача, прочитанная из файла
+type Task struct {
+	Row         int
+	Title       string
+	Description string
+	Completed   bool
+	CompletedAt *time.Time
+	DueAt       *time.Time
+	Tags        []string
+	ListName    string
+	UID         string // UID из .ics: задача с таким UID обновляется вместо создания новой
+	Subtasks    []*Task
+}
+
+// RowError - ошибка в строке файла (для JSON - в элементе массива)
+type RowError struct {
+	Row     int
+	Message string
+}
+
+// Result - задачи и ошибки, найденные при разборе файла
+type Result struct {
+	Tasks  []*Task
+	Errors []RowError
+	Total  int // число задач, включая подзадачи и строки с ошибками
+}
+
+func (r *Result) addError(row int, format string, args ...any) {
+	r.Errors = append(r.Errors, RowError{Row: row, Message: fmt.Sprintf(format, args...)})
+}
+
+// Parse разбирает файл в указанном формате. Ошибки отдельных строк попадают
+// в Result.Errors, ошибка возвращается, только если файл нельзя прочитать целиком.
+func Parse(format string, r io.Reader) (*Result, error) {
+	var parse func(io.Reader) (*Result, error)
+	switch NormalizeFormat(format) {
+	case FormatCSV:
+		parse = parseCSV
+	case FormatTodoTxt:
+		parse = parseTodoTxt
+	case FormatJSON:
+		parse = parseJSON
+	case FormatICal:
+		parse = parseICal
+	default:
+		return nil, fmt.Errorf("unsupported import format %q, expected csv, todotxt, json or ics", format)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, MaxFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if len(data) > MaxFileSize {
+		return nil, fmt.Errorf("file is larger than %d MB", MaxFileSize>>20)
+	}
+
+	result, err := parse(bytes.NewReader(bytes.TrimPrefix(data, utf8BOM)))
+	if err != nil {
+		return nil, err
+	}
+	if result.Total > MaxTasks {
+		return nil, fmt.Errorf("file contains %d tasks, at most %d can be imported at once", result.Total, MaxTasks)
+	}
+	return result, nil
+}
+
+// NormalizeFormat приводит название формата к константе; расширения файлов тоже допускаются
+func NormalizeFormat(format string) string {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "csv":
+		return FormatCSV
+	case "todotxt", "todo.txt", "txt":
+		return FormatTodoTxt
+	case "json":
+		return FormatJSON
+	case "ics", "ical", "icalendar":
+		return FormatICal
+	}
+	return ""
+}
+
+// FormatFromFilename определяет формат по имени файла
+func FormatFromFilename(name string) string {
+	name = strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(name, ".csv"):
+		return FormatCSV
+	case strings.HasSuffix(name, ".json"):
+		return FormatJSON
+	case strings.HasSuffix(name, ".txt"):
+		return FormatTodoTxt
+	case strings.HasSuffix(name, ".ics"):
+		return FormatICal
+	}
+	return ""
+}
+
+// validate проверяет поля задачи и возвращает описание первой ошибки
+func (t *Task) validate() string {
+	switch {
+	case t.Title == "":
+		return "title is required"
+	case utf8.RuneCountInString(t.Title) > maxTitleLen:
+		return fmt.Sprintf("title is longer than %d characters", maxTitleLen)
+	case utf8.RuneCountInString(t.ListName) > maxListLen:
+		return fmt.Sprintf("list name is longer than %d characters", maxListLen)
+	case len(t.UID) > maxUIDLen:
+		return fmt.Sprintf("UID is longer than %d characters", maxUIDLen)
+	case len(t.Tags) > maxTagsPerRow:
+		return fmt.Sprintf("more than %d tags", maxTagsPerRow)
+	}
+	return ""
+}
+
+// addTag добавляет тег без пробелов по краям и без повторов
+func (t *Task) addTag(tag string) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return
+	}
+	for _, existing := range t.Tags {
+		if existing == tag {
+			return
+		}
+	}
+	t.Tags = append(t.Tags, tag)
+}
+
+// Форматы дат, которые принимаются в файлах импорта
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// parseTime разбирает дату; время без часового пояса считается UTC
+func parseTime(value string) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid date %q", value)
+}
+
+// parseBool разбирает признак выполнения задачи
+func parseBool(value string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "false", "0", "no", "n", "f", "open", "todo", "pending", "active":
+		return false, nil
+	case "true", "1", "yes", "y", "x", "t", "done", "completed":
+		return true, nil
+	}
+	return false, fmt.Errorf("invalid completed value %q", value)
+}
+
+// linker связывает подзадачи с родителями по идентификаторам из файла (id/parent_id)
+type linker struct {
+	byID     map[string]*Task
+	parentOf map[*Task]string
+	order    []*Task
+}
+
+func newLinker() *linker {
+	return &linker{
+		byID:     make(map[string]*Task),
+		parentOf: make(map[*Task]string),
+	}
+}
+
+// add запоминает задачу; возвращает текст ошибки, если id уже встречался
+func (l *linker) add(task *Task, id, parentID string) string {
+	if id != "" {
+		if other, ok := l.byID[id]; ok {
+			return fmt.Sprintf("duplicate id %q, first used in row %d", id, other.Row)
+		}
+		l.byID[id] = task
+	}
+	l.parentOf[task] = parentID
+	l.order = append(l.order, task)
+	return ""
+}
+
+// link раскладывает задачи по родителям в порядке файла. Допускается один уровень подзадач.
+func (l *linker) link(result *Result) {
+	for _, task := range l.order {
+		parentID := l.parentOf[task]
+		if parentID == "" {
+			result.Tasks = append(result.Tasks, task)
+			continue
+		}
+
+		parent, ok := l.byID[parentID]
+		switch {
+		case !ok:
+			result.addError(task.Row, "parent task %q not found in file", parentID)
+		case parent == task:
+			result.addError(task.Row, "task cannot be its own parent")
+		case l.parentOf[parent] != "":
+			result.addError(task.Row, "subtasks cannot have their own subtasks")
+		default:
+			parent.Subtasks = append(parent.Subtasks, task)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Поля JSON; кроме формата выгрузки /v1/export поддерживаются названия полей
+// распространенных менеджеров задач (name/content, notes, labels, project, due.date)
+var (
+	jsonTitleFields       = []string{"title", "name", "content", "text"}
+	jsonDescriptionFields = []string{"description", "notes", "note", "desc"}
+	jsonCompletedFields   = []string{"completed", "done", "checked", "is_completed", "status"}
+	jsonCompletedAtFields = []string{"completed_at", "completedAt", "completed_date"}
+	jsonDueFields         = []string{"due_at", "due", "due_date", "dueDate"}
+	jsonTagsFields        = []string{"tags", "labels"}
+	jsonListFields        = []string{"list", "list_name", "listName", "project"}
+	jsonSubtaskFields     = []string{"subtasks", "children", "items"}
+	jsonIDFields          = []string{"id"}
+	jsonParentFields      = []string{"parent_id", "parentId", "parent"}
+)
+
+// parseJSON разбирает массив задач или объект с массивом "tasks".
+// Подзадачи задаются вложенным массивом или ссылкой parent_id на id другой задачи.
+// Строка ошибки - номер элемента верхнего уровня, начиная с 1.
+func parseJSON(r io.Reader) (*Result, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	var document json.RawMessage
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(document, &items); err != nil {
+		var wrapper struct {
+			Tasks []json.RawMessage `json:"tasks"`
+		}
+		if err := json.Unmarshal(document, &wrapper); err != nil || wrapper.Tasks == nil {
+			return nil, fmt.Errorf("invalid JSON: expected an array of tasks or an object with a \"tasks\" array")
+		}
+		items = wrapper.Tasks
+	}
+
+	result := &Result{}
+	links := newLinker()
+	for i, raw := range items {
+		row := i + 1
+		result.Total++
+
+		object, err := decodeJSONObject(raw)
+		if err != nil {
+			result.addError(row, "%v", err)
+			continue
+		}
+
+		task, err := jsonTask(object)
+		if err != nil {
+			result.addError(row, "%v", err)
+			continue
+		}
+		task.Row = row
+		if msg := task.validate(); msg != "" {
+			result.addError(row, "%s", msg)
+			continue
+		}
+
+		subtasks, err := object.getArray(jsonSubtaskFields...)
+		if err != nil {
+			result.addError(row, "%v", err)
+			continue
+		}
+		for j, rawSubtask := range subtasks {
+			result.Total++
+			subtask, msg := jsonSubtask(rawSubtask)
+			if msg != "" {
+				result.addError(row, "subtask %d: %s", j+1, msg)
+				continue
+			}
+			subtask.Row = row
+			task.Subtasks = append(task.Subtasks, subtask)
+		}
+
+		id, _ := object.getString(jsonIDFields...)
+		parentID, err := object.getString(jsonParentFields...)
+		if err != nil {
+			result.addError(row, "%v", err)
+			continue
+		}
+		if parentID != "" && len(task.Subtasks) > 0 {
+			result.addError(row, "subtasks cannot have their own subtasks")
+			continue
+		}
+		if msg := links.add(task, id, parentID); msg != "" {
+			result.addError(row, "%s", msg)
+		}
+	}
+
+	links.link(result)
+	return result, nil
+}
+
+// jsonSubtask разбирает вложенную подзадачу; у нее не может быть своих подзадач
+func jsonSubtask(raw json.RawMessage) (*Task, string) {
+	object, err := decodeJSONObject(raw)
+	if err != nil {
+		return nil, err.Error()
+	}
+	if subtasks, _ := object.getArray(jsonSubtaskFields...); len(subtasks) > 0 {
+		return nil, "subtasks cannot have their own subtasks"
+	}
+
+	task, err := jsonTask(object)
+	if err != nil {
+		return nil, err.Error()
+	}
+	if msg := task.validate(); msg != "" {
+		return nil, msg
+	}
+	return task, ""
+}
+
+// jsonTask собирает задачу из полей объекта
+func jsonTask(object jsonObject) (*Task, error) {
+	task := &Task{}
+
+	var err error
+	if task.Title, err = object.getString(jsonTitleFields...); err != nil {
+		return nil, err
+	}
+	task.Title = strings.TrimSpace(task.Title)
+	if task.Description, err = object.getString(jsonDescriptionFields...); err != nil {
+		return nil, err
+	}
+	task.Description = strings.TrimSpace(task.Description)
+	if task.ListName, err = object.getString(jsonListFields...); err != nil {
+		return nil, err
+	}
+	task.ListName = strings.TrimSpace(task.ListName)
+
+	if task.Completed, err = object.getBool(jsonCompletedFields...); err != nil {
+		return nil, err
+	}
+	if task.CompletedAt, err = object.getTime(jsonCompletedAtFields...); err != nil {
+		return nil, err
+	}
+	if task.DueAt, err = object.getTime(jsonDueFields...); err != nil {
+		return nil, err
+	}
+
+	tags, err := object.getStrings(jsonTagsFields...)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		task.addTag(tag)
+	}
+
+	return task, nil
+}
+
+// jsonObject - поля JSON объекта задачи
+type jsonObject map[string]json.RawMessage
+
+func decodeJSONObject(raw json.RawMessage) (jsonObject, error) {
+	var object jsonObject
+	if err := json.Unmarshal(raw, &object); err != nil || object == nil {
+		return nil, fmt.Errorf("task must be a JSON object")
+	}
+	return object, nil
+}
+
+// field возвращает первое заданное (не null) поле из списка
+func (o jsonObject) field(keys ...string) (string, json.RawMessage) {
+	for _, key := range keys {
+		if raw, ok := o[key]; ok && !bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+			return key, raw
+		}
+	}
+	return "", nil
+}
+
+// getString читает строку; числа тоже принимаются (например, числовые id)
+func (o jsonObject) getString(keys ...string) (string, error) {
+	key, raw := o.field(keys...)
+	if raw == nil {
+		return "", nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err == nil {
+		return n.String(), nil
+	}
+	return "", fmt.Errorf("field %q must be a string", key)
+}
+
+// getBool читает признак выполнения: true/false или строку статуса ("done", "completed")
+func (o jsonObject) getBool(keys ...string) (bool, error) {
+	key, raw := o.field(keys...)
+	if raw == nil {
+		return false, nil
+	}
+
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return parseBool(s)
+	}
+	return false, fmt.Errorf("field %q must be a boolean", key)
+}
+
+// getTime читает дату строкой или объектом с полем "date"
+func (o jsonObject) getTime(keys ...string) (*time.Time, error) {
+	key, raw := o.field(keys...)
+	if raw == nil {
+		return nil, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		var nested struct {
+			Date string `json:"date"`
+		}
+		if err := json.Unmarshal(raw, &nested); err != nil {
+			return nil, fmt.Errorf("field %q must be a date string", key)
+		}
+		s = nested.Date
+	}
+
+	t, err := parseTime(s)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", key, err)
+	}
+	return t, nil
+}
+
+// getStrings читает массив строк или одну строку с разделителями , или ;
+func (o jsonObject) getStrings(keys ...string) ([]string, error) {
+	key, raw := o.field(keys...)
+	if raw == nil {
+		return nil, nil
+	}
+
+	var values []string
+	if err := json.Unmarshal(raw, &values); err == nil {
+		return values, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return strings.FieldsFunc(s, func(r rune) bool { return r == ';' || r == ',' }), nil
+	}
+	return nil, fmt.Errorf("field %q must be an array of strings", key)
+}
+
+// getArray читает массив вложенных объектов
+func (o jsonObject) getArray(keys ...string) ([]json.RawMessage, error) {
+	key, raw := o.field(keys...)
+	if raw == nil {
+		return nil, nil
+	}
+
+	var values []json.RawMessage
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, fmt.Errorf("field %q must be an array", key)
+	}
+	return values, nil
+}
@@ -0,0 +1,105 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// parseTodoTxt разбирает файл в формате Todo.txt (https://github.com/todotxt/todo.txt):
+//
+//	x 2024-01-03 2024-01-01 (A) Call Mom +Family @phone due:2024-01-05
+//
+// Приоритет, проекты (+) и контексты (@) становятся тегами priority:A, project:Family
+// и context:phone, due: - сроком задачи. Дата создания не переносится: задачи
+// создаются с текущим временем. Строки нумеруются по номеру строки в файле.
+func parseTodoTxt(r io.Reader) (*Result, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), MaxFileSize)
+
+	result := &Result{}
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		result.Total++
+
+		task, err := parseTodoLine(text)
+		if err != nil {
+			result.addError(line, "%v", err)
+			continue
+		}
+		task.Row = line
+		if msg := task.validate(); msg != "" {
+			result.addError(line, "%s", msg)
+			continue
+		}
+		result.Tasks = append(result.Tasks, task)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return result, nil
+}
+
+// parseTodoLine разбирает одну строку Todo.txt
+func parseTodoLine(line string) (*Task, error) {
+	task := &Task{}
+	fields := strings.Fields(line)
+
+	i := 0
+	if fields[i] == "x" {
+		task.Completed = true
+		i++
+		if i < len(fields) && isTodoDate(fields[i]) {
+			task.CompletedAt, _ = parseTime(fields[i])
+			i++
+		}
+	}
+	if i < len(fields) && isTodoPriority(fields[i]) {
+		task.addTag("priority:" + fields[i][1:2])
+		i++
+	}
+	if i < len(fields) && isTodoDate(fields[i]) {
+		i++
+	}
+
+	var words []string
+	for _, field := range fields[i:] {
+		switch {
+		case len(field) > 1 && field[0] == '+':
+			task.addTag("project:" + field[1:])
+		case len(field) > 1 && field[0] == '@':
+			task.addTag("context:" + field[1:])
+		case strings.HasPrefix(field, "due:"):
+			due, err := parseTime(field[len("due:"):])
+			if err != nil {
+				return nil, fmt.Errorf("due: %w", err)
+			}
+			task.DueAt = due
+		case strings.HasPrefix(field, "pri:") && isTodoPriority("("+field[len("pri:"):]+")"):
+			task.addTag("priority:" + field[len("pri:"):])
+		default:
+			words = append(words, field)
+		}
+	}
+	task.Title = strings.Join(words, " ")
+
+	return task, nil
+}
+
+func isTodoDate(s string) bool {
+	_, err := time.Parse("2006-01-02", s)
+	return err == nil
+}
+
+// isTodoPriority проверяет приоритет вида (A)..(Z)
+func isTodoPriority(s string) bool {
+	return len(s) == 3 && s[0] == '(' && s[2] == ')' && s[1] >= 'A' && s[1] <= 'Z'
+}
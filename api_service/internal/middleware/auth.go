@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"net/http"
 	"strings"
 
 	"github.com/bagdasarian/checklist-app/api_service/internal/service"
@@ -38,33 +39,84 @@ func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "metadata is not provided")
+		ctx, err := interceptor.authorize(ctx)
+		if err != nil {
+			return nil, err
 		}
 
-		authHeaders := md.Get(authorizationHeader)
-		if len(authHeaders) == 0 {
-			return nil, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
-		}
+		return handler(ctx, req)
+	}
+}
 
-		accessToken := authHeaders[0]
-		if !strings.HasPrefix(accessToken, bearerPrefix) {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid authorization header format")
+// Stream возвращает интерсептор для аутентификации потоковых методов
+func (interceptor *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if isPublicMethod(info.FullMethod) {
+			return handler(srv, stream)
 		}
 
-		accessToken = strings.TrimPrefix(accessToken, bearerPrefix)
-
-		claims, err := interceptor.jwtManager.Verify(accessToken)
+		ctx, err := interceptor.authorize(stream.Context())
 		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
+			return err
 		}
 
-		ctx = context.WithValue(ctx, "user_id", claims.UserID)
-		ctx = context.WithValue(ctx, "username", claims.Username)
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+	}
+}
 
-		return handler(ctx, req)
+// AuthenticateHTTP проверяет JWT токен запроса, обработанного в обход gRPC (например,
+// multipart-загрузки), и возвращает контекст с данными пользователя и Idempotency-Key
+func (interceptor *AuthInterceptor) AuthenticateHTTP(r *http.Request) (context.Context, error) {
+	md := metadata.Pairs(authorizationHeader, r.Header.Get("Authorization"))
+	if key := r.Header.Get(IdempotencyKeyHeader); key != "" {
+		md.Set(IdempotencyKeyHeader, key)
+	}
+	return interceptor.authorize(metadata.NewIncomingContext(r.Context(), md))
+}
+
+// authorize проверяет JWT токен из метаданных и добавляет данные пользователя в контекст
+func (interceptor *AuthInterceptor) authorize(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "metadata is not provided")
 	}
+
+	authHeaders := md.Get(authorizationHeader)
+	if len(authHeaders) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+	}
+
+	accessToken := authHeaders[0]
+	if !strings.HasPrefix(accessToken, bearerPrefix) {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid authorization header format")
+	}
+
+	accessToken = strings.TrimPrefix(accessToken, bearerPrefix)
+
+	claims, err := interceptor.jwtManager.Verify(accessToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
+	}
+
+	ctx = context.WithValue(ctx, "user_id", claims.UserID)
+	ctx = context.WithValue(ctx, "username", claims.Username)
+
+	return ctx, nil
+}
+
+// authenticatedStream подменяет контекст потока на контекст с данными пользователя
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
 }
 
 // isPublicMethod проверяет, является ли метод публичным
@@ -72,6 +124,7 @@ func isPublicMethod(method string) bool {
 	publicMethods := []string{
 		"/checklist.api.TaskService/RegisterUser",
 		"/checklist.api.TaskService/LoginUser",
+		"/checklist.api.TaskService/GetCalendarFeed", // доступ по токену ленты
 		"/checklist.TaskService/RegisterUser",
 		"/checklist.TaskService/LoginUser",
 	}
@@ -0,0 +1,308 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	IdempotencyKeyHeader   = "idempotency-key"
+	idempotentReplayHeader = "idempotent-replayed"
+	maxIdempotencyKeyLen   = 255
+
+	// Сколько держится блокировка ключа, пока выполняется запрос без дедлайна (потоковый импорт);
+	// запросу с дедлайном блокировка выдается до дедлайна с запасом idempotencyLockMargin
+	idempotencyLockTTL    = 5 * time.Minute
+	idempotencyLockMargin = 10 * time.Second
+)
+
+// Изменяющие методы, для которых поддерживается Idempotency-Key: унарные методы TaskService
+// с HTTP правилом POST, PUT, PATCH или DELETE. Список строится по описанию сервиса в proto,
+// поэтому новые изменяющие методы попадают в него автоматически. Публичные методы сюда не входят:
+// ключи хранятся отдельно для каждого пользователя. Потоковый ImportTasks проверяет ключ
+// сам через Execute.
+var idempotentMethods = mutatingMethods(pb.File_api_service_proto.Services().ByName("TaskService"))
+
+// mutatingMethods возвращает полные имена изменяющих унарных методов сервиса
+func mutatingMethods(service protoreflect.ServiceDescriptor) map[string]bool {
+	methods := make(map[string]bool)
+	for i := 0; i < service.Methods().Len(); i++ {
+		method := service.Methods().Get(i)
+		fullMethod := fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
+		if method.IsStreamingClient() || method.IsStreamingServer() || isPublicMethod(fullMethod) {
+			continue
+		}
+
+		rule, _ := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+		switch rule.GetPattern().(type) {
+		case *annotations.HttpRule_Post, *annotations.HttpRule_Put, *annotations.HttpRule_Patch, *annotations.HttpRule_Delete:
+			methods[fullMethod] = true
+		}
+	}
+	return methods
+}
+
+// idempotencyRecord - запись в Redis для одного ключа пользователя
+type idempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"`
+	Done        bool   `json:"done"`
+	Response    []byte `json:"response,omitempty"` // anypb.Any с ответом метода
+	// Token - случайный токен запроса, который держит блокировку; снять блокировку или сохранить
+	// ответ может только он, даже если его блокировка истекла и ключ занял другой запрос
+	Token string `json:"token,omitempty"`
+}
+
+// idempotencyStore - хранилище ключей идемпотентности. Значения сравниваются целиком,
+// поэтому запись с токеном запроса изменяет только этот запрос.
+type idempotencyStore interface {
+	// acquire записывает value на ttl, если ключа нет, и возвращает true при успехе
+	acquire(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+	// get возвращает значение ключа или redis.Nil, если ключа нет
+	get(ctx context.Context, key string) ([]byte, error)
+	// replace заменяет значение old на value на ttl и возвращает false, если значение уже другое
+	replace(ctx context.Context, key string, old, value []byte, ttl time.Duration) (bool, error)
+	// release удаляет ключ, если его значение равно old
+	release(ctx context.Context, key string, old []byte) error
+}
+
+type IdempotencyInterceptor struct {
+	store idempotencyStore
+	ttl   time.Duration
+}
+
+func NewIdempotencyInterceptor(redisClient *redis.Client, ttl time.Duration) *IdempotencyInterceptor {
+	return &IdempotencyInterceptor{
+		store: redisIdempotencyStore{client: redisClient},
+		ttl:   ttl,
+	}
+}
+
+// Unary возвращает интерсептор, который повторно отдает сохраненный ответ
+// для запросов с уже использованным Idempotency-Key. Должен идти после AuthInterceptor.
+func (interceptor *IdempotencyInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if !idempotentMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "request is not a proto message")
+		}
+		return interceptor.Execute(ctx, info.FullMethod, func(ctx context.Context) (any, error) {
+			return handler(ctx, req)
+		}, msg)
+	}
+}
+
+// Execute выполняет handler с Idempotency-Key из метаданных ctx: повтор запроса с тем же ключом
+// получает сохраненный ответ. Отпечаток запроса вычисляется по method и сообщениям req.
+// Нужен методам, которые не проходят через Unary (потоковый ImportTasks и HTTP загрузка импорта);
+// без интерсептора (nil) handler выполняется как есть.
+func (interceptor *IdempotencyInterceptor) Execute(ctx context.Context, method string, handler func(ctx context.Context) (any, error), req ...proto.Message) (any, error) {
+	if interceptor == nil {
+		return handler(ctx)
+	}
+
+	key := idempotencyKeyFromContext(ctx)
+	if key == "" {
+		return handler(ctx)
+	}
+	if len(key) > maxIdempotencyKeyLen {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency key must not exceed %d characters", maxIdempotencyKeyLen)
+	}
+
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint, err := requestFingerprint(method, req...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fingerprint request: %v", err)
+	}
+
+	token, err := newIdempotencyToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate idempotency token: %v", err)
+	}
+	pending, err := json.Marshal(idempotencyRecord{Fingerprint: fingerprint, Token: token})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode idempotency record: %v", err)
+	}
+
+	redisKey := fmt.Sprintf("idempotency:user:%s:%s", userID, key)
+	resp, replayed, err := interceptor.lookup(ctx, redisKey, fingerprint, pending)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		// Redis недоступен - выполняем запрос без защиты от повторов
+		log.Printf("Idempotency store unavailable, executing request without it: %v", err)
+		return handler(ctx)
+	}
+	if replayed {
+		grpc.SetHeader(ctx, metadata.Pairs(idempotentReplayHeader, "true"))
+		return resp, nil
+	}
+
+	resp, err = handler(ctx)
+	if err != nil {
+		// Ошибки не сохраняются: клиент может повторить запрос с тем же ключом
+		if delErr := interceptor.store.release(context.WithoutCancel(ctx), redisKey, pending); delErr != nil {
+			log.Printf("Failed to release idempotency key: %v", delErr)
+		}
+		return nil, err
+	}
+
+	if err := interceptor.save(context.WithoutCancel(ctx), redisKey, fingerprint, pending, resp); err != nil {
+		log.Printf("Failed to store idempotent response: %v", err)
+	}
+	return resp, nil
+}
+
+// lookup захватывает ключ для нового запроса, записывая в него pending, или возвращает сохраненный ответ
+func (interceptor *IdempotencyInterceptor) lookup(ctx context.Context, redisKey, fingerprint string, pending []byte) (any, bool, error) {
+	for attempt := 0; attempt < 2; attempt++ {
+		acquired, err := interceptor.store.acquire(ctx, redisKey, pending, lockTTL(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		if acquired {
+			return nil, false, nil
+		}
+
+		data, err := interceptor.store.get(ctx, redisKey)
+		if errors.Is(err, redis.Nil) {
+			continue // ключ истек между SETNX и GET
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		var record idempotencyRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, false, err
+		}
+		if record.Fingerprint != fingerprint {
+			return nil, false, status.Errorf(codes.FailedPrecondition, "idempotency key was already used with a different request")
+		}
+		if !record.Done {
+			return nil, false, status.Errorf(codes.Aborted, "request with this idempotency key is still in progress")
+		}
+
+		var stored anypb.Any
+		if err := proto.Unmarshal(record.Response, &stored); err != nil {
+			return nil, false, err
+		}
+		resp, err := stored.UnmarshalNew()
+		if err != nil {
+			return nil, false, err
+		}
+		return resp, true, nil
+	}
+
+	return nil, false, fmt.Errorf("failed to acquire idempotency key")
+}
+
+// save сохраняет успешный ответ на время жизни ключа, если ключ все еще заблокирован этим запросом (pending)
+func (interceptor *IdempotencyInterceptor) save(ctx context.Context, redisKey, fingerprint string, pending []byte, resp any) error {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return fmt.Errorf("response is not a proto message")
+	}
+
+	stored, err := anypb.New(msg)
+	if err != nil {
+		return err
+	}
+	response, err := proto.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(idempotencyRecord{
+		Fingerprint: fingerprint,
+		Done:        true,
+		Response:    response,
+	})
+	if err != nil {
+		return err
+	}
+
+	replaced, err := interceptor.store.replace(ctx, redisKey, pending, data, interceptor.ttl)
+	if err != nil {
+		return err
+	}
+	if !replaced {
+		return fmt.Errorf("idempotency key lock expired before the response was stored")
+	}
+	return nil
+}
+
+// lockTTL возвращает время блокировки ключа: до дедлайна запроса с запасом или idempotencyLockTTL без дедлайна
+func lockTTL(ctx context.Context) time.Duration {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return idempotencyLockTTL
+	}
+	return max(time.Until(deadline), 0) + idempotencyLockMargin
+}
+
+// newIdempotencyToken возвращает случайный токен запроса
+func newIdempotencyToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// requestFingerprint вычисляет хэш метода и сообщений запроса
+func requestFingerprint(method string, req ...proto.Message) (string, error) {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	for _, msg := range req {
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte{0})
+		hash.Write(body)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// idempotencyKeyFromContext извлекает Idempotency-Key из метаданных запроса
+func idempotencyKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(IdempotencyKeyHeader); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Замена и удаление значения ключа, только если оно не изменилось (compare-and-set / compare-and-delete)
+var (
+	replaceIfEqualScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+    redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+    return 1
+end
+return 0
+`)
+	deleteIfEqualScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+    return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+)
+
+// redisIdempotencyStore хранит ключи идемпотентности в Redis
+type redisIdempotencyStore struct {
+	client *redis.Client
+}
+
+func (s redisIdempotencyStore) acquire(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	return s.client.SetNX(ctx, key, value, ttl).Result()
+}
+
+func (s redisIdempotencyStore) get(ctx context.Context, key string) ([]byte, error) {
+	return s.client.Get(ctx, key).Bytes()
+}
+
+func (s redisIdempotencyStore) replace(ctx context.Context, key string, old, value []byte, ttl time.Duration) (bool, error) {
+	replaced, err := replaceIfEqualScript.Run(ctx, s.client, []string{key}, old, value, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return replaced == 1, nil
+}
+
+func (s redisIdempotencyStore) release(ctx context.Context, key string, old []byte) error {
+	return deleteIfEqualScript.Run(ctx, s.client, []string{key}, old).Err()
+}
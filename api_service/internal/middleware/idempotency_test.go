@@ -0,0 +1,249 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// memoryStore повторяет семантику redisIdempotencyStore (SETNX, GET и сравнивающие скрипты)
+type memoryStore struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{values: make(map[string][]byte)}
+}
+
+func (s *memoryStore) acquire(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.values[key]; ok {
+		return false, nil
+	}
+	s.values[key] = value
+	return true, nil
+}
+
+func (s *memoryStore) get(ctx context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.values[key]
+	if !ok {
+		return nil, redis.Nil
+	}
+	return value, nil
+}
+
+func (s *memoryStore) replace(ctx context.Context, key string, old, value []byte, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !bytes.Equal(s.values[key], old) {
+		return false, nil
+	}
+	s.values[key] = value
+	return true, nil
+}
+
+func (s *memoryStore) release(ctx context.Context, key string, old []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if bytes.Equal(s.values[key], old) {
+		delete(s.values, key)
+	}
+	return nil
+}
+
+// expire удаляет все ключи, как если бы истек их TTL
+func (s *memoryStore) expire() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.values)
+}
+
+const createTaskMethod = "/checklist.api.TaskService/CreateTask"
+
+func idempotentContext(key string) context.Context {
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(IdempotencyKeyHeader, key))
+}
+
+// respond возвращает обработчик, который считает вызовы и отвечает value
+func respond(calls *int, value string) func(ctx context.Context) (any, error) {
+	return func(ctx context.Context) (any, error) {
+		*calls++
+		return wrapperspb.String(value), nil
+	}
+}
+
+func TestIdempotentMethodsCoverMutatingMethods(t *testing.T) {
+	for _, method := range []string{
+		"CreateTask", "UpdateTask", "DeleteTask", "CompleteTask", "RevertTask", "ArchiveTask", "UnarchiveTask",
+		"CreateList", "DeleteList",
+		"CreateTemplate", "UpdateTemplate", "DeleteTemplate", "InstantiateTemplate", "SaveListAsTemplate",
+		"SetArchivePolicy", "ResetCalendarToken", "UpdateSettings", "SetDigestSettings",
+		"CreateWebhook", "UpdateWebhook", "DeleteWebhook", "RedeliverWebhook",
+		"CreateReminder", "DeleteReminder",
+	} {
+		if !idempotentMethods["/checklist.api.TaskService/"+method] {
+			t.Errorf("%s does not accept Idempotency-Key", method)
+		}
+	}
+
+	for _, method := range []string{
+		// Чтение, публичные и потоковые методы
+		"GetTasks", "GetTask", "GetStats", "GetDigest", "GetWebhookDeliveries",
+		"RegisterUser", "LoginUser", "GetCalendarFeed",
+		"WatchTasks", "ExportTasks", "ImportTasks",
+	} {
+		if idempotentMethods["/checklist.api.TaskService/"+method] {
+			t.Errorf("%s must not be handled by the idempotency interceptor", method)
+		}
+	}
+}
+
+func TestRequestFingerprint(t *testing.T) {
+	options := &pb.ImportOptions{Format: "csv"}
+	fingerprint := func(t *testing.T, method string, file string) string {
+		t.Helper()
+		value, err := requestFingerprint(method, options, wrapperspb.Bytes([]byte(file)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return value
+	}
+
+	base := fingerprint(t, "/checklist.api.TaskService/ImportTasks", "title\nfirst\n")
+	if base != fingerprint(t, "/checklist.api.TaskService/ImportTasks", "title\nfirst\n") {
+		t.Fatal("fingerprint of the same request differs")
+	}
+	if base == fingerprint(t, "/checklist.api.TaskService/ImportTasks", "title\nsecond\n") {
+		t.Fatal("fingerprint does not depend on the file")
+	}
+	if base == fingerprint(t, "/checklist.api.TaskService/CreateTask", "title\nfirst\n") {
+		t.Fatal("fingerprint does not depend on the method")
+	}
+}
+
+func TestIdempotencyReplaysStoredResponse(t *testing.T) {
+	interceptor := &IdempotencyInterceptor{store: newMemoryStore(), ttl: time.Hour}
+	req := &pb.CreateTaskRequest{Title: "first"}
+
+	var calls int
+	first, err := interceptor.Execute(idempotentContext("key-1"), createTaskMethod, respond(&calls, "task-1"), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := interceptor.Execute(idempotentContext("key-1"), createTaskMethod, respond(&calls, "task-2"), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if calls != 1 {
+		t.Fatalf("handler was called %d times, want 1", calls)
+	}
+	if !proto.Equal(first.(proto.Message), second.(proto.Message)) {
+		t.Fatalf("replayed response %v, want %v", second, first)
+	}
+}
+
+func TestIdempotencyRejectsDifferentRequestWithSameKey(t *testing.T) {
+	interceptor := &IdempotencyInterceptor{store: newMemoryStore(), ttl: time.Hour}
+
+	var calls int
+	if _, err := interceptor.Execute(idempotentContext("key-1"), createTaskMethod, respond(&calls, "task-1"),
+		&pb.CreateTaskRequest{Title: "first"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := interceptor.Execute(idempotentContext("key-1"), createTaskMethod, respond(&calls, "task-2"),
+		&pb.CreateTaskRequest{Title: "second"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Execute() = %v, want FailedPrecondition", err)
+	}
+	if calls != 1 {
+		t.Fatalf("handler was called %d times, want 1", calls)
+	}
+}
+
+func TestIdempotencyRejectsConcurrentRequest(t *testing.T) {
+	interceptor := &IdempotencyInterceptor{store: newMemoryStore(), ttl: time.Hour}
+	req := &pb.CreateTaskRequest{Title: "first"}
+
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		_, err := interceptor.Execute(idempotentContext("key-1"), createTaskMethod, func(ctx context.Context) (any, error) {
+			close(started)
+			<-finish
+			return wrapperspb.String("task-1"), nil
+		}, req)
+		done <- err
+	}()
+	<-started
+
+	var calls int
+	_, err := interceptor.Execute(idempotentContext("key-1"), createTaskMethod, respond(&calls, "task-2"), req)
+	close(finish)
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("Execute() = %v while the first request is in progress, want Aborted", err)
+	}
+	if calls != 0 {
+		t.Fatalf("handler was called %d times, want 0", calls)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIdempotencyFailedRequestKeepsAnotherRequestsLock(t *testing.T) {
+	store := newMemoryStore()
+	interceptor := &IdempotencyInterceptor{store: store, ttl: time.Hour}
+	req := &pb.CreateTaskRequest{Title: "first"}
+
+	var calls int
+	_, err := interceptor.Execute(idempotentContext("key-1"), createTaskMethod, func(ctx context.Context) (any, error) {
+		// Блокировка первого запроса истекла, и ключ занял повтор, который выполнился успешно
+		store.expire()
+		if _, err := interceptor.Execute(idempotentContext("key-1"), createTaskMethod, respond(&calls, "task-2"), req); err != nil {
+			t.Fatal(err)
+		}
+		return nil, errors.New("database is unavailable")
+	}, req)
+	if err == nil {
+		t.Fatal("Execute() returned no error")
+	}
+
+	// Ошибка первого запроса не удалила сохраненный ответ повтора
+	resp, err := interceptor.Execute(idempotentContext("key-1"), createTaskMethod, respond(&calls, "task-3"), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 || resp.(*wrapperspb.StringValue).GetValue() != "task-2" {
+		t.Fatalf("handler was called %d times and returned %v, want the stored task-2", calls, resp)
+	}
+}
+
+func TestLockTTLFollowsDeadline(t *testing.T) {
+	if ttl := lockTTL(context.Background()); ttl != idempotencyLockTTL {
+		t.Fatalf("lockTTL() without deadline = %v, want %v", ttl, idempotencyLockTTL)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Minute)
+	defer cancel()
+	if ttl := lockTTL(ctx); ttl <= 20*time.Minute || ttl > 20*time.Minute+idempotencyLockMargin {
+		t.Fatalf("lockTTL() with a 20m deadline = %v", ttl)
+	}
+}
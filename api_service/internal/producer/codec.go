@@ -0,0 +1,85 @@
+package producer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	kafkapb "github.com/bagdasarian/checklist-app/api_service/pkg/pb/kafka"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+// Заголовки сообщения с событием. Сообщения без content-type отправлены до перехода
+// на protobuf и содержат TaskEvent, сериализованный encoding/json.
+const (
+	HeaderContentType   = "content-type"
+	HeaderSchemaVersion = "schema-version"
+
+	ContentTypeProtobuf = "application/x-protobuf"
+	// SchemaVersion увеличивается при несовместимых изменениях TaskEvent.
+	// Версия 2 заменила details на attributes; события версии 1 по-прежнему принимаются.
+	SchemaVersion = "2"
+)
+
+// Версия схемы в поле schema_version события
+const schemaVersionNumber = 2
+
+// legacySchemaVersion - версия событий в JSON и событий до появления attributes
+const legacySchemaVersion = "1"
+
+// EncodeEvent сериализует событие в protobuf и возвращает заголовки формата
+func EncodeEvent(event *kafkapb.TaskEvent) ([]byte, []kafka.Header, error) {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return nil, nil, err
+	}
+	headers := []kafka.Header{
+		{Key: HeaderContentType, Value: []byte(ContentTypeProtobuf)},
+		{Key: HeaderSchemaVersion, Value: []byte(SchemaVersion)},
+	}
+	return data, headers, nil
+}
+
+// DecodeEvent разбирает событие в protobuf или в прежнем JSON формате.
+// У событий версии 1 текст details переносится в attributes["details"].
+func DecodeEvent(msg kafka.Message) (*kafkapb.TaskEvent, error) {
+	var event kafkapb.TaskEvent
+	switch contentType := header(msg, HeaderContentType); contentType {
+	case "":
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal legacy JSON event: %w", err)
+		}
+		upgradeLegacyEvent(&event)
+	case ContentTypeProtobuf:
+		version := header(msg, HeaderSchemaVersion)
+		if version != SchemaVersion && version != legacySchemaVersion {
+			return nil, fmt.Errorf("unsupported event schema version %q", version)
+		}
+		if err := proto.Unmarshal(msg.Value, &event); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+		}
+		if version == legacySchemaVersion {
+			upgradeLegacyEvent(&event)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported event content type %q", contentType)
+	}
+	return &event, nil
+}
+
+func upgradeLegacyEvent(event *kafkapb.TaskEvent) {
+	event.SchemaVersion = 1
+	if details := event.Details; details != "" {
+		event.Attributes = map[string]string{"details": details}
+		event.Details = ""
+	}
+}
+
+func header(msg kafka.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
@@ -2,27 +2,41 @@ package producer
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"log"
 	"time"
 
 	kafkapb "github.com/bagdasarian/checklist-app/api_service/pkg/pb/kafka"
+	"github.com/google/uuid"
 	"github.com/segmentio/kafka-go"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Source - значение поля source в событиях api_service
+const Source = "api_service"
+
+// EventMeta - сведения о запросе, в котором произошло событие
+type EventMeta struct {
+	RequestID string
+	TraceID   string
+	ClientIP  string
+	UserAgent string
+}
+
 type Producer struct {
 	writer *kafka.Writer
 	topic  string
 }
 
 func NewProducer(brokers []string, topic string) *Producer {
+	// Hash по ключу (ID пользователя): события пользователя попадают в одну партицию
+	// и читаются WatchTasks в порядке отправки. Отправка синхронная, чтобы при недоступном
+	// брокере вызывающий получил ошибку и мог положить событие в спул.
 	writer := &kafka.Writer{
-		Addr:     kafka.TCP(brokers...),
-		Topic:    topic,
-		Balancer: &kafka.LeastBytes{},
-		Async:    true,
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireOne,
+		BatchTimeout: 10 * time.Millisecond,
 	}
 
 	return &Producer{
@@ -31,43 +45,56 @@ func NewProducer(brokers []string, topic string) *Producer {
 	}
 }
 
-// SendEvent отправляет событие в Kafka
-func (p *Producer) SendEvent(ctx context.Context, action kafkapb.ActionType, userID, taskID, details string) error {
-	event := &kafkapb.TaskEvent{
-		Timestamp: timestamppb.Now(),
-		Action:    action,
-		UserId:    userID,
-		TaskId:    taskID,
-		Details:   details,
-	}
-
-	jsonData, err := json.Marshal(event)
+// newEvent собирает событие с новым event_id; сведения о клиенте из meta добавляются к attributes
+func newEvent(meta EventMeta, action kafkapb.ActionType, userID, taskID string, attributes map[string]string) (*kafkapb.TaskEvent, error) {
+	eventID, err := uuid.NewV7()
 	if err != nil {
-		return fmt.Errorf("failed to marshal event: %w", err)
+		return nil, fmt.Errorf("failed to generate event ID: %w", err)
 	}
 
-	msg := kafka.Message{
-		Key:   []byte(userID),
-		Value: jsonData,
-		Time:  time.Now(),
+	attrs := make(map[string]string, len(attributes)+2)
+	for key, value := range attributes {
+		attrs[key] = value
+	}
+	if meta.ClientIP != "" {
+		attrs["client_ip"] = meta.ClientIP
+	}
+	if meta.UserAgent != "" {
+		attrs["user_agent"] = meta.UserAgent
 	}
 
-	maxRetries := 3
-	for i := 0; i < maxRetries; i++ {
-		err := p.writer.WriteMessages(ctx, msg)
-		if err == nil {
-			return nil
-		}
+	return &kafkapb.TaskEvent{
+		Timestamp:     timestamppb.Now(),
+		Action:        action,
+		UserId:        userID,
+		TaskId:        taskID,
+		EventId:       eventID.String(),
+		RequestId:     meta.RequestID,
+		TraceId:       meta.TraceID,
+		Source:        Source,
+		SchemaVersion: schemaVersionNumber,
+		Attributes:    attrs,
+	}, nil
+}
 
-		log.Printf("Failed to send event to Kafka (attempt %d/%d): %v", i+1, maxRetries, err)
+// SendEvent отправляет событие в Kafka и возвращает ошибку, если все попытки не удались.
+// События изменения задач публикует db_service через outbox, здесь отправляются только события чтения.
+func (p *Producer) SendEvent(ctx context.Context, meta EventMeta, action kafkapb.ActionType, userID, taskID string, attributes map[string]string) error {
+	return sendWithRetry(ctx, p, "Kafka", meta, action, userID, taskID, attributes)
+}
 
-		if i < maxRetries-1 {
-			time.Sleep(time.Second * time.Duration(i+1))
+// Publish отправляет сообщения одним запросом
+func (p *Producer) Publish(ctx context.Context, msgs ...Message) error {
+	batch := make([]kafka.Message, len(msgs))
+	for i, msg := range msgs {
+		batch[i] = kafka.Message{
+			Key:     msg.Key,
+			Value:   msg.Value,
+			Headers: msg.Headers,
+			Time:    msg.Time,
 		}
 	}
-
-	log.Printf("Failed to send event to Kafka after %d attempts, continuing without event", maxRetries)
-	return nil
+	return p.writer.WriteMessages(ctx, batch...)
 }
 
 func (p *Producer) Close() error {
@@ -0,0 +1,59 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	kafkapb "github.com/bagdasarian/checklist-app/api_service/pkg/pb/kafka"
+)
+
+// MemoryPublisher передает события в канал в памяти; используется в тестах вместо брокера.
+// Если буфер заполнен, SendEvent ждет читателя до отмены контекста.
+type MemoryPublisher struct {
+	mu     sync.RWMutex
+	events chan *kafkapb.TaskEvent
+	closed bool
+}
+
+func NewMemoryPublisher(buffer int) *MemoryPublisher {
+	return &MemoryPublisher{
+		events: make(chan *kafkapb.TaskEvent, buffer),
+	}
+}
+
+// Events возвращает канал отправленных событий; он закрывается в Close
+func (p *MemoryPublisher) Events() <-chan *kafkapb.TaskEvent {
+	return p.events
+}
+
+func (p *MemoryPublisher) SendEvent(ctx context.Context, meta EventMeta, action kafkapb.ActionType, userID, taskID string, attributes map[string]string) error {
+	event, err := newEvent(meta, action, userID, taskID, attributes)
+	if err != nil {
+		return err
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return errors.New("publisher is closed")
+	}
+
+	select {
+	case p.events <- event:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to send event: %w", ctx.Err())
+	}
+}
+
+func (p *MemoryPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.closed {
+		p.closed = true
+		close(p.events)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+
+	kafkapb "github.com/bagdasarian/checklist-app/api_service/pkg/pb/kafka"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// NATSPublisher публикует события в поток NATS JetStream. Nats-Msg-Id - ID события,
+// поэтому повторная отправка того же события в окне дедупликации потока не создает дубль.
+type NATSPublisher struct {
+	conn    *nats.Conn
+	js      jetstream.JetStream
+	subject string
+}
+
+// NewNATSPublisher подключается к NATS и создает поток stream для subject, если его еще нет
+func NewNATSPublisher(ctx context.Context, url, stream, subject string) (*NATSPublisher, error) {
+	conn, err := nats.Connect(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     stream,
+		Subjects: []string{subject},
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create NATS stream %s: %w", stream, err)
+	}
+
+	return &NATSPublisher{
+		conn:    conn,
+		js:      js,
+		subject: subject,
+	}, nil
+}
+
+func (p *NATSPublisher) SendEvent(ctx context.Context, meta EventMeta, action kafkapb.ActionType, userID, taskID string, attributes map[string]string) error {
+	return sendWithRetry(ctx, p, "NATS", meta, action, userID, taskID, attributes)
+}
+
+// Publish публикует сообщения по одному и останавливается на первой ошибке
+func (p *NATSPublisher) Publish(ctx context.Context, msgs ...Message) error {
+	for _, msg := range msgs {
+		natsMsg := nats.NewMsg(p.subject)
+		natsMsg.Data = msg.Value
+		natsMsg.Header.Set(NATSHeaderKey, string(msg.Key))
+		natsMsg.Header.Set(jetstream.MsgIDHeader, msg.EventID)
+		for _, h := range msg.Headers {
+			natsMsg.Header.Set(h.Key, string(h.Value))
+		}
+		if _, err := p.js.PublishMsg(ctx, natsMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
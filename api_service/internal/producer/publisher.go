@@ -0,0 +1,101 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	kafkapb "github.com/bagdasarian/checklist-app/api_service/pkg/pb/kafka"
+	"github.com/segmentio/kafka-go"
+)
+
+// Брокеры, в которые можно отправлять события (EVENT_BACKEND)
+const (
+	BackendKafka = "kafka"
+	BackendRedis = "redis"
+	BackendNATS  = "nats"
+)
+
+// Поля записи в потоке Redis: ключ и тело сообщения, заголовки - с префиксом RedisHeaderPrefix
+const (
+	RedisFieldKey     = "key"
+	RedisFieldValue   = "value"
+	RedisHeaderPrefix = "header:"
+)
+
+// NATSHeaderKey - заголовок сообщения NATS с ключом (ID пользователя)
+const NATSHeaderKey = "message-key"
+
+// EventPublisher отправляет события задач в брокер. Реализации: Kafka (Producer),
+// Redis Streams (RedisPublisher), NATS JetStream (NATSPublisher), канал в памяти для тестов
+// (MemoryPublisher) и отправка через спул на диске (SpoolingPublisher).
+type EventPublisher interface {
+	// SendEvent отправляет событие и возвращает ошибку, если все попытки не удались
+	SendEvent(ctx context.Context, meta EventMeta, action kafkapb.ActionType, userID, taskID string, attributes map[string]string) error
+	Close() error
+}
+
+// Broker отправляет закодированные события в брокер одной попыткой, в порядке следования.
+// Реализуют Producer, RedisPublisher и NATSPublisher.
+type Broker interface {
+	Publish(ctx context.Context, msgs ...Message) error
+	Close() error
+}
+
+// Message - закодированное событие: ключ (ID пользователя), тело и заголовки формата
+type Message struct {
+	EventID string         `json:"event_id"`
+	Key     []byte         `json:"key"`
+	Value   []byte         `json:"value"`
+	Headers []kafka.Header `json:"headers"`
+	Time    time.Time      `json:"time"`
+}
+
+// Число попыток отправить событие
+const maxSendAttempts = 3
+
+// encodeMessage собирает событие и сериализует его в сообщение с ключом userID
+func encodeMessage(meta EventMeta, action kafkapb.ActionType, userID, taskID string, attributes map[string]string) (Message, error) {
+	event, err := newEvent(meta, action, userID, taskID, attributes)
+	if err != nil {
+		return Message{}, err
+	}
+
+	data, headers, err := EncodeEvent(event)
+	if err != nil {
+		return Message{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Message{
+		EventID: event.EventId,
+		Key:     []byte(userID),
+		Value:   data,
+		Headers: headers,
+		Time:    time.Now(),
+	}, nil
+}
+
+// sendWithRetry собирает событие и отправляет его в broker, пока отправка не удастся
+// или не закончатся попытки
+func sendWithRetry(ctx context.Context, broker Broker, name string, meta EventMeta, action kafkapb.ActionType, userID, taskID string, attributes map[string]string) error {
+	msg, err := encodeMessage(meta, action, userID, taskID, attributes)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < maxSendAttempts; i++ {
+		err = broker.Publish(ctx, msg)
+		if err == nil {
+			return nil
+		}
+
+		log.Printf("Failed to send event to %s (attempt %d/%d): %v", name, i+1, maxSendAttempts, err)
+
+		if i < maxSendAttempts-1 {
+			time.Sleep(time.Second * time.Duration(i+1))
+		}
+	}
+
+	return fmt.Errorf("failed to send event after %d attempts: %w", maxSendAttempts, err)
+}
@@ -0,0 +1,56 @@
+package producer
+
+import (
+	"context"
+
+	kafkapb "github.com/bagdasarian/checklist-app/api_service/pkg/pb/kafka"
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisPublisher добавляет события в поток Redis Streams. Поток один, поэтому события
+// читаются в порядке отправки; длина потока приблизительно ограничена maxLen.
+type RedisPublisher struct {
+	client *redis.Client
+	stream string
+	maxLen int64
+}
+
+func NewRedisPublisher(client *redis.Client, stream string, maxLen int64) *RedisPublisher {
+	return &RedisPublisher{
+		client: client,
+		stream: stream,
+		maxLen: maxLen,
+	}
+}
+
+func (p *RedisPublisher) SendEvent(ctx context.Context, meta EventMeta, action kafkapb.ActionType, userID, taskID string, attributes map[string]string) error {
+	return sendWithRetry(ctx, p, "Redis", meta, action, userID, taskID, attributes)
+}
+
+// Publish добавляет сообщения в поток по одному и останавливается на первой ошибке
+func (p *RedisPublisher) Publish(ctx context.Context, msgs ...Message) error {
+	for _, msg := range msgs {
+		values := map[string]interface{}{
+			RedisFieldKey:   msg.Key,
+			RedisFieldValue: msg.Value,
+		}
+		for _, h := range msg.Headers {
+			values[RedisHeaderPrefix+h.Key] = h.Value
+		}
+		err := p.client.XAdd(ctx, &redis.XAddArgs{
+			Stream: p.stream,
+			MaxLen: p.maxLen,
+			Approx: true,
+			Values: values,
+		}).Err()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close ничего не делает: клиент Redis общий и закрывается в main
+func (p *RedisPublisher) Close() error {
+	return nil
+}
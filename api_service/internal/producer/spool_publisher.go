@@ -0,0 +1,144 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/spool"
+	kafkapb "github.com/bagdasarian/checklist-app/api_service/pkg/pb/kafka"
+)
+
+// Параметры отправки событий из спула
+const (
+	spoolBatchSize     = 100
+	spoolSendTimeout   = 10 * time.Second
+	spoolRetryMinDelay = time.Second
+	spoolRetryMaxDelay = 30 * time.Second
+)
+
+// SpoolingPublisher отправляет события в брокер одной попыткой, а если брокер недоступен,
+// записывает их в спул на диске. Пока в спуле есть события, новые тоже записываются в него,
+// чтобы не обогнать накопленные. Run отправляет события из спула в порядке записи,
+// когда брокер снова доступен; после сбоя отправки возможны повторы.
+type SpoolingPublisher struct {
+	broker Broker
+	name   string
+	spool  *spool.Spool
+}
+
+func NewSpoolingPublisher(broker Broker, name string, s *spool.Spool) *SpoolingPublisher {
+	return &SpoolingPublisher{
+		broker: broker,
+		name:   name,
+		spool:  s,
+	}
+}
+
+// SendEvent отправляет событие или записывает его в спул. Ошибка возвращается,
+// только если событие не удалось ни отправить, ни записать в спул.
+func (p *SpoolingPublisher) SendEvent(ctx context.Context, meta EventMeta, action kafkapb.ActionType, userID, taskID string, attributes map[string]string) error {
+	msg, err := encodeMessage(meta, action, userID, taskID, attributes)
+	if err != nil {
+		return err
+	}
+
+	if p.spool.Len() == 0 {
+		err := p.broker.Publish(ctx, msg)
+		if err == nil {
+			return nil
+		}
+		log.Printf("Failed to send event to %s, spooling it: %v", p.name, err)
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal spooled event: %w", err)
+	}
+	if err := p.spool.Append(data); err != nil {
+		return fmt.Errorf("failed to spool event: %w", err)
+	}
+	return nil
+}
+
+// Run отправляет события из спула до отмены контекста. Если брокер недоступен,
+// пауза между попытками растет от spoolRetryMinDelay до spoolRetryMaxDelay.
+func (p *SpoolingPublisher) Run(ctx context.Context) {
+	delay := spoolRetryMinDelay
+	for {
+		sent, err := p.drain(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to send spooled events to %s, retrying in %v: %v", p.name, delay, err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			delay = min(delay*2, spoolRetryMaxDelay)
+			continue
+		}
+		delay = spoolRetryMinDelay
+
+		if sent > 0 {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.spool.Notify():
+		}
+	}
+}
+
+// drain отправляет одну пачку событий из спула и возвращает число отправленных
+func (p *SpoolingPublisher) drain(ctx context.Context) (int, error) {
+	records, err := p.spool.Peek(spoolBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	msgs := make([]Message, 0, len(records))
+	for _, data := range records {
+		var msg Message
+		if err := json.Unmarshal(data, &msg); err != nil {
+			log.Printf("Skipping invalid spooled event: %v", err)
+			continue
+		}
+		msgs = append(msgs, msg)
+	}
+
+	if len(msgs) > 0 {
+		sendCtx, cancel := context.WithTimeout(ctx, spoolSendTimeout)
+		err := p.broker.Publish(sendCtx, msgs...)
+		cancel()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err := p.spool.Ack(); err != nil {
+		return 0, err
+	}
+	return len(records), nil
+}
+
+// Stats возвращает состояние спула
+func (p *SpoolingPublisher) Stats() spool.Stats {
+	return p.spool.Stats()
+}
+
+func (p *SpoolingPublisher) Close() error {
+	spoolErr := p.spool.Close()
+	if err := p.broker.Close(); err != nil {
+		return err
+	}
+	return spoolErr
+}
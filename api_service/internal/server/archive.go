@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ограничение срока автоархивации - 10 лет
+const maxArchiveAfterDays = 3650
+
+// ArchiveTask переносит задачу в архив вместе с подзадачами
+func (s *TaskService) ArchiveTask(ctx context.Context, req *pb.ArchiveTaskRequest) (*pb.ArchiveTaskResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "task id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	archiveResp, err := s.dbClient.ArchiveTask(ctx, &dbpb.ArchiveTaskRequest{
+		Id:              req.Id,
+		UserId:          userID,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, dbError(err, "archive task")
+	}
+
+	return &pb.ArchiveTaskResponse{
+		Task: toTask(archiveResp.Task),
+	}, nil
+}
+
+// UnarchiveTask возвращает задачу из архива вместе с подзадачами
+func (s *TaskService) UnarchiveTask(ctx context.Context, req *pb.UnarchiveTaskRequest) (*pb.UnarchiveTaskResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "task id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	unarchiveResp, err := s.dbClient.UnarchiveTask(ctx, &dbpb.UnarchiveTaskRequest{
+		Id:              req.Id,
+		UserId:          userID,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, dbError(err, "unarchive task")
+	}
+
+	return &pb.UnarchiveTaskResponse{
+		Task: toTask(unarchiveResp.Task),
+	}, nil
+}
+
+// GetArchivePolicy возвращает политику автоархивации пользователя
+func (s *TaskService) GetArchivePolicy(ctx context.Context, req *pb.GetArchivePolicyRequest) (*pb.GetArchivePolicyResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policyResp, err := s.dbClient.GetArchivePolicy(ctx, &dbpb.GetArchivePolicyRequest{UserId: userID})
+	if err != nil {
+		return nil, dbError(err, "get archive policy")
+	}
+
+	return &pb.GetArchivePolicyResponse{
+		Policy: toArchivePolicy(policyResp.Policy),
+	}, nil
+}
+
+// SetArchivePolicy включает, выключает или меняет срок автоархивации
+func (s *TaskService) SetArchivePolicy(ctx context.Context, req *pb.SetArchivePolicyRequest) (*pb.SetArchivePolicyResponse, error) {
+	if req.ArchiveAfterDays <= 0 || req.ArchiveAfterDays > maxArchiveAfterDays {
+		return nil, status.Errorf(codes.InvalidArgument, "archive_after_days must be between 1 and %d", maxArchiveAfterDays)
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policyResp, err := s.dbClient.SetArchivePolicy(ctx, &dbpb.SetArchivePolicyRequest{
+		UserId:           userID,
+		Enabled:          req.Enabled,
+		ArchiveAfterDays: req.ArchiveAfterDays,
+	})
+	if err != nil {
+		return nil, dbError(err, "set archive policy")
+	}
+
+	return &pb.SetArchivePolicyResponse{
+		Policy: toArchivePolicy(policyResp.Policy),
+	}, nil
+}
+
+// toArchivePolicy преобразует политику db_service в политику API
+func toArchivePolicy(policy *dbpb.ArchivePolicy) *pb.ArchivePolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &pb.ArchivePolicy{
+		Enabled:          policy.Enabled,
+		ArchiveAfterDays: policy.ArchiveAfterDays,
+		UpdatedAt:        policy.UpdatedAt,
+	}
+}
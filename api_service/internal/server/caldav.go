@@ -0,0 +1,783 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/caldav"
+	"github.com/bagdasarian/checklist-app/api_service/internal/ical"
+	"github.com/bagdasarian/checklist-app/api_service/internal/importer"
+	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	calDAVRealm             = "Checklist"
+	calDAVDefaultName       = "Tasks"
+	calDAVObjectContentType = "text/calendar; charset=utf-8; component=VTODO"
+	calDAVAllowedMethods    = "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, REPORT"
+
+	// Ограничение размера тела запросов CalDAV
+	maxCalDAVBodySize = 1 << 20
+)
+
+// Значения свойств коллекций, которые не зависят от пользователя
+const (
+	calDAVPrivileges = "<D:privilege><D:read/></D:privilege><D:privilege><D:write/></D:privilege>" +
+		"<D:privilege><D:write-content/></D:privilege><D:privilege><D:bind/></D:privilege>" +
+		"<D:privilege><D:unbind/></D:privilege>"
+	calDAVReports = "<D:supported-report><D:report><C:calendar-query/></D:report></D:supported-report>" +
+		"<D:supported-report><D:report><C:calendar-multiget/></D:report></D:supported-report>" +
+		"<D:supported-report><D:report><D:sync-collection/></D:report></D:supported-report>"
+)
+
+// calDAVHandler обслуживает CalDAV клиентов (Apple Reminders, Thunderbird, DAVx5) поверх
+// существующих методов db_service. Списки задач - календарные коллекции, задачи без списка -
+// коллекция caldav.DefaultCollection, каждая задача - ресурс VTODO с именем {ical_uid}.ics.
+// ETag ресурса - версия задачи, как в REST API.
+type calDAVHandler struct {
+	service   *TaskService
+	auth      *middleware.AuthInterceptor
+	sync      *caldav.SyncStore
+	authCache *caldav.AuthCache
+}
+
+// CalDAVHandler возвращает обработчик путей caldav.Prefix. Пользователь определяется
+// по Basic авторизации (логин и пароль) или по JWT токену в заголовке Authorization.
+// Без syncStore отчет sync-collection не поддерживается, без authCache пароль проверяется
+// в db_service на каждый запрос.
+func (s *TaskService) CalDAVHandler(auth *middleware.AuthInterceptor, syncStore *caldav.SyncStore, authCache *caldav.AuthCache) http.Handler {
+	return &calDAVHandler{
+		service:   s,
+		auth:      auth,
+		sync:      syncStore,
+		authCache: authCache,
+	}
+}
+
+// calDAVResource - задача, доступная по CalDAV
+type calDAVResource struct {
+	task       *pb.Task
+	parentUID  string
+	collection string
+	href       string
+}
+
+// calDAVState - коллекции и задачи пользователя на момент запроса
+type calDAVState struct {
+	collections []string
+	names       map[string]string
+	members     map[string][]*calDAVResource
+	byUID       map[string]*calDAVResource
+}
+
+func (h *calDAVHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("DAV", "1, calendar-access")
+
+	// OPTIONS клиенты отправляют до авторизации, чтобы узнать возможности сервера
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Allow", calDAVAllowedMethods)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	userID, err := h.authenticate(r)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Basic realm="`+calDAVRealm+`"`)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	target, ok := caldav.ParsePath(r.URL.EscapedPath())
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case "PROPFIND":
+		h.propfind(w, r, userID, target)
+	case "REPORT":
+		h.report(w, r, userID, target)
+	case http.MethodGet, http.MethodHead:
+		h.get(w, r, userID, target)
+	case http.MethodPut:
+		h.put(w, r, userID, target)
+	case http.MethodDelete:
+		h.delete(w, r, userID, target)
+	default:
+		w.Header().Set("Allow", calDAVAllowedMethods)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// authenticate возвращает ID пользователя из Basic авторизации или JWT токена
+func (h *calDAVHandler) authenticate(r *http.Request) (string, error) {
+	if username, password, ok := r.BasicAuth(); ok {
+		if h.authCache != nil {
+			if userID, ok := h.authCache.Get(username, password); ok {
+				return userID, nil
+			}
+		}
+
+		resp, err := h.service.dbClient.AuthenticateUser(r.Context(), &dbpb.AuthenticateUserRequest{
+			Username: username,
+			Password: password,
+		})
+		if err != nil || !resp.Success {
+			return "", errors.New("invalid username or password")
+		}
+		if h.authCache != nil {
+			h.authCache.Add(username, password, resp.UserId)
+		}
+		return resp.UserId, nil
+	}
+
+	ctx, err := h.auth.AuthenticateHTTP(r)
+	if err != nil {
+		return "", errors.New(status.Convert(err).Message())
+	}
+	return middleware.GetUserIDFromContext(ctx)
+}
+
+func (h *calDAVHandler) propfind(w http.ResponseWriter, r *http.Request, userID string, target caldav.Target) {
+	body, err := readCalDAVBody(w, r)
+	if err != nil {
+		writeCalDAVError(w, err)
+		return
+	}
+	req, err := caldav.ParsePropFind(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Depth: infinity обрабатывается как 1: глубже коллекций ресурсов нет
+	children := r.Header.Get("Depth") != "0"
+
+	ms := caldav.NewMultistatus()
+	if target.Kind == caldav.TargetResource {
+		res, err := h.findResource(r.Context(), userID, target)
+		if err != nil {
+			writeCalDAVError(w, err)
+			return
+		}
+		if res == nil {
+			http.NotFound(w, r)
+			return
+		}
+		ms.AddProps(res.href, resourceProps(res, req.Has(caldav.PropCalendarData)), req)
+		ms.Write(w)
+		return
+	}
+
+	state, err := h.loadState(r.Context(), userID)
+	if err != nil {
+		writeCalDAVError(w, err)
+		return
+	}
+
+	switch target.Kind {
+	case caldav.TargetRoot:
+		ms.AddProps(caldav.Prefix, containerProps(), req)
+		if children {
+			ms.AddProps(caldav.PrincipalPath, principalProps(), req)
+			ms.AddProps(caldav.HomePath, containerProps(), req)
+		}
+	case caldav.TargetPrincipal:
+		ms.AddProps(caldav.PrincipalPath, principalProps(), req)
+	case caldav.TargetHome:
+		ms.AddProps(caldav.HomePath, containerProps(), req)
+		if children {
+			for _, collection := range state.collections {
+				props, err := h.collectionProps(r.Context(), userID, state, collection)
+				if err != nil {
+					writeCalDAVError(w, err)
+					return
+				}
+				ms.AddProps(caldav.CollectionPath(collection), props, req)
+			}
+		}
+	case caldav.TargetCollection:
+		if _, ok := state.names[target.Collection]; !ok {
+			http.NotFound(w, r)
+			return
+		}
+		props, err := h.collectionProps(r.Context(), userID, state, target.Collection)
+		if err != nil {
+			writeCalDAVError(w, err)
+			return
+		}
+		ms.AddProps(caldav.CollectionPath(target.Collection), props, req)
+		if children {
+			for _, res := range state.members[target.Collection] {
+				ms.AddProps(res.href, resourceProps(res, req.Has(caldav.PropCalendarData)), req)
+			}
+		}
+	}
+	ms.Write(w)
+}
+
+func (h *calDAVHandler) report(w http.ResponseWriter, r *http.Request, userID string, target caldav.Target) {
+	body, err := readCalDAVBody(w, r)
+	if err != nil {
+		writeCalDAVError(w, err)
+		return
+	}
+	report, err := caldav.ParseReport(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if report.Kind == "" || (report.Kind == caldav.ReportSyncCollection && h.sync == nil) {
+		caldav.WriteError(w, http.StatusForbidden, caldav.ErrorSupportedReport, "")
+		return
+	}
+
+	state, err := h.loadState(r.Context(), userID)
+	if err != nil {
+		writeCalDAVError(w, err)
+		return
+	}
+
+	// Отчеты по коллекции; calendar-multiget допускается и на других путях
+	if report.Kind != caldav.ReportCalendarMultiget {
+		if target.Kind != caldav.TargetCollection {
+			caldav.WriteError(w, http.StatusForbidden, caldav.ErrorSupportedReport, "")
+			return
+		}
+		if _, ok := state.names[target.Collection]; !ok {
+			http.NotFound(w, r)
+			return
+		}
+	}
+
+	withData := report.Props.Has(caldav.PropCalendarData)
+	ms := caldav.NewMultistatus()
+	switch report.Kind {
+	case caldav.ReportCalendarQuery:
+		for _, res := range state.members[target.Collection] {
+			if report.Filter != nil {
+				ok, err := report.Filter.MatchCalendar(calendarObject(res))
+				if err != nil {
+					caldav.WriteError(w, http.StatusForbidden, caldav.ErrorValidFilter, "")
+					return
+				}
+				if !ok {
+					continue
+				}
+			}
+			ms.AddProps(res.href, resourceProps(res, withData), report.Props)
+		}
+
+	case caldav.ReportCalendarMultiget:
+		for _, href := range report.Hrefs {
+			hrefTarget, ok := caldav.ParseHref(href)
+			res := state.find(hrefTarget)
+			if !ok || res == nil {
+				ms.AddStatus(href, http.StatusNotFound)
+				continue
+			}
+			ms.AddProps(res.href, resourceProps(res, withData), report.Props)
+		}
+
+	case caldav.ReportSyncCollection:
+		if report.SyncLevel != "" && report.SyncLevel != "1" {
+			caldav.WriteError(w, http.StatusForbidden, caldav.ErrorSupportedSyncLevel, "")
+			return
+		}
+
+		current := collectionETags(state.members[target.Collection])
+		var previous map[string]string
+		if report.SyncToken != "" {
+			previous, err = h.sync.Load(r.Context(), userID, target.Collection, report.SyncToken)
+			if errors.Is(err, caldav.ErrInvalidSyncToken) {
+				caldav.WriteError(w, http.StatusForbidden, caldav.ErrorValidSyncToken, "")
+				return
+			}
+			if err != nil {
+				writeCalDAVError(w, err)
+				return
+			}
+		}
+
+		for _, res := range state.members[target.Collection] {
+			if etag, ok := previous[res.href]; !ok || etag != current[res.href] {
+				ms.AddProps(res.href, resourceProps(res, withData), report.Props)
+			}
+		}
+		for href := range previous {
+			if _, ok := current[href]; !ok {
+				ms.AddStatus(href, http.StatusNotFound)
+			}
+		}
+
+		token, err := h.sync.Save(r.Context(), userID, target.Collection, current)
+		if err != nil {
+			writeCalDAVError(w, err)
+			return
+		}
+		ms.SetSyncToken(token)
+	}
+	ms.Write(w)
+}
+
+func (h *calDAVHandler) get(w http.ResponseWriter, r *http.Request, userID string, target caldav.Target) {
+	if target.Kind != caldav.TargetResource {
+		w.Header().Set("Allow", "OPTIONS, PROPFIND, REPORT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	res, err := h.findResource(r.Context(), userID, target)
+	if err != nil {
+		writeCalDAVError(w, err)
+		return
+	}
+	if res == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := ical.Encode(&buf, calendarObject(res)); err != nil {
+		writeCalDAVError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", calDAVObjectContentType)
+	w.Header().Set("ETag", FormatETag(res.task.Version))
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		w.Write(buf.Bytes())
+	}
+}
+
+// put создает или заменяет задачу из календарного объекта. Запись идет через ImportTasks:
+// задача с тем же UID обновляется, новая создается в списке коллекции. Если задачу
+// изменили после проверки If-Match, ImportTasks возвращает Aborted - 412.
+func (h *calDAVHandler) put(w http.ResponseWriter, r *http.Request, userID string, target caldav.Target) {
+	if target.Kind != caldav.TargetResource {
+		w.Header().Set("Allow", "OPTIONS, PROPFIND, REPORT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := readCalDAVBody(w, r)
+	if err != nil {
+		writeCalDAVError(w, err)
+		return
+	}
+	task, parentUID, err := importer.ParseCalendarObject(bytes.NewReader(body))
+	if errors.Is(err, importer.ErrUnsupportedComponent) {
+		caldav.WriteError(w, http.StatusForbidden, caldav.ErrorSupportedComponent, "")
+		return
+	}
+	if err != nil {
+		caldav.WriteError(w, http.StatusForbidden, caldav.ErrorValidCalendarData, "")
+		return
+	}
+	// Имя ресурса всегда строится из UID, поэтому другое имя клиент потом не найдет
+	if task.UID != target.UID {
+		caldav.WriteError(w, http.StatusForbidden, caldav.ErrorValidObject, "")
+		return
+	}
+
+	exists, err := h.collectionExists(r.Context(), userID, target.Collection)
+	if err != nil {
+		writeCalDAVError(w, err)
+		return
+	}
+	if !exists {
+		http.Error(w, "calendar collection does not exist", http.StatusConflict)
+		return
+	}
+
+	existing, err := h.loadResource(r.Context(), userID, task.UID)
+	if err != nil {
+		writeCalDAVError(w, err)
+		return
+	}
+	if existing != nil && existing.collection != target.Collection {
+		caldav.WriteError(w, http.StatusForbidden, caldav.ErrorNoUIDConflict, caldav.HrefXML(existing.href))
+		return
+	}
+	if !checkPreconditions(r, existing) {
+		http.Error(w, "precondition failed", http.StatusPreconditionFailed)
+		return
+	}
+
+	// Подзадача передается вместе с текущим состоянием родителя, который остается без изменений.
+	// Родителем может быть только задача верхнего уровня из той же коллекции, иначе связь игнорируется.
+	// Версии проверяются в той же транзакции, что и запись: при If-Match изменяемая задача,
+	// а родитель - всегда, чтобы не перезаписать его изменения прежним состоянием.
+	item := toImportItems([]*importer.Task{task})[0]
+	if existing != nil && r.Header.Get("If-Match") != "" {
+		item.ExpectedVersion = existing.task.Version
+	}
+	var parent *calDAVResource
+	if parentUID != "" && parentUID != task.UID {
+		if parent, err = h.loadResource(r.Context(), userID, parentUID); err != nil {
+			writeCalDAVError(w, err)
+			return
+		}
+	}
+	if parent != nil && parent.collection == target.Collection && parent.task.ParentId == "" {
+		parentItem := importItemFromTask(parent.task)
+		parentItem.ExpectedVersion = parent.task.Version
+		parentItem.Subtasks = []*dbpb.ImportTaskItem{item}
+		item = parentItem
+	}
+
+	var listID string
+	if target.Collection != caldav.DefaultCollection {
+		listID = target.Collection
+	}
+
+	_, err = h.service.dbClient.ImportTasks(calDAVEventContext(r), &dbpb.ImportTasksRequest{
+		UserId: userID,
+		ListId: listID,
+		Items:  []*dbpb.ImportTaskItem{item},
+	})
+	if err != nil {
+		writeCalDAVError(w, dbError(err, "save calendar object"))
+		return
+	}
+
+	// ETag не возвращается: сохраненное представление отличается от присланного (RFC 4791, раздел 5.3.4)
+	if existing == nil {
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *calDAVHandler) delete(w http.ResponseWriter, r *http.Request, userID string, target caldav.Target) {
+	switch target.Kind {
+	case caldav.TargetResource:
+	case caldav.TargetCollection:
+		http.Error(w, "calendar collections are deleted via the lists API", http.StatusForbidden)
+		return
+	default:
+		w.Header().Set("Allow", "OPTIONS, PROPFIND, REPORT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	res, err := h.findResource(r.Context(), userID, target)
+	if err != nil {
+		writeCalDAVError(w, err)
+		return
+	}
+	if res == nil {
+		http.NotFound(w, r)
+		return
+	}
+	if !checkPreconditions(r, res) {
+		http.Error(w, "precondition failed", http.StatusPreconditionFailed)
+		return
+	}
+
+	var expectedVersion int64
+	if r.Header.Get("If-Match") != "" {
+		expectedVersion = res.task.Version
+	}
+	resp, err := h.service.dbClient.DeleteTask(calDAVEventContext(r), &dbpb.DeleteTaskRequest{
+		Id:              res.task.Id,
+		UserId:          userID,
+		ExpectedVersion: expectedVersion,
+	})
+	if err != nil {
+		writeCalDAVError(w, dbError(err, "delete calendar object"))
+		return
+	}
+	if !resp.Success {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// loadState загружает списки и неархивные задачи пользователя
+func (h *calDAVHandler) loadState(ctx context.Context, userID string) (*calDAVState, error) {
+	listsResp, err := h.service.dbClient.GetLists(ctx, &dbpb.GetListsRequest{UserId: userID})
+	if err != nil {
+		return nil, dbError(err, "get lists")
+	}
+
+	state := &calDAVState{
+		collections: []string{caldav.DefaultCollection},
+		names:       map[string]string{caldav.DefaultCollection: calDAVDefaultName},
+		members:     make(map[string][]*calDAVResource),
+		byUID:       make(map[string]*calDAVResource),
+	}
+	for _, list := range listsResp.Lists {
+		state.collections = append(state.collections, list.Id)
+		state.names[list.Id] = list.Name
+	}
+
+	dbStream, err := h.service.dbClient.ExportTasks(ctx, &dbpb.ExportTasksRequest{UserId: userID})
+	if err != nil {
+		return nil, dbError(err, "export tasks")
+	}
+
+	uids := make(map[string]string)
+	var resources []*calDAVResource
+	for {
+		resp, err := dbStream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, dbError(err, "export tasks")
+		}
+
+		task := toTask(resp.Task)
+		if task.ArchivedAt != nil {
+			continue
+		}
+		collection := task.ListId
+		if collection == "" {
+			collection = caldav.DefaultCollection
+		}
+		uids[task.Id] = task.IcalUid
+		resources = append(resources, &calDAVResource{
+			task:       task,
+			collection: collection,
+			href:       caldav.ResourcePath(collection, task.IcalUid),
+		})
+	}
+
+	for _, res := range resources {
+		res.parentUID = uids[res.task.ParentId]
+		state.members[res.collection] = append(state.members[res.collection], res)
+		state.byUID[res.task.IcalUid] = res
+	}
+	return state, nil
+}
+
+// loadResource загружает неархивную задачу по UID календаря; nil, если такой задачи нет
+func (h *calDAVHandler) loadResource(ctx context.Context, userID, uid string) (*calDAVResource, error) {
+	resp, err := h.service.dbClient.GetTask(ctx, &dbpb.GetTaskRequest{UserId: userID, IcalUid: uid})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, dbError(err, "get task")
+	}
+
+	task := toTask(resp.Task)
+	if task.ArchivedAt != nil {
+		return nil, nil
+	}
+	collection := task.ListId
+	if collection == "" {
+		collection = caldav.DefaultCollection
+	}
+	res := &calDAVResource{
+		task:       task,
+		collection: collection,
+		href:       caldav.ResourcePath(collection, task.IcalUid),
+	}
+
+	// Архивный родитель в коллекциях не виден, поэтому связь с ним не передается, как и в loadState
+	if task.ParentId != "" {
+		parentResp, err := h.service.dbClient.GetTask(ctx, &dbpb.GetTaskRequest{Id: task.ParentId, UserId: userID})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, dbError(err, "get task")
+		}
+		if err == nil && parentResp.Task.ArchivedAt == nil {
+			res.parentUID = parentResp.Task.IcalUid
+		}
+	}
+	return res, nil
+}
+
+// findResource загружает задачу по пути ресурса; nil, если ее нет в этой коллекции
+func (h *calDAVHandler) findResource(ctx context.Context, userID string, target caldav.Target) (*calDAVResource, error) {
+	res, err := h.loadResource(ctx, userID, target.UID)
+	if err != nil || res == nil || res.collection != target.Collection {
+		return nil, err
+	}
+	return res, nil
+}
+
+// collectionExists проверяет, что коллекция - список пользователя или коллекция задач без списка
+func (h *calDAVHandler) collectionExists(ctx context.Context, userID, collection string) (bool, error) {
+	if collection == caldav.DefaultCollection {
+		return true, nil
+	}
+	listsResp, err := h.service.dbClient.GetLists(ctx, &dbpb.GetListsRequest{UserId: userID})
+	if err != nil {
+		return false, dbError(err, "get lists")
+	}
+	for _, list := range listsResp.Lists {
+		if list.Id == collection {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// find возвращает задачу по пути ресурса или nil
+func (s *calDAVState) find(target caldav.Target) *calDAVResource {
+	if target.Kind != caldav.TargetResource {
+		return nil
+	}
+	res := s.byUID[target.UID]
+	if res == nil || res.collection != target.Collection {
+		return nil
+	}
+	return res
+}
+
+// collectionProps возвращает свойства коллекции и сохраняет ее состояние для sync-token
+func (h *calDAVHandler) collectionProps(ctx context.Context, userID string, state *calDAVState, collection string) (caldav.Props, error) {
+	props := caldav.Props{
+		caldav.PropResourceType:        "<D:collection/><C:calendar/>",
+		caldav.PropSupportedComponents: `<C:comp name="VTODO"/>`,
+		caldav.PropPrivileges:          calDAVPrivileges,
+		caldav.PropSupportedReports:    calDAVReports,
+	}
+	props.SetText(caldav.PropDisplayName, state.names[collection])
+	props.SetHref(caldav.PropCurrentUserPrincipal, caldav.PrincipalPath)
+
+	etags := collectionETags(state.members[collection])
+	if h.sync == nil {
+		props.SetText(caldav.PropCTag, caldav.SyncToken(etags))
+		return props, nil
+	}
+
+	token, err := h.sync.Save(ctx, userID, collection, etags)
+	if err != nil {
+		return nil, err
+	}
+	props.SetText(caldav.PropCTag, token)
+	props.SetText(caldav.PropSyncToken, token)
+	return props, nil
+}
+
+// resourceProps возвращает свойства задачи; calendar-data формируется, только если запрошен
+func resourceProps(res *calDAVResource, withData bool) caldav.Props {
+	props := caldav.Props{caldav.PropResourceType: ""}
+	props.SetText(caldav.PropETag, FormatETag(res.task.Version))
+	props.SetText(caldav.PropContentType, calDAVObjectContentType)
+	if withData {
+		var buf bytes.Buffer
+		if err := ical.Encode(&buf, calendarObject(res)); err == nil {
+			props.SetText(caldav.PropCalendarData, buf.String())
+		}
+	}
+	return props
+}
+
+func principalProps() caldav.Props {
+	props := caldav.Props{caldav.PropResourceType: "<D:collection/><D:principal/>"}
+	props.SetHref(caldav.PropCurrentUserPrincipal, caldav.PrincipalPath)
+	props.SetHref(caldav.PropPrincipalURL, caldav.PrincipalPath)
+	props.SetHref(caldav.PropCalendarHomeSet, caldav.HomePath)
+	return props
+}
+
+func containerProps() caldav.Props {
+	props := caldav.Props{caldav.PropResourceType: "<D:collection/>"}
+	props.SetHref(caldav.PropCurrentUserPrincipal, caldav.PrincipalPath)
+	return props
+}
+
+// collectionETags возвращает состояние коллекции: href -> ETag
+func collectionETags(members []*calDAVResource) map[string]string {
+	etags := make(map[string]string, len(members))
+	for _, res := range members {
+		etags[res.href] = FormatETag(res.task.Version)
+	}
+	return etags
+}
+
+// calendarObject формирует календарный объект задачи. DTSTAMP не зависит от времени
+// запроса, чтобы одинаковые версии задачи давали одинаковое представление.
+func calendarObject(res *calDAVResource) *ical.Component {
+	stamp := res.task.CreatedAt.AsTime()
+	if res.task.CompletedAt != nil && res.task.CompletedAt.AsTime().After(stamp) {
+		stamp = res.task.CompletedAt.AsTime()
+	}
+
+	calendar := ical.NewCalendar("")
+	calendar.Children = append(calendar.Children, ical.TodoFromTask(res.task, res.parentUID, stamp))
+	return calendar
+}
+
+// checkPreconditions проверяет заголовки If-Match и If-None-Match по версии задачи.
+// existing - nil, если ресурса еще нет.
+func checkPreconditions(r *http.Request, existing *calDAVResource) bool {
+	if ifNoneMatch := strings.TrimSpace(r.Header.Get("If-None-Match")); ifNoneMatch == "*" && existing != nil {
+		return false
+	}
+
+	ifMatch := strings.TrimSpace(r.Header.Get("If-Match"))
+	if ifMatch == "" {
+		return true
+	}
+	if existing == nil {
+		return false
+	}
+	if ifMatch == "*" {
+		return true
+	}
+	version, err := ParseETag(ifMatch)
+	return err == nil && version == existing.task.Version
+}
+
+// importItemFromTask возвращает задачу в виде элемента импорта без изменений
+func importItemFromTask(task *pb.Task) *dbpb.ImportTaskItem {
+	return &dbpb.ImportTaskItem{
+		Title:       task.Title,
+		Description: task.Description,
+		Completed:   task.Completed,
+		CompletedAt: task.CompletedAt,
+		DueAt:       task.DueAt,
+		Tags:        task.Tags,
+		IcalUid:     task.IcalUid,
+	}
+}
+
+// readCalDAVBody читает тело запроса с ограничением размера
+func readCalDAVBody(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxCalDAVBodySize))
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return nil, status.Errorf(codes.ResourceExhausted, "request body is larger than %d bytes", maxCalDAVBodySize)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to read request body: %v", err)
+	}
+	return body, nil
+}
+
+// writeCalDAVError отправляет ошибку gRPC как HTTP статус; Aborted (конфликт версий) - 412
+func writeCalDAVError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+	message := st.Message()
+
+	switch st.Code() {
+	case codes.Aborted:
+		httpStatus = http.StatusPreconditionFailed
+	case codes.ResourceExhausted:
+		httpStatus = http.StatusRequestEntityTooLarge
+	}
+	if httpStatus == http.StatusInternalServerError {
+		fmt.Printf("CalDAV request failed: %v\n", err)
+		message = "internal server error"
+	}
+	http.Error(w, message, httpStatus)
+}
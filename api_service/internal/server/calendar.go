@@ -0,0 +1,117 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/ical"
+	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	calendarContentType = "text/calendar; charset=utf-8"
+	calendarName        = "Checklist"
+	calendarFeedPrefix  = "/v1/calendar/"
+)
+
+// GetCalendarFeed отдает задачи пользователя в формате iCalendar (VTODO).
+// Метод публичный: пользователь определяется по секретному токену из пути.
+// Архивные задачи в ленту не попадают.
+func (s *TaskService) GetCalendarFeed(ctx context.Context, req *pb.GetCalendarFeedRequest) (*httpbody.HttpBody, error) {
+	token := strings.TrimSuffix(req.Token, ".ics")
+	if token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "calendar token is required")
+	}
+
+	owner, err := s.dbClient.GetCalendarTokenOwner(ctx, &dbpb.GetCalendarTokenOwnerRequest{Token: token})
+	if err != nil {
+		return nil, dbError(err, "get calendar feed")
+	}
+
+	dbStream, err := s.dbClient.ExportTasks(ctx, &dbpb.ExportTasksRequest{UserId: owner.UserId})
+	if err != nil {
+		return nil, dbError(err, "get calendar feed")
+	}
+
+	calendar := ical.NewCalendar(calendarName)
+	stamp := time.Now()
+	uids := make(map[string]string)
+	for {
+		resp, err := dbStream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, dbError(err, "get calendar feed")
+		}
+
+		task := toTask(resp.Task)
+		if task.ArchivedAt != nil {
+			continue
+		}
+		// Родительская задача выгружается раньше своих подзадач
+		uids[task.Id] = task.IcalUid
+		calendar.Children = append(calendar.Children, ical.TodoFromTask(task, uids[task.ParentId], stamp))
+	}
+
+	var buf bytes.Buffer
+	if err := ical.Encode(&buf, calendar); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode calendar: %v", err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: calendarContentType,
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+// GetCalendarToken возвращает токен календарной ленты, создавая его при первом запросе
+func (s *TaskService) GetCalendarToken(ctx context.Context, req *pb.GetCalendarTokenRequest) (*pb.GetCalendarTokenResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbResp, err := s.dbClient.GetCalendarToken(ctx, &dbpb.GetCalendarTokenRequest{UserId: userID})
+	if err != nil {
+		return nil, dbError(err, "get calendar token")
+	}
+
+	return &pb.GetCalendarTokenResponse{Token: toCalendarToken(dbResp.Token)}, nil
+}
+
+// ResetCalendarToken выдает новый токен календарной ленты; ссылка со старым токеном перестает работать
+func (s *TaskService) ResetCalendarToken(ctx context.Context, req *pb.ResetCalendarTokenRequest) (*pb.ResetCalendarTokenResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbResp, err := s.dbClient.ResetCalendarToken(ctx, &dbpb.ResetCalendarTokenRequest{UserId: userID})
+	if err != nil {
+		return nil, dbError(err, "reset calendar token")
+	}
+
+	return &pb.ResetCalendarTokenResponse{Token: toCalendarToken(dbResp.Token)}, nil
+}
+
+func toCalendarToken(token *dbpb.CalendarToken) *pb.CalendarToken {
+	if token == nil {
+		return nil
+	}
+
+	return &pb.CalendarToken{
+		Token:     token.Token,
+		FeedPath:  calendarFeedPrefix + token.Token + ".ics",
+		CreatedAt: token.CreatedAt,
+	}
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Форматы дайджеста, отрисовку выполняет db_service
+var digestFormats = []string{"text", "markdown", "html"}
+
+var (
+	deliveryTimePattern = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`)
+	datePattern         = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+)
+
+// GetDigestSettings возвращает настройки ежедневного дайджеста
+func (s *TaskService) GetDigestSettings(ctx context.Context, req *pb.GetDigestSettingsRequest) (*pb.GetDigestSettingsResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settingsResp, err := s.dbClient.GetDigestSettings(ctx, &dbpb.GetDigestSettingsRequest{UserId: userID})
+	if err != nil {
+		return nil, dbError(err, "get digest settings")
+	}
+
+	return &pb.GetDigestSettingsResponse{
+		Settings: toDigestSettings(settingsResp.Settings),
+	}, nil
+}
+
+// SetDigestSettings включает или выключает дайджест и меняет время отправки и формат.
+// Пустые поля получают значения по умолчанию: 08:00, text. Часовой пояс берется из настроек пользователя.
+func (s *TaskService) SetDigestSettings(ctx context.Context, req *pb.SetDigestSettingsRequest) (*pb.SetDigestSettingsResponse, error) {
+	if req.DeliveryTime != "" && !deliveryTimePattern.MatchString(req.DeliveryTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "delivery_time must be in HH:MM format")
+	}
+	if req.Format != "" && !slices.Contains(digestFormats, req.Format) {
+		return nil, status.Errorf(codes.InvalidArgument, "format must be one of: text, markdown, html")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settingsResp, err := s.dbClient.SetDigestSettings(ctx, &dbpb.SetDigestSettingsRequest{
+		UserId:       userID,
+		Enabled:      req.Enabled,
+		DeliveryTime: req.DeliveryTime,
+		Format:       req.Format,
+	})
+	if err != nil {
+		return nil, dbError(err, "set digest settings")
+	}
+
+	return &pb.SetDigestSettingsResponse{
+		Settings: toDigestSettings(settingsResp.Settings),
+	}, nil
+}
+
+// GetDigest отдает дайджест за день в виде текста, Markdown или HTML
+func (s *TaskService) GetDigest(ctx context.Context, req *pb.GetDigestRequest) (*httpbody.HttpBody, error) {
+	if req.Format != "" && !slices.Contains(digestFormats, req.Format) {
+		return nil, status.Errorf(codes.InvalidArgument, "format must be one of: text, markdown, html")
+	}
+	if req.Date != "" && !datePattern.MatchString(req.Date) {
+		return nil, status.Errorf(codes.InvalidArgument, "date must be in YYYY-MM-DD format")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	digestResp, err := s.dbClient.GetDigest(ctx, &dbpb.GetDigestRequest{
+		UserId: userID,
+		Date:   req.Date,
+		Format: req.Format,
+	})
+	if err != nil {
+		return nil, dbError(err, "get digest")
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: digestResp.Digest.ContentType,
+		Data:        []byte(digestResp.Digest.Content),
+	}, nil
+}
+
+// toDigestSettings преобразует настройки db_service в настройки API
+func toDigestSettings(settings *dbpb.DigestSettings) *pb.DigestSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &pb.DigestSettings{
+		Enabled:      settings.Enabled,
+		DeliveryTime: settings.DeliveryTime,
+		TimeZone:     settings.TimeZone,
+		Format:       settings.Format,
+		LastSentOn:   settings.LastSentOn,
+		UpdatedAt:    settings.UpdatedAt,
+	}
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Заголовок If-Match: напрямую из gRPC метаданных или проброшенный gRPC Gateway
+var ifMatchKeys = []string{"if-match", "grpcgateway-if-match"}
+
+// FormatETag возвращает значение заголовка ETag для версии задачи
+func FormatETag(version int64) string {
+	return fmt.Sprintf("%q", strconv.FormatInt(version, 10))
+}
+
+// ErrWeakETag - слабый ETag (W/"..."). If-Match сравнивает теги строго (RFC 9110, 13.1.1),
+// поэтому слабый тег не совпадает ни с одной версией.
+var ErrWeakETag = errors.New("weak entity tag cannot be used in If-Match")
+
+// ParseETag извлекает версию задачи из значения ETag/If-Match. Для "*" возвращается 0,
+// для слабого тега - ErrWeakETag.
+func ParseETag(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "*" {
+		return 0, nil
+	}
+	if strings.HasPrefix(value, "W/") {
+		return 0, ErrWeakETag
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(value, `"`), 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("invalid entity tag %q", value)
+	}
+	return version, nil
+}
+
+// ResponseVersion возвращает версию задачи из ответа или 0, если ответ ее не содержит
+func ResponseVersion(resp proto.Message) int64 {
+	switch r := resp.(type) {
+	case interface{ GetVersion() int64 }:
+		return r.GetVersion()
+	case interface{ GetTask() *pb.Task }:
+		return r.GetTask().GetVersion()
+	}
+	return 0
+}
+
+// expectedVersion возвращает ожидаемую версию задачи из запроса,
+// а если она не указана - из заголовка If-Match
+func expectedVersion(ctx context.Context, fromRequest int64) (int64, error) {
+	if fromRequest != 0 {
+		return fromRequest, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+
+	for _, key := range ifMatchKeys {
+		if values := md.Get(key); len(values) > 0 {
+			version, err := ParseETag(values[0])
+			if errors.Is(err, ErrWeakETag) {
+				// Условие не выполнено, как и при несовпадении версии (412 для HTTP)
+				return 0, status.Errorf(codes.Aborted, "If-Match does not match: %v", err)
+			}
+			if err != nil {
+				return 0, status.Errorf(codes.InvalidArgument, "invalid If-Match header: %v", err)
+			}
+			return version, nil
+		}
+	}
+	return 0, nil
+}
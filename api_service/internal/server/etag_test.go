@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseETag(t *testing.T) {
+	for _, tc := range []struct {
+		value   string
+		version int64
+		err     bool
+	}{
+		{value: `"3"`, version: 3},
+		{value: ` "12" `, version: 12},
+		{value: "*", version: 0},
+		{value: "", version: 0},
+		{value: `"abc"`, err: true},
+		{value: `"0"`, err: true},
+		{value: `W/"3"`, err: true},
+	} {
+		version, err := ParseETag(tc.value)
+		if (err != nil) != tc.err || version != tc.version {
+			t.Errorf("ParseETag(%q) = %d, %v", tc.value, version, err)
+		}
+	}
+
+	if _, err := ParseETag(`W/"3"`); !errors.Is(err, ErrWeakETag) {
+		t.Errorf("ParseETag(weak) = %v, want ErrWeakETag", err)
+	}
+}
+
+func TestExpectedVersionRejectsWeakETag(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("grpcgateway-if-match", `W/"3"`))
+	if _, err := expectedVersion(ctx, 0); status.Code(err) != codes.Aborted {
+		t.Fatalf("expectedVersion() = %v, want Aborted", err)
+	}
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("if-match", `"3"`))
+	if version, err := expectedVersion(ctx, 0); err != nil || version != 3 {
+		t.Fatalf("expectedVersion() = %d, %v, want 3", version, err)
+	}
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/producer"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Заголовки корреляции запроса; grpc-gateway пробрасывает их в метаданные без префикса
+const (
+	RequestIDHeader   = "x-request-id"
+	TraceparentHeader = "traceparent"
+)
+
+// Метаданные, в которых grpc-gateway передает заголовки HTTP запроса
+const (
+	gatewayUserAgentKey = "grpcgateway-user-agent"
+	forwardedForKey     = "x-forwarded-for"
+)
+
+// Метаданные вызовов db_service, из которых он берет сведения о запросе для событий
+// изменения задач (см. db_service/internal/outbox)
+const (
+	dbRequestIDKey      = "x-request-id"
+	dbTraceIDKey        = "x-trace-id"
+	dbEventAttributeKey = "x-event-attr-"
+)
+
+type eventMetaKey struct{}
+
+// EventMetaInterceptor определяет сведения о запросе один раз на вызов и передает их
+// во все вызовы db_service. Должен идти после AuthInterceptor.
+func EventMetaInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withEventMeta(ctx, eventMeta(ctx)), req)
+	}
+}
+
+// withEventMeta сохраняет сведения о запросе в контексте и в метаданных вызовов db_service
+func withEventMeta(ctx context.Context, meta producer.EventMeta) context.Context {
+	ctx = context.WithValue(ctx, eventMetaKey{}, meta)
+	ctx = metadata.AppendToOutgoingContext(ctx, dbRequestIDKey, meta.RequestID)
+	if meta.TraceID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, dbTraceIDKey, meta.TraceID)
+	}
+	return withEventAttributes(ctx, map[string]string{
+		"client_ip":  meta.ClientIP,
+		"user_agent": meta.UserAgent,
+	})
+}
+
+// withEventAttributes добавляет атрибуты к событиям, которые db_service запишет при вызовах с этим контекстом.
+// Пустые значения не передаются.
+func withEventAttributes(ctx context.Context, attributes map[string]string) context.Context {
+	for key, value := range attributes {
+		if value != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, dbEventAttributeKey+key, value)
+		}
+	}
+	return ctx
+}
+
+// eventMeta возвращает сведения о запросе для событий Kafka из метаданных gRPC.
+// Если клиент не передал X-Request-Id, генерируется новый ID.
+func eventMeta(ctx context.Context) producer.EventMeta {
+	if meta, ok := ctx.Value(eventMetaKey{}).(producer.EventMeta); ok {
+		return meta
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(keys ...string) string {
+		for _, key := range keys {
+			if values := md.Get(key); len(values) > 0 && values[0] != "" {
+				return values[0]
+			}
+		}
+		return ""
+	}
+
+	meta := producer.EventMeta{
+		RequestID: first(RequestIDHeader),
+		TraceID:   parseTraceID(first(TraceparentHeader)),
+		ClientIP:  firstForwardedFor(first(forwardedForKey)),
+		UserAgent: first(gatewayUserAgentKey, "user-agent"),
+	}
+	if meta.ClientIP == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			meta.ClientIP = hostOnly(p.Addr.String())
+		}
+	}
+	if meta.RequestID == "" {
+		meta.RequestID = uuid.NewString()
+	}
+	return meta
+}
+
+// httpEventMeta возвращает сведения о HTTP запросе, обработанном в обход gRPC (CalDAV, загрузка импорта)
+func httpEventMeta(r *http.Request) producer.EventMeta {
+	meta := producer.EventMeta{
+		RequestID: r.Header.Get(RequestIDHeader),
+		TraceID:   parseTraceID(r.Header.Get(TraceparentHeader)),
+		ClientIP:  firstForwardedFor(r.Header.Get(forwardedForKey)),
+		UserAgent: r.UserAgent(),
+	}
+	if meta.ClientIP == "" {
+		meta.ClientIP = hostOnly(r.RemoteAddr)
+	}
+	if meta.RequestID == "" {
+		meta.RequestID = uuid.NewString()
+	}
+	return meta
+}
+
+// parseTraceID извлекает trace-id из заголовка traceparent (версия-trace_id-parent_id-флаги)
+func parseTraceID(traceparent string) string {
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) != 4 || len(parts[1]) != 32 {
+		return ""
+	}
+	traceID := strings.ToLower(parts[1])
+	if _, err := hex.DecodeString(traceID); err != nil || traceID == strings.Repeat("0", 32) {
+		return ""
+	}
+	return traceID
+}
+
+// firstForwardedFor возвращает адрес клиента - первый адрес в X-Forwarded-For
+func firstForwardedFor(value string) string {
+	client, _, _ := strings.Cut(value, ",")
+	return strings.TrimSpace(client)
+}
+
+func hostOnly(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// Значения атрибута origin - откуда пришло изменение задачи, кроме API
+const (
+	originTemplate = "template"
+	originImport   = "import"
+	originCalDAV   = "caldav"
+)
+
+// calDAVEventContext возвращает контекст вызова db_service для изменения задачи через CalDAV
+func calDAVEventContext(r *http.Request) context.Context {
+	ctx := withEventMeta(r.Context(), httpEventMeta(r))
+	return withEventAttributes(ctx, map[string]string{"origin": originCalDAV})
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/export"
+	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ContentDispositionHeader - метаданные с именем файла выгрузки, gateway отдает их заголовком
+const ContentDispositionHeader = "content-disposition"
+
+// ExportTasks выгружает все задачи пользователя в выбранном формате.
+// Задачи читаются из потока db_service и отправляются клиенту по одной, без буферизации.
+func (s *TaskService) ExportTasks(req *pb.ExportTasksRequest, stream pb.TaskService_ExportTasksServer) error {
+	ctx := stream.Context()
+
+	writer, err := export.NewWriter(req.Format)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	dbStream, err := s.dbClient.ExportTasks(ctx, &dbpb.ExportTasksRequest{UserId: userID})
+	if err != nil {
+		return dbError(err, "export tasks")
+	}
+
+	header := metadata.Pairs(ContentDispositionHeader,
+		fmt.Sprintf(`attachment; filename="tasks.%s"`, writer.FileExtension()))
+	if err := stream.SetHeader(header); err != nil {
+		return err
+	}
+
+	send := func(data []byte, err error) error {
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to encode export: %v", err)
+		}
+		if data == nil {
+			return nil
+		}
+		return stream.Send(&httpbody.HttpBody{
+			ContentType: writer.ContentType(),
+			Data:        data,
+		})
+	}
+
+	if err := send(writer.Begin()); err != nil {
+		return err
+	}
+
+	for {
+		resp, err := dbStream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return dbError(err, "export tasks")
+		}
+
+		if err := send(writer.Write(toTask(resp.Task), resp.ListName)); err != nil {
+			return err
+		}
+	}
+
+	return send(writer.End())
+}
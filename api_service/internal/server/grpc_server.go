@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
 	"github.com/bagdasarian/checklist-app/api_service/internal/producer"
 	"github.com/bagdasarian/checklist-app/api_service/internal/service"
+	"github.com/bagdasarian/checklist-app/api_service/internal/watch"
 	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
 	kafkapb "github.com/bagdasarian/checklist-app/api_service/pkg/pb/kafka"
 	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
@@ -19,16 +21,22 @@ import (
 
 type TaskService struct {
 	pb.UnimplementedTaskServiceServer
-	dbClient      client.DBClientInterface
-	jwtManager    *service.JWTManager
-	kafkaProducer *producer.Producer
+	dbClient    client.DBClientInterface
+	jwtManager  *service.JWTManager
+	events      producer.EventPublisher
+	watchHub    *watch.Hub
+	idempotency *middleware.IdempotencyInterceptor
 }
 
-func NewTaskService(dbClient client.DBClientInterface, jwtManager *service.JWTManager, kafkaProducer *producer.Producer) *TaskService {
+// NewTaskService создает сервис; idempotency нужен методам, которые проверяют Idempotency-Key
+// сами (импорт), и может быть nil, если ключи идемпотентности отключены
+func NewTaskService(dbClient client.DBClientInterface, jwtManager *service.JWTManager, events producer.EventPublisher, watchHub *watch.Hub, idempotency *middleware.IdempotencyInterceptor) *TaskService {
 	return &TaskService{
-		dbClient:      dbClient,
-		jwtManager:    jwtManager,
-		kafkaProducer: kafkaProducer,
+		dbClient:    dbClient,
+		jwtManager:  jwtManager,
+		events:      events,
+		watchHub:    watchHub,
+		idempotency: idempotency,
 	}
 }
 
@@ -121,25 +129,24 @@ func (s *TaskService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest)
 		return nil, err
 	}
 
+	tags, err := normalizeTags(req.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	createTaskReq := &dbpb.CreateTaskRequest{
 		Title:       strings.TrimSpace(req.Title),
 		Description: strings.TrimSpace(req.Description),
 		UserId:      userID,
+		DueAt:       req.DueAt,
+		Tags:        tags,
+		ParentId:    strings.TrimSpace(req.ParentId),
+		ListId:      strings.TrimSpace(req.ListId),
 	}
 
 	createTaskResp, err := s.dbClient.CreateTask(ctx, createTaskReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create task: %w", err)
-	}
-
-	if s.kafkaProducer != nil {
-		go func() {
-			kafkaCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-			defer cancel()
-			if err := s.kafkaProducer.SendEvent(kafkaCtx, kafkapb.ActionType_ACTION_CREATE_TASK, userID, createTaskResp.Id, fmt.Sprintf("Created task: %s", createTaskResp.Title)); err != nil {
-				fmt.Printf("Failed to send Kafka event: %v\n", err)
-			}
-		}()
+		return nil, dbError(err, "create task")
 	}
 
 	return &pb.CreateTaskResponse{
@@ -150,6 +157,11 @@ func (s *TaskService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest)
 		Completed:   createTaskResp.Completed,
 		CreatedAt:   createTaskResp.CreatedAt,
 		CompletedAt: createTaskResp.CompletedAt,
+		Version:     createTaskResp.Version,
+		DueAt:       createTaskResp.DueAt,
+		Tags:        createTaskResp.Tags,
+		ParentId:    createTaskResp.ParentId,
+		ListId:      createTaskResp.ListId,
 	}, nil
 }
 
@@ -173,39 +185,54 @@ func (s *TaskService) GetTasks(ctx context.Context, req *pb.GetTasksRequest) (*p
 		offset = 0
 	}
 
+	if _, ok := pb.TaskView_name[int32(req.View)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown task view: %d", req.View)
+	}
+	if _, ok := pb.DueFilter_name[int32(req.Due)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown due filter: %d", req.Due)
+	}
+	if _, ok := pb.TaskSort_name[int32(req.Sort)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown task sort: %d", req.Sort)
+	}
+	if req.DueFrom != "" && !datePattern.MatchString(req.DueFrom) {
+		return nil, status.Errorf(codes.InvalidArgument, "due_from must be in YYYY-MM-DD format")
+	}
+	if req.DueTo != "" && !datePattern.MatchString(req.DueTo) {
+		return nil, status.Errorf(codes.InvalidArgument, "due_to must be in YYYY-MM-DD format")
+	}
+
 	getTasksReq := &dbpb.GetTasksRequest{
 		UserId:           userID,
 		IncludeCompleted: req.IncludeCompleted,
 		Limit:            limit,
 		Offset:           offset,
+		ListId:           strings.TrimSpace(req.ListId),
+		View:             dbpb.TaskView(req.View),
+		Due:              dbpb.DueFilter(req.Due),
+		DueFrom:          req.DueFrom,
+		DueTo:            req.DueTo,
+		Sort:             dbpb.TaskSort(req.Sort),
 	}
 
 	getTasksResp, err := s.dbClient.GetTasks(ctx, getTasksReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tasks: %w", err)
+		return nil, dbError(err, "get tasks")
 	}
 
-	if s.kafkaProducer != nil {
+	if s.events != nil {
+		meta := eventMeta(ctx)
 		go func() {
-			kafkaCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			eventCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 			defer cancel()
-			if err := s.kafkaProducer.SendEvent(kafkaCtx, kafkapb.ActionType_ACTION_GET_TASKS, userID, "", fmt.Sprintf("Retrieved %d tasks", getTasksResp.TotalCount)); err != nil {
-				fmt.Printf("Failed to send Kafka event: %v\n", err)
+			if err := s.events.SendEvent(eventCtx, meta, kafkapb.ActionType_ACTION_GET_TASKS, userID, "", map[string]string{"count": strconv.Itoa(int(getTasksResp.TotalCount))}); err != nil {
+				fmt.Printf("Failed to send task event: %v\n", err)
 			}
 		}()
 	}
 
 	tasks := make([]*pb.Task, len(getTasksResp.Tasks))
 	for i, task := range getTasksResp.Tasks {
-		tasks[i] = &pb.Task{
-			Id:          task.Id,
-			UserId:      task.UserId,
-			Title:       task.Title,
-			Description: task.Description,
-			Completed:   task.Completed,
-			CreatedAt:   task.CreatedAt,
-			CompletedAt: task.CompletedAt,
-		}
+		tasks[i] = toTask(task)
 	}
 
 	return &pb.GetTasksResponse{
@@ -225,30 +252,26 @@ func (s *TaskService) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest)
 		return nil, err
 	}
 
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	deleteTaskReq := &dbpb.DeleteTaskRequest{
-		Id:     req.Id,
-		UserId: userID,
+		Id:              req.Id,
+		UserId:          userID,
+		ExpectedVersion: version,
 	}
 
 	deleteTaskResp, err := s.dbClient.DeleteTask(ctx, deleteTaskReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete task: %w", err)
+		return nil, dbError(err, "delete task")
 	}
 
 	if !deleteTaskResp.Success {
 		return nil, status.Errorf(codes.NotFound, "task not found")
 	}
 
-	if s.kafkaProducer != nil {
-		go func() {
-			kafkaCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-			defer cancel()
-			if err := s.kafkaProducer.SendEvent(kafkaCtx, kafkapb.ActionType_ACTION_DELETE_TASK, userID, req.Id, "Task deleted"); err != nil {
-				fmt.Printf("Failed to send Kafka event: %v\n", err)
-			}
-		}()
-	}
-
 	return &pb.DeleteTaskResponse{
 		Success: true,
 		Message: "task deleted successfully",
@@ -266,9 +289,15 @@ func (s *TaskService) CompleteTask(ctx context.Context, req *pb.CompleteTaskRequ
 		return nil, err
 	}
 
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	completeTaskReq := &dbpb.CompleteTaskRequest{
-		Id:     req.Id,
-		UserId: userID,
+		Id:              req.Id,
+		UserId:          userID,
+		ExpectedVersion: version,
 	}
 
 	completeTaskResp, err := s.dbClient.CompleteTask(ctx, completeTaskReq)
@@ -276,22 +305,211 @@ func (s *TaskService) CompleteTask(ctx context.Context, req *pb.CompleteTaskRequ
 		if strings.Contains(err.Error(), "task not found") {
 			return nil, status.Errorf(codes.NotFound, "task not found")
 		}
-		return nil, fmt.Errorf("failed to complete task: %w", err)
-	}
-
-	if s.kafkaProducer != nil {
-		go func() {
-			kafkaCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-			defer cancel()
-			if err := s.kafkaProducer.SendEvent(kafkaCtx, kafkapb.ActionType_ACTION_COMPLETE_TASK, userID, req.Id, "Task completed"); err != nil {
-				fmt.Printf("Failed to send Kafka event: %v\n", err)
-			}
-		}()
+		return nil, dbError(err, "complete task")
 	}
 
 	return &pb.CompleteTaskResponse{
 		Id:          completeTaskResp.Id,
 		Completed:   completeTaskResp.Completed,
 		CompletedAt: completeTaskResp.CompletedAt,
+		Version:     completeTaskResp.Version,
+	}, nil
+}
+
+// UpdateTask изменяет поля задачи, переданные в запросе
+func (s *TaskService) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "task id is required")
+	}
+	if req.Title == nil && req.Description == nil && req.DueAt == nil && !req.ClearDueAt && req.Tags == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nothing to update")
+	}
+	if req.DueAt != nil && req.ClearDueAt {
+		return nil, status.Errorf(codes.InvalidArgument, "due_at and clear_due_at cannot be set together")
+	}
+	if req.Title != nil && strings.TrimSpace(*req.Title) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "title cannot be empty")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	updateTaskReq := &dbpb.UpdateTaskRequest{
+		Id:              req.Id,
+		UserId:          userID,
+		ExpectedVersion: version,
+		DueAt:           req.DueAt,
+		ClearDueAt:      req.ClearDueAt,
+	}
+	if req.Title != nil {
+		title := strings.TrimSpace(*req.Title)
+		updateTaskReq.Title = &title
+	}
+	if req.Description != nil {
+		description := strings.TrimSpace(*req.Description)
+		updateTaskReq.Description = &description
+	}
+	if req.Tags != nil {
+		tags, err := normalizeTags(req.Tags.Values)
+		if err != nil {
+			return nil, err
+		}
+		updateTaskReq.Tags = &dbpb.TaskTags{Values: tags}
+	}
+
+	updateTaskResp, err := s.dbClient.UpdateTask(ctx, updateTaskReq)
+	if err != nil {
+		return nil, dbError(err, "update task")
+	}
+
+	return &pb.UpdateTaskResponse{
+		Task: toTask(updateTaskResp.Task),
+	}, nil
+}
+
+// GetTaskHistory возвращает историю изменений задачи
+func (s *TaskService) GetTaskHistory(ctx context.Context, req *pb.GetTaskHistoryRequest) (*pb.GetTaskHistoryResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "task id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit cannot exceed 100")
+	}
+
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	historyResp, err := s.dbClient.GetTaskHistory(ctx, &dbpb.GetTaskHistoryRequest{
+		TaskId: req.Id,
+		UserId: userID,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, dbError(err, "get task history")
+	}
+
+	revisions := make([]*pb.TaskRevision, len(historyResp.Revisions))
+	for i, revision := range historyResp.Revisions {
+		revisions[i] = &pb.TaskRevision{
+			Id:        revision.Id,
+			TaskId:    revision.TaskId,
+			Revision:  revision.Revision,
+			Action:    revision.Action,
+			OldTask:   toTask(revision.OldTask),
+			NewTask:   toTask(revision.NewTask),
+			ActorId:   revision.ActorId,
+			CreatedAt: revision.CreatedAt,
+		}
+	}
+
+	return &pb.GetTaskHistoryResponse{
+		Revisions:  revisions,
+		TotalCount: historyResp.TotalCount,
+	}, nil
+}
+
+// RevertTask откатывает задачу к состоянию указанной ревизии
+func (s *TaskService) RevertTask(ctx context.Context, req *pb.RevertTaskRequest) (*pb.RevertTaskResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "task id is required")
+	}
+	if req.Revision <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "revision must be positive")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withEventAttributes(ctx, map[string]string{"revision": strconv.Itoa(int(req.Revision))})
+	revertResp, err := s.dbClient.RevertTask(ctx, &dbpb.RevertTaskRequest{
+		Id:              req.Id,
+		UserId:          userID,
+		Revision:        req.Revision,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, dbError(err, "revert task")
+	}
+
+	return &pb.RevertTaskResponse{
+		Task: toTask(revertResp.Task),
 	}, nil
 }
+
+// toTask преобразует задачу db_service в задачу API
+func toTask(task *dbpb.DbTask) *pb.Task {
+	if task == nil {
+		return nil
+	}
+
+	return &pb.Task{
+		Id:          task.Id,
+		UserId:      task.UserId,
+		Title:       task.Title,
+		Description: task.Description,
+		Completed:   task.Completed,
+		CreatedAt:   task.CreatedAt,
+		CompletedAt: task.CompletedAt,
+		Version:     task.Version,
+		DueAt:       task.DueAt,
+		Tags:        task.Tags,
+		ParentId:    task.ParentId,
+		ListId:      task.ListId,
+		ArchivedAt:  task.ArchivedAt,
+		IcalUid:     task.IcalUid,
+	}
+}
+
+// normalizeTags убирает пробелы и повторы в тегах
+func normalizeTags(tags []string) ([]string, error) {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "tags cannot be empty")
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			result = append(result, tag)
+		}
+	}
+	return result, nil
+}
+
+// dbError сохраняет клиентские gRPC статусы db_service, остальные ошибки оборачивает
+func dbError(err error, action string) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.FailedPrecondition, codes.Aborted:
+		return status.Error(st.Code(), st.Message())
+	}
+	return fmt.Errorf("failed to %s: %w", action, err)
+}
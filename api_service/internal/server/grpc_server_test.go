@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/client"
+	"github.com/bagdasarian/checklist-app/api_service/internal/producer"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	kafkapb "github.com/bagdasarian/checklist-app/api_service/pkg/pb/kafka"
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeDBClient отвечает только на GetTasks и ImportTasks; вызов остальных методов приводит к панике
+type fakeDBClient struct {
+	client.DBClientInterface
+	tasks []*dbpb.DbTask
+	// Метаданные последнего вызова ImportTasks
+	outgoing metadata.MD
+}
+
+func (c *fakeDBClient) GetTasks(ctx context.Context, req *dbpb.GetTasksRequest) (*dbpb.GetTasksResponse, error) {
+	return &dbpb.GetTasksResponse{Tasks: c.tasks, TotalCount: int32(len(c.tasks))}, nil
+}
+
+func (c *fakeDBClient) ImportTasks(ctx context.Context, req *dbpb.ImportTasksRequest) (*dbpb.ImportTasksResponse, error) {
+	c.outgoing, _ = metadata.FromOutgoingContext(ctx)
+	tasks := make([]*dbpb.DbTask, len(req.Items))
+	for i, item := range req.Items {
+		tasks[i] = &dbpb.DbTask{Id: fmt.Sprintf("task-%d", i+1), UserId: req.UserId, Title: item.Title}
+	}
+	return &dbpb.ImportTasksResponse{Tasks: tasks}, nil
+}
+
+// importStream передает ImportTasks сообщения requests и запоминает ответ
+type importStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*pb.ImportTasksRequest
+	resp     *pb.ImportTasksResponse
+}
+
+func (s *importStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *importStream) Recv() (*pb.ImportTasksRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *importStream) SendAndClose(resp *pb.ImportTasksResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func TestGetTasksPublishesEvent(t *testing.T) {
+	events := producer.NewMemoryPublisher(1)
+	defer events.Close()
+	db := &fakeDBClient{tasks: []*dbpb.DbTask{{Id: "task-1", UserId: "user-1"}, {Id: "task-2", UserId: "user-1"}}}
+	s := NewTaskService(db, nil, events, nil, nil)
+
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(RequestIDHeader, "request-1", gatewayUserAgentKey, "test-agent"))
+	resp, err := s.GetTasks(ctx, &pb.GetTasksRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Tasks) != 2 || resp.TotalCount != 2 {
+		t.Fatalf("GetTasks() returned %d tasks of %d, want 2", len(resp.Tasks), resp.TotalCount)
+	}
+
+	// Событие отправляется в фоне после ответа
+	select {
+	case event := <-events.Events():
+		if event.Action != kafkapb.ActionType_ACTION_GET_TASKS || event.UserId != "user-1" {
+			t.Fatalf("event = %s for %s, want ACTION_GET_TASKS for user-1", event.Action, event.UserId)
+		}
+		if event.EventId == "" || event.RequestId != "request-1" {
+			t.Fatalf("event ID %q, request ID %q", event.EventId, event.RequestId)
+		}
+		if event.Attributes["count"] != "2" || event.Attributes["user_agent"] != "test-agent" {
+			t.Fatalf("attributes = %v", event.Attributes)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("event was not published")
+	}
+}
+
+func TestImportTasksPassesEventMeta(t *testing.T) {
+	db := &fakeDBClient{}
+	s := NewTaskService(db, nil, nil, nil, nil)
+
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(
+		RequestIDHeader, "request-1",
+		forwardedForKey, "203.0.113.7",
+		gatewayUserAgentKey, "test-agent",
+	))
+	stream := &importStream{ctx: ctx, requests: []*pb.ImportTasksRequest{
+		{Payload: &pb.ImportTasksRequest_Options{Options: &pb.ImportOptions{Format: "csv"}}},
+		{Payload: &pb.ImportTasksRequest_Chunk{Chunk: []byte("title\nfirst\n")}},
+	}}
+	if err := s.ImportTasks(stream); err != nil {
+		t.Fatal(err)
+	}
+	if stream.resp.GetImportedCount() != 1 {
+		t.Fatalf("imported %d tasks, want 1", stream.resp.GetImportedCount())
+	}
+
+	// db_service записывает эти сведения в события созданных задач
+	for key, want := range map[string]string{
+		dbRequestIDKey:                     "request-1",
+		dbEventAttributeKey + "client_ip":  "203.0.113.7",
+		dbEventAttributeKey + "user_agent": "test-agent",
+		dbEventAttributeKey + "origin":     originImport,
+	} {
+		if got := db.outgoing.Get(key); len(got) != 1 || got[0] != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/importer"
+	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Запас на поля формы и заголовки частей multipart сверх размера файла
+const multipartOverhead = 1 << 20
+
+// ImportTasks принимает файл потоком: первое сообщение - параметры импорта, следующие - части файла.
+// EventMetaInterceptor работает только для unary-вызовов, поэтому сведения о запросе для событий
+// созданных задач определяются здесь.
+func (s *TaskService) ImportTasks(stream pb.TaskService_ImportTasksServer) error {
+	ctx := withEventMeta(stream.Context(), eventMeta(stream.Context()))
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Errorf(codes.InvalidArgument, "import options are required")
+	}
+	if err != nil {
+		return err
+	}
+	options := first.GetOptions()
+	if options == nil {
+		return status.Errorf(codes.InvalidArgument, "first message must contain import options")
+	}
+
+	var file bytes.Buffer
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if msg.GetOptions() != nil {
+			return status.Errorf(codes.InvalidArgument, "import options must be sent only in the first message")
+		}
+		if file.Len()+len(msg.GetChunk()) > importer.MaxFileSize {
+			return status.Errorf(codes.InvalidArgument, "file is larger than %d MB", importer.MaxFileSize>>20)
+		}
+		file.Write(msg.GetChunk())
+	}
+
+	resp, err := s.importTasks(ctx, userID, options, &file)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// ImportUploadHandler возвращает обработчик multipart-загрузки для POST /v1/import.
+// Поля формы: file (обязательно), format (по умолчанию определяется по расширению файла),
+// dry_run и list_id. Если в файле есть ошибки, а dry_run не задан, ответ - 422 с отчетом.
+func (s *TaskService) ImportUploadHandler(mux *runtime.ServeMux, auth *middleware.AuthInterceptor) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+		fail := func(err error) {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+		}
+
+		ctx, err := auth.AuthenticateHTTP(r)
+		if err != nil {
+			fail(err)
+			return
+		}
+		userID, err := middleware.GetUserIDFromContext(ctx)
+		if err != nil {
+			fail(err)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, importer.MaxFileSize+multipartOverhead)
+		if err := r.ParseMultipartForm(importer.MaxFileSize); err != nil {
+			fail(status.Errorf(codes.InvalidArgument, "invalid multipart form: %v", err))
+			return
+		}
+		defer r.MultipartForm.RemoveAll()
+
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			fail(status.Errorf(codes.InvalidArgument, "file is required: %v", err))
+			return
+		}
+		defer file.Close()
+
+		options := &pb.ImportOptions{
+			Format: r.FormValue("format"),
+			ListId: r.FormValue("list_id"),
+		}
+		if options.Format == "" {
+			options.Format = importer.FormatFromFilename(header.Filename)
+		}
+		if value := r.FormValue("dry_run"); value != "" {
+			if options.DryRun, err = strconv.ParseBool(value); err != nil {
+				fail(status.Errorf(codes.InvalidArgument, "invalid dry_run value %q", value))
+				return
+			}
+		}
+
+		resp, err := s.importTasks(withEventMeta(ctx, httpEventMeta(r)), userID, options, file)
+		if err != nil {
+			fail(err)
+			return
+		}
+
+		buf, err := marshaler.Marshal(resp)
+		if err != nil {
+			fail(status.Errorf(codes.Internal, "failed to marshal response: %v", err))
+			return
+		}
+
+		httpStatus := http.StatusOK
+		if !resp.DryRun && len(resp.Errors) > 0 {
+			httpStatus = http.StatusUnprocessableEntity
+		}
+		w.Header().Set("Content-Type", marshaler.ContentType(resp))
+		w.WriteHeader(httpStatus)
+		w.Write(buf)
+	}
+}
+
+// Имя метода импорта для ключей идемпотентности: импорт через gRPC и HTTP загрузку - один метод
+const importTasksMethod = "/checklist.api.TaskService/ImportTasks"
+
+// importTasks импортирует файл. С Idempotency-Key повтор того же импорта (те же параметры и файл)
+// возвращает сохраненный ответ и не создает задачи второй раз.
+func (s *TaskService) importTasks(ctx context.Context, userID string, options *pb.ImportOptions, file io.Reader) (*pb.ImportTasksResponse, error) {
+	data, err := io.ReadAll(io.LimitReader(file, importer.MaxFileSize+1))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to read file: %v", err)
+	}
+	if len(data) > importer.MaxFileSize {
+		return nil, status.Errorf(codes.InvalidArgument, "file is larger than %d MB", importer.MaxFileSize>>20)
+	}
+
+	resp, err := s.idempotency.Execute(ctx, importTasksMethod, func(ctx context.Context) (any, error) {
+		return s.importFile(ctx, userID, options, bytes.NewReader(data))
+	}, options, wrapperspb.Bytes(data))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.ImportTasksResponse), nil
+}
+
+// importFile разбирает и проверяет файл. Задачи создаются одной транзакцией в db_service,
+// только если это не dry-run и в файле нет ошибок.
+func (s *TaskService) importFile(ctx context.Context, userID string, options *pb.ImportOptions, file io.Reader) (*pb.ImportTasksResponse, error) {
+	format := importer.NormalizeFormat(options.Format)
+	if format == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported import format %q, expected csv, todotxt, json or ics", options.Format)
+	}
+
+	result, err := importer.Parse(format, file)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &pb.ImportTasksResponse{
+		DryRun:    options.DryRun,
+		TotalRows: int32(result.Total),
+		Errors:    make([]*pb.ImportRowError, len(result.Errors)),
+	}
+	for i, rowErr := range result.Errors {
+		resp.Errors[i] = &pb.ImportRowError{
+			Row:     int32(rowErr.Row),
+			Message: rowErr.Message,
+		}
+	}
+	if options.DryRun || len(result.Errors) > 0 || len(result.Tasks) == 0 {
+		return resp, nil
+	}
+
+	ctx = withEventAttributes(ctx, map[string]string{"origin": originImport})
+	importResp, err := s.dbClient.ImportTasks(ctx, &dbpb.ImportTasksRequest{
+		UserId: userID,
+		ListId: strings.TrimSpace(options.ListId),
+		Items:  toImportItems(result.Tasks),
+	})
+	if err != nil {
+		return nil, dbError(err, "import tasks")
+	}
+
+	resp.ImportedCount = int32(len(importResp.Tasks))
+	resp.Tasks = make([]*pb.Task, len(importResp.Tasks))
+	for i, task := range importResp.Tasks {
+		resp.Tasks[i] = toTask(task)
+	}
+	resp.UpdatedCount = int32(len(importResp.UpdatedTasks))
+	resp.UpdatedTasks = make([]*pb.Task, len(importResp.UpdatedTasks))
+	for i, task := range importResp.UpdatedTasks {
+		resp.UpdatedTasks[i] = toTask(task)
+	}
+	resp.CreatedLists = make([]*pb.TaskList, len(importResp.CreatedLists))
+	for i, list := range importResp.CreatedLists {
+		resp.CreatedLists[i] = toTaskList(list)
+	}
+
+	return resp, nil
+}
+
+// toImportItems преобразует разобранные задачи в формат db_service
+func toImportItems(tasks []*importer.Task) []*dbpb.ImportTaskItem {
+	items := make([]*dbpb.ImportTaskItem, len(tasks))
+	for i, task := range tasks {
+		items[i] = &dbpb.ImportTaskItem{
+			Title:       task.Title,
+			Description: task.Description,
+			Completed:   task.Completed,
+			CompletedAt: optionalTimestamp(task.CompletedAt),
+			DueAt:       optionalTimestamp(task.DueAt),
+			Tags:        task.Tags,
+			ListName:    task.ListName,
+			Subtasks:    toImportItems(task.Subtasks),
+			IcalUid:     task.UID,
+		}
+	}
+	return items
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
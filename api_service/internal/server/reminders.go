@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateReminder создает напоминание о задаче: на момент remind_at или за offset_seconds до срока задачи
+func (s *TaskService) CreateReminder(ctx context.Context, req *pb.CreateReminderRequest) (*pb.CreateReminderResponse, error) {
+	if strings.TrimSpace(req.TaskId) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "task id is required")
+	}
+	if (req.RemindAt == nil) == (req.OffsetSeconds == nil) {
+		return nil, status.Errorf(codes.InvalidArgument, "exactly one of remind_at and offset_seconds must be set")
+	}
+	if req.RemindAt != nil {
+		if err := req.RemindAt.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid remind_at: %v", err)
+		}
+		if !req.RemindAt.AsTime().After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "remind_at must be in the future")
+		}
+	}
+	if req.OffsetSeconds != nil && *req.OffsetSeconds < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset_seconds cannot be negative")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createReminderResp, err := s.dbClient.CreateReminder(ctx, &dbpb.CreateReminderRequest{
+		TaskId:        req.TaskId,
+		UserId:        userID,
+		RemindAt:      req.RemindAt,
+		OffsetSeconds: req.OffsetSeconds,
+	})
+	if err != nil {
+		return nil, dbError(err, "create reminder")
+	}
+
+	return &pb.CreateReminderResponse{Reminder: toReminder(createReminderResp.Reminder)}, nil
+}
+
+// GetReminders возвращает напоминания задачи
+func (s *TaskService) GetReminders(ctx context.Context, req *pb.GetRemindersRequest) (*pb.GetRemindersResponse, error) {
+	if strings.TrimSpace(req.TaskId) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "task id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	getRemindersResp, err := s.dbClient.GetReminders(ctx, &dbpb.GetRemindersRequest{
+		TaskId: req.TaskId,
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, dbError(err, "get reminders")
+	}
+
+	reminders := make([]*pb.Reminder, len(getRemindersResp.Reminders))
+	for i, reminder := range getRemindersResp.Reminders {
+		reminders[i] = toReminder(reminder)
+	}
+
+	return &pb.GetRemindersResponse{Reminders: reminders}, nil
+}
+
+// DeleteReminder удаляет напоминание
+func (s *TaskService) DeleteReminder(ctx context.Context, req *pb.DeleteReminderRequest) (*pb.DeleteReminderResponse, error) {
+	if strings.TrimSpace(req.TaskId) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "task id is required")
+	}
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reminder id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteReminderResp, err := s.dbClient.DeleteReminder(ctx, &dbpb.DeleteReminderRequest{
+		Id:     req.Id,
+		TaskId: req.TaskId,
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, dbError(err, "delete reminder")
+	}
+
+	if !deleteReminderResp.Success {
+		return nil, status.Errorf(codes.NotFound, "reminder not found")
+	}
+
+	return &pb.DeleteReminderResponse{
+		Success: true,
+		Message: "reminder deleted successfully",
+	}, nil
+}
+
+// toReminder преобразует напоминание db_service в напоминание API
+func toReminder(reminder *dbpb.Reminder) *pb.Reminder {
+	if reminder == nil {
+		return nil
+	}
+
+	return &pb.Reminder{
+		Id:            reminder.Id,
+		TaskId:        reminder.TaskId,
+		RemindAt:      reminder.RemindAt,
+		OffsetSeconds: reminder.OffsetSeconds,
+		FireAt:        reminder.FireAt,
+		Status:        reminder.Status,
+		Attempts:      reminder.Attempts,
+		LastError:     reminder.LastError,
+		SentAt:        reminder.SentAt,
+		CreatedAt:     reminder.CreatedAt,
+	}
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetSettings возвращает настройки пользователя
+func (s *TaskService) GetSettings(ctx context.Context, req *pb.GetSettingsRequest) (*pb.GetSettingsResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settingsResp, err := s.dbClient.GetSettings(ctx, &dbpb.GetSettingsRequest{UserId: userID})
+	if err != nil {
+		return nil, dbError(err, "get settings")
+	}
+
+	return &pb.GetSettingsResponse{
+		Settings: toUserSettings(settingsResp.Settings),
+	}, nil
+}
+
+// UpdateSettings изменяет переданные поля настроек пользователя.
+// Часовой пояс и локаль проверяет db_service.
+func (s *TaskService) UpdateSettings(ctx context.Context, req *pb.UpdateSettingsRequest) (*pb.UpdateSettingsResponse, error) {
+	if req.TimeZone != nil && strings.TrimSpace(*req.TimeZone) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "time_zone cannot be empty")
+	}
+	if req.Locale != nil && strings.TrimSpace(*req.Locale) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "locale cannot be empty")
+	}
+	if req.WeekStart != nil && (*req.WeekStart < 0 || *req.WeekStart > 6) {
+		return nil, status.Errorf(codes.InvalidArgument, "week_start must be between 0 (Sunday) and 6 (Saturday)")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updateReq := &dbpb.UpdateSettingsRequest{
+		UserId:    userID,
+		TimeZone:  req.TimeZone,
+		Locale:    req.Locale,
+		WeekStart: req.WeekStart,
+	}
+	if req.DefaultListId != nil {
+		listID := strings.TrimSpace(*req.DefaultListId)
+		updateReq.DefaultListId = &listID
+	}
+	if req.DefaultSort != nil {
+		if _, ok := pb.TaskSort_name[int32(*req.DefaultSort)]; !ok || *req.DefaultSort == pb.TaskSort_TASK_SORT_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown task sort: %d", *req.DefaultSort)
+		}
+		sort := dbpb.TaskSort(*req.DefaultSort)
+		updateReq.DefaultSort = &sort
+	}
+
+	settingsResp, err := s.dbClient.UpdateSettings(ctx, updateReq)
+	if err != nil {
+		return nil, dbError(err, "update settings")
+	}
+
+	return &pb.UpdateSettingsResponse{
+		Settings: toUserSettings(settingsResp.Settings),
+	}, nil
+}
+
+// toUserSettings преобразует настройки db_service в настройки API
+func toUserSettings(settings *dbpb.UserSettings) *pb.UserSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &pb.UserSettings{
+		TimeZone:      settings.TimeZone,
+		Locale:        settings.Locale,
+		WeekStart:     settings.WeekStart,
+		DefaultListId: settings.DefaultListId,
+		DefaultSort:   pb.TaskSort(settings.DefaultSort),
+		UpdatedAt:     settings.UpdatedAt,
+	}
+}
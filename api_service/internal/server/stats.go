@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+)
+
+// GetStats возвращает статистику выполнения задач пользователя
+func (s *TaskService) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*pb.GetStatsResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statsResp, err := s.dbClient.GetStats(ctx, &dbpb.GetStatsRequest{UserId: userID})
+	if err != nil {
+		return nil, dbError(err, "get stats")
+	}
+
+	stats := statsResp.Stats
+	if stats == nil {
+		return &pb.GetStatsResponse{}, nil
+	}
+
+	return &pb.GetStatsResponse{
+		Stats: &pb.TaskStats{
+			OpenCount:            stats.OpenCount,
+			OverdueCount:         stats.OverdueCount,
+			CompletedCount:       stats.CompletedCount,
+			AvgCompletionSeconds: stats.AvgCompletionSeconds,
+			CurrentStreakDays:    stats.CurrentStreakDays,
+			LongestStreakDays:    stats.LongestStreakDays,
+			Daily:                toStatsBuckets(stats.Daily),
+			Weekly:               toStatsBuckets(stats.Weekly),
+			Monthly:              toStatsBuckets(stats.Monthly),
+			TimeZone:             stats.TimeZone,
+			GeneratedAt:          stats.GeneratedAt,
+		},
+	}, nil
+}
+
+func toStatsBuckets(buckets []*dbpb.StatsBucket) []*pb.StatsBucket {
+	result := make([]*pb.StatsBucket, len(buckets))
+	for i, bucket := range buckets {
+		result[i] = &pb.StatsBucket{
+			Start:     bucket.Start,
+			Completed: bucket.Completed,
+		}
+	}
+	return result
+}
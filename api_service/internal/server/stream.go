@@ -0,0 +1,274 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// Сколько ждать записи в соединение; медленный клиент отключается
+	streamWriteTimeout = 10 * time.Second
+
+	// Через сколько миллисекунд EventSource переподключается после обрыва
+	sseRetryMillis = 3000
+
+	// Параметр запроса с JWT токеном: браузер не может задать заголовок Authorization
+	// для WebSocket и EventSource
+	accessTokenParam = "access_token"
+)
+
+// StreamBridge отдает изменения задач (те же, что WatchTasks) браузерам через WebSocket и
+// Server-Sent Events. Медленные клиенты отключаются: при переполнении буфера подписки хаб
+// закрывает ее, а запись, не завершившаяся за streamWriteTimeout, обрывает соединение.
+// Клиент переподключается с последним resume_token и получает пропущенные изменения.
+type StreamBridge struct {
+	service    *TaskService
+	auth       *middleware.AuthInterceptor
+	heartbeat  time.Duration
+	maxPerUser int
+
+	mu          sync.Mutex
+	connections map[string]int
+}
+
+// StreamBridge создает мост; maxPerUser - лимит одновременных соединений одного пользователя
+// (WebSocket и SSE вместе), heartbeat - интервал сообщений, не дающих прокси закрыть соединение
+func (s *TaskService) StreamBridge(auth *middleware.AuthInterceptor, maxPerUser int, heartbeat time.Duration) *StreamBridge {
+	return &StreamBridge{
+		service:     s,
+		auth:        auth,
+		heartbeat:   heartbeat,
+		maxPerUser:  maxPerUser,
+		connections: make(map[string]int),
+	}
+}
+
+// WebSocketHandler возвращает обработчик GET /v1/stream/ws. Сообщения сервера - JSON в формате
+// потоков gRPC Gateway: {"result": TaskChange}, {"error": {...}} перед закрытием
+// и {"heartbeat": "<время>"}. Сообщения клиента игнорируются.
+func (b *StreamBridge) WebSocketHandler(mux *runtime.ServeMux) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		userID, release, ok := b.open(mux, w, r)
+		if !ok {
+			return
+		}
+		defer release()
+
+		resumeToken := r.URL.Query().Get("resume_token")
+		server := websocket.Server{
+			// Запросы с других источников разрешены: доступ определяется JWT токеном, а не cookie
+			Handshake: func(*websocket.Config, *http.Request) error { return nil },
+			Handler: func(conn *websocket.Conn) {
+				b.serveWebSocket(conn, userID, resumeToken)
+			},
+		}
+		server.ServeHTTP(w, r)
+	}
+}
+
+func (b *StreamBridge) serveWebSocket(conn *websocket.Conn, userID, resumeToken string) {
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(conn.Request().Context())
+	defer cancel()
+
+	// Чтение нужно, чтобы обработать закрытие соединения клиентом
+	go func() {
+		defer cancel()
+		var msg []byte
+		for {
+			if err := websocket.Message.Receive(conn, &msg); err != nil {
+				return
+			}
+		}
+	}()
+
+	var writeMu sync.Mutex
+	write := func(data []byte) error {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+		return websocket.Message.Send(conn, string(data))
+	}
+
+	stopHeartbeats := b.startHeartbeats(ctx, cancel, func(now time.Time) error {
+		return write([]byte(fmt.Sprintf(`{"heartbeat":%q}`, now.UTC().Format(time.RFC3339))))
+	})
+	defer stopHeartbeats()
+
+	err := b.service.watchTasks(ctx, userID, resumeToken, func(change *pb.TaskChange) error {
+		data, err := protojson.Marshal(change)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to marshal task change: %v", err)
+		}
+		return write([]byte(`{"result":` + string(data) + `}`))
+	})
+	if err != nil && ctx.Err() == nil {
+		write(streamErrorJSON(err))
+	}
+}
+
+// SSEHandler возвращает обработчик GET /v1/stream/sse. События change содержат TaskChange,
+// id события - resume_token, поэтому EventSource сам передает его в Last-Event-ID при переподключении.
+// Перед закрытием из-за ошибки отправляется событие error; heartbeat - комментарий.
+func (b *StreamBridge) SSEHandler(mux *runtime.ServeMux) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		userID, release, ok := b.open(mux, w, r)
+		if !ok {
+			return
+		}
+		defer release()
+
+		resumeToken := r.Header.Get("Last-Event-ID")
+		if resumeToken == "" {
+			resumeToken = r.URL.Query().Get("resume_token")
+		}
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		rc := http.NewResponseController(w)
+		var writeMu sync.Mutex
+		write := func(data string) error {
+			writeMu.Lock()
+			defer writeMu.Unlock()
+			rc.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+			if _, err := fmt.Fprint(w, data); err != nil {
+				return err
+			}
+			return rc.Flush()
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		// Отключает буферизацию ответа в nginx
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+		if err := write(fmt.Sprintf("retry: %d\n\n", sseRetryMillis)); err != nil {
+			return
+		}
+
+		// Ответ нельзя использовать после выхода из обработчика, поэтому heartbeat останавливается до него
+		stopHeartbeats := b.startHeartbeats(ctx, cancel, func(now time.Time) error {
+			return write(": heartbeat " + now.UTC().Format(time.RFC3339) + "\n\n")
+		})
+		defer stopHeartbeats()
+
+		err := b.service.watchTasks(ctx, userID, resumeToken, func(change *pb.TaskChange) error {
+			data, err := protojson.Marshal(change)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to marshal task change: %v", err)
+			}
+			event := "event: change\n"
+			if change.ResumeToken != "" {
+				event += "id: " + change.ResumeToken + "\n"
+			}
+			return write(event + "data: " + string(data) + "\n\n")
+		})
+		if err != nil && ctx.Err() == nil {
+			write("event: error\ndata: " + string(streamErrorJSON(err)) + "\n\n")
+		}
+	}
+}
+
+// open проверяет JWT токен и лимит соединений. Возвращает функцию, освобождающую соединение;
+// при ошибке ответ уже отправлен.
+func (b *StreamBridge) open(mux *runtime.ServeMux, w http.ResponseWriter, r *http.Request) (string, func(), bool) {
+	_, marshaler := runtime.MarshalerForRequest(mux, r)
+	fail := func(err error) {
+		runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+	}
+
+	if r.Header.Get("Authorization") == "" {
+		if token := r.URL.Query().Get(accessTokenParam); token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+	ctx, err := b.auth.AuthenticateHTTP(r)
+	if err != nil {
+		fail(err)
+		return "", nil, false
+	}
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		fail(err)
+		return "", nil, false
+	}
+
+	b.mu.Lock()
+	if b.maxPerUser > 0 && b.connections[userID] >= b.maxPerUser {
+		b.mu.Unlock()
+		fail(status.Errorf(codes.ResourceExhausted, "too many open streams, at most %d per user", b.maxPerUser))
+		return "", nil, false
+	}
+	b.connections[userID]++
+	b.mu.Unlock()
+
+	release := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.connections[userID]--
+		if b.connections[userID] <= 0 {
+			delete(b.connections, userID)
+		}
+	}
+	return userID, release, true
+}
+
+// startHeartbeats вызывает send с интервалом b.heartbeat, пока не отменен контекст; если запись
+// не удалась, вызывает cancel. Возвращает функцию, которая останавливает heartbeat и ждет его завершения.
+func (b *StreamBridge) startHeartbeats(ctx context.Context, cancel context.CancelFunc, send func(time.Time) error) func() {
+	if b.heartbeat <= 0 {
+		return func() {}
+	}
+
+	ctx, stop := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(b.heartbeat)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if err := send(now); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	return func() {
+		stop()
+		<-done
+	}
+}
+
+// streamErrorJSON возвращает ошибку в формате gRPC Gateway: {"error": {"code": ..., "message": ...}}
+func streamErrorJSON(err error) []byte {
+	st := status.Convert(err)
+	message := st.Message()
+	if st.Code() == codes.Unknown || st.Code() == codes.Internal {
+		message = "internal error"
+	}
+
+	data, _ := json.Marshal(map[string]any{
+		"error": map[string]any{"code": int(st.Code()), "message": message},
+	})
+	return data
+}
@@ -0,0 +1,375 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateList создает список задач
+func (s *TaskService) CreateList(ctx context.Context, req *pb.CreateListRequest) (*pb.CreateListResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "list name is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createListResp, err := s.dbClient.CreateList(ctx, &dbpb.CreateListRequest{
+		UserId: userID,
+		Name:   name,
+	})
+	if err != nil {
+		return nil, dbError(err, "create list")
+	}
+
+	return &pb.CreateListResponse{
+		List: toTaskList(createListResp.List),
+	}, nil
+}
+
+// GetLists возвращает списки задач пользователя
+func (s *TaskService) GetLists(ctx context.Context, req *pb.GetListsRequest) (*pb.GetListsResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	getListsResp, err := s.dbClient.GetLists(ctx, &dbpb.GetListsRequest{UserId: userID})
+	if err != nil {
+		return nil, dbError(err, "get lists")
+	}
+
+	lists := make([]*pb.TaskList, len(getListsResp.Lists))
+	for i, list := range getListsResp.Lists {
+		lists[i] = toTaskList(list)
+	}
+
+	return &pb.GetListsResponse{Lists: lists}, nil
+}
+
+// DeleteList удаляет список, задачи списка остаются без списка
+func (s *TaskService) DeleteList(ctx context.Context, req *pb.DeleteListRequest) (*pb.DeleteListResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "list id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteListResp, err := s.dbClient.DeleteList(ctx, &dbpb.DeleteListRequest{
+		Id:     req.Id,
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, dbError(err, "delete list")
+	}
+
+	if !deleteListResp.Success {
+		return nil, status.Errorf(codes.NotFound, "list not found")
+	}
+
+	return &pb.DeleteListResponse{
+		Success: true,
+		Message: "list deleted successfully",
+	}, nil
+}
+
+// CreateTemplate создает шаблон чек-листа
+func (s *TaskService) CreateTemplate(ctx context.Context, req *pb.CreateTemplateRequest) (*pb.CreateTemplateResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "template name is required")
+	}
+
+	items, err := toDbBlueprints(req.Items, false)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createTemplateResp, err := s.dbClient.CreateTemplate(ctx, &dbpb.CreateTemplateRequest{
+		UserId:      userID,
+		Name:        name,
+		Description: strings.TrimSpace(req.Description),
+		Items:       items,
+	})
+	if err != nil {
+		return nil, dbError(err, "create template")
+	}
+
+	return &pb.CreateTemplateResponse{
+		Template: toTaskTemplate(createTemplateResp.Template),
+	}, nil
+}
+
+// GetTemplates возвращает шаблоны пользователя
+func (s *TaskService) GetTemplates(ctx context.Context, req *pb.GetTemplatesRequest) (*pb.GetTemplatesResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	getTemplatesResp, err := s.dbClient.GetTemplates(ctx, &dbpb.GetTemplatesRequest{UserId: userID})
+	if err != nil {
+		return nil, dbError(err, "get templates")
+	}
+
+	templates := make([]*pb.TaskTemplate, len(getTemplatesResp.Templates))
+	for i, template := range getTemplatesResp.Templates {
+		templates[i] = toTaskTemplate(template)
+	}
+
+	return &pb.GetTemplatesResponse{Templates: templates}, nil
+}
+
+// GetTemplate возвращает шаблон по ID
+func (s *TaskService) GetTemplate(ctx context.Context, req *pb.GetTemplateRequest) (*pb.GetTemplateResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "template id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	getTemplateResp, err := s.dbClient.GetTemplate(ctx, &dbpb.GetTemplateRequest{
+		Id:     req.Id,
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, dbError(err, "get template")
+	}
+
+	return &pb.GetTemplateResponse{
+		Template: toTaskTemplate(getTemplateResp.Template),
+	}, nil
+}
+
+// UpdateTemplate заменяет название, описание и задачи шаблона
+func (s *TaskService) UpdateTemplate(ctx context.Context, req *pb.UpdateTemplateRequest) (*pb.UpdateTemplateResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "template id is required")
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "template name is required")
+	}
+
+	items, err := toDbBlueprints(req.Items, false)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updateTemplateResp, err := s.dbClient.UpdateTemplate(ctx, &dbpb.UpdateTemplateRequest{
+		Id:          req.Id,
+		UserId:      userID,
+		Name:        name,
+		Description: strings.TrimSpace(req.Description),
+		Items:       items,
+	})
+	if err != nil {
+		return nil, dbError(err, "update template")
+	}
+
+	return &pb.UpdateTemplateResponse{
+		Template: toTaskTemplate(updateTemplateResp.Template),
+	}, nil
+}
+
+// DeleteTemplate удаляет шаблон
+func (s *TaskService) DeleteTemplate(ctx context.Context, req *pb.DeleteTemplateRequest) (*pb.DeleteTemplateResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "template id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteTemplateResp, err := s.dbClient.DeleteTemplate(ctx, &dbpb.DeleteTemplateRequest{
+		Id:     req.Id,
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, dbError(err, "delete template")
+	}
+
+	if !deleteTemplateResp.Success {
+		return nil, status.Errorf(codes.NotFound, "template not found")
+	}
+
+	return &pb.DeleteTemplateResponse{
+		Success: true,
+		Message: "template deleted successfully",
+	}, nil
+}
+
+// InstantiateTemplate создает задачи по шаблону в существующем или новом списке
+func (s *TaskService) InstantiateTemplate(ctx context.Context, req *pb.InstantiateTemplateRequest) (*pb.InstantiateTemplateResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "template id is required")
+	}
+	listID := strings.TrimSpace(req.ListId)
+	newListName := strings.TrimSpace(req.NewListName)
+	if listID != "" && newListName != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "list_id and new_list_name cannot be set together")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withEventAttributes(ctx, map[string]string{"origin": originTemplate})
+	instantiateResp, err := s.dbClient.InstantiateTemplate(ctx, &dbpb.InstantiateTemplateRequest{
+		TemplateId:  req.Id,
+		UserId:      userID,
+		ListId:      listID,
+		NewListName: newListName,
+		StartAt:     req.StartAt,
+	})
+	if err != nil {
+		return nil, dbError(err, "instantiate template")
+	}
+
+	tasks := make([]*pb.Task, len(instantiateResp.Tasks))
+	for i, task := range instantiateResp.Tasks {
+		tasks[i] = toTask(task)
+	}
+
+	return &pb.InstantiateTemplateResponse{
+		Tasks: tasks,
+		List:  toTaskList(instantiateResp.List),
+	}, nil
+}
+
+// SaveListAsTemplate сохраняет задачи списка как новый шаблон
+func (s *TaskService) SaveListAsTemplate(ctx context.Context, req *pb.SaveListAsTemplateRequest) (*pb.SaveListAsTemplateResponse, error) {
+	if strings.TrimSpace(req.ListId) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "list id is required")
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "template name is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	saveResp, err := s.dbClient.SaveListAsTemplate(ctx, &dbpb.SaveListAsTemplateRequest{
+		ListId:      req.ListId,
+		UserId:      userID,
+		Name:        name,
+		Description: strings.TrimSpace(req.Description),
+		BaseTime:    req.BaseTime,
+	})
+	if err != nil {
+		return nil, dbError(err, "save list as template")
+	}
+
+	return &pb.SaveListAsTemplateResponse{
+		Template: toTaskTemplate(saveResp.Template),
+	}, nil
+}
+
+// toDbBlueprints проверяет заготовки задач и преобразует их в формат db_service.
+// Допускается один уровень подзадач, как и у обычных задач.
+func toDbBlueprints(items []*pb.TaskBlueprint, nested bool) ([]*dbpb.TaskBlueprint, error) {
+	result := make([]*dbpb.TaskBlueprint, len(items))
+	for i, item := range items {
+		title := strings.TrimSpace(item.Title)
+		if title == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "template item title is required")
+		}
+		if nested && len(item.Subtasks) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "template subtasks cannot have their own subtasks")
+		}
+
+		tags, err := normalizeTags(item.Tags)
+		if err != nil {
+			return nil, err
+		}
+		subtasks, err := toDbBlueprints(item.Subtasks, true)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = &dbpb.TaskBlueprint{
+			Title:            title,
+			Description:      strings.TrimSpace(item.Description),
+			DueOffsetSeconds: item.DueOffsetSeconds,
+			Tags:             tags,
+			Subtasks:         subtasks,
+		}
+	}
+	return result, nil
+}
+
+func toBlueprints(items []*dbpb.TaskBlueprint) []*pb.TaskBlueprint {
+	result := make([]*pb.TaskBlueprint, len(items))
+	for i, item := range items {
+		result[i] = &pb.TaskBlueprint{
+			Title:            item.Title,
+			Description:      item.Description,
+			DueOffsetSeconds: item.DueOffsetSeconds,
+			Tags:             item.Tags,
+			Subtasks:         toBlueprints(item.Subtasks),
+		}
+	}
+	return result
+}
+
+// toTaskTemplate преобразует шаблон db_service в шаблон API
+func toTaskTemplate(template *dbpb.TaskTemplate) *pb.TaskTemplate {
+	if template == nil {
+		return nil
+	}
+
+	return &pb.TaskTemplate{
+		Id:          template.Id,
+		UserId:      template.UserId,
+		Name:        template.Name,
+		Description: template.Description,
+		Items:       toBlueprints(template.Items),
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}
+}
+
+// toTaskList преобразует список db_service в список API
+func toTaskList(list *dbpb.TaskList) *pb.TaskList {
+	if list == nil {
+		return nil
+	}
+
+	return &pb.TaskList{
+		Id:        list.Id,
+		UserId:    list.UserId,
+		Name:      list.Name,
+		CreatedAt: list.CreatedAt,
+	}
+}
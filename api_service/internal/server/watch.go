@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
+	"github.com/bagdasarian/checklist-app/api_service/internal/watch"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	kafkapb "github.com/bagdasarian/checklist-app/api_service/pkg/pb/kafka"
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Типы изменений для событий Kafka; события без типа (например, GetTasks) в поток не попадают
+var taskChangeTypes = map[kafkapb.ActionType]pb.TaskChangeType{
+	kafkapb.ActionType_ACTION_CREATE_TASK:    pb.TaskChangeType_TASK_CHANGE_TYPE_CREATED,
+	kafkapb.ActionType_ACTION_UPDATE_TASK:    pb.TaskChangeType_TASK_CHANGE_TYPE_UPDATED,
+	kafkapb.ActionType_ACTION_REVERT_TASK:    pb.TaskChangeType_TASK_CHANGE_TYPE_UPDATED,
+	kafkapb.ActionType_ACTION_ARCHIVE_TASK:   pb.TaskChangeType_TASK_CHANGE_TYPE_UPDATED,
+	kafkapb.ActionType_ACTION_UNARCHIVE_TASK: pb.TaskChangeType_TASK_CHANGE_TYPE_UPDATED,
+	kafkapb.ActionType_ACTION_COMPLETE_TASK:  pb.TaskChangeType_TASK_CHANGE_TYPE_COMPLETED,
+	kafkapb.ActionType_ACTION_DELETE_TASK:    pb.TaskChangeType_TASK_CHANGE_TYPE_DELETED,
+}
+
+// WatchTasks отправляет изменения задач пользователя, пока клиент не отключится.
+// С resume_token сначала отправляются пропущенные изменения, затем сообщение SYNCED.
+// Без токена SYNCED отправляется сразу, чтобы у клиента был токен для переподключения.
+func (s *TaskService) WatchTasks(req *pb.WatchTasksRequest, stream pb.TaskService_WatchTasksServer) error {
+	ctx := stream.Context()
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.watchTasks(ctx, userID, req.ResumeToken, stream.Send)
+}
+
+// watchTasks передает изменения задач в send до отмены контекста. Используется gRPC методом
+// WatchTasks и мостами WebSocket/SSE; ошибки возвращаются в виде gRPC статусов.
+func (s *TaskService) watchTasks(ctx context.Context, userID, resumeToken string, send func(*pb.TaskChange) error) error {
+	if s.watchHub == nil {
+		return status.Errorf(codes.Unavailable, "task updates are disabled")
+	}
+
+	var from watch.Position
+	if resumeToken != "" {
+		var err error
+		if from, err = watch.ParseToken(resumeToken); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	sub, current, err := s.watchHub.Subscribe(userID)
+	if errors.Is(err, watch.ErrNotReady) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to subscribe: %v", err)
+	}
+	defer sub.Close()
+
+	sendEvent := func(event watch.Event) error {
+		change, err := s.taskChange(ctx, userID, event)
+		if err != nil || change == nil {
+			return err
+		}
+		return send(change)
+	}
+
+	if from != nil {
+		err := s.watchHub.ReadRange(ctx, userID, from, current, sendEvent)
+		if errors.Is(err, watch.ErrTokenExpired) {
+			return status.Errorf(codes.OutOfRange, "%v, reload tasks and watch without resume_token", err)
+		}
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "failed to read missed task updates: %v", err)
+		}
+	}
+
+	err = send(&pb.TaskChange{
+		Type:        pb.TaskChangeType_TASK_CHANGE_TYPE_SYNCED,
+		ResumeToken: current.Token(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return status.Errorf(codes.Unavailable, "client is too slow, reconnect with the last resume_token")
+			}
+			// Токен клиента может быть новее позиции хаба, если он получен от другого экземпляра
+			if offset, ok := from[event.Partition]; ok && event.Offset < offset {
+				continue
+			}
+			if err := sendEvent(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// taskChange преобразует событие Kafka в изменение для клиента. Для всех типов, кроме удаления,
+// добавляется текущее состояние задачи; если задача уже удалена, возвращается nil.
+func (s *TaskService) taskChange(ctx context.Context, userID string, event watch.Event) (*pb.TaskChange, error) {
+	changeType, ok := taskChangeTypes[event.Event.Action]
+	if !ok || event.Event.TaskId == "" {
+		return nil, nil
+	}
+
+	change := &pb.TaskChange{
+		Type:        changeType,
+		TaskId:      event.Event.TaskId,
+		OccurredAt:  event.Event.Timestamp,
+		ResumeToken: event.Token,
+	}
+	if changeType == pb.TaskChangeType_TASK_CHANGE_TYPE_DELETED {
+		return change, nil
+	}
+
+	resp, err := s.dbClient.GetTask(ctx, &dbpb.GetTaskRequest{Id: event.Event.TaskId, UserId: userID})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, dbError(err, "get task")
+	}
+	change.Task = toTask(resp.Task)
+	return change, nil
+}
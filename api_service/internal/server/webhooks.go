@@ -0,0 +1,318 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/middleware"
+	"github.com/bagdasarian/checklist-app/api_service/pkg/pb"
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Типы событий, на которые можно подписать вебхук; события задач отправляет kafka_service,
+// напоминания и дайджесты - db_service
+var webhookEventTypes = []string{
+	"task.created",
+	"task.updated",
+	"task.completed",
+	"task.deleted",
+	"task.archived",
+	"task.unarchived",
+	"task.reverted",
+	"task.reminder",
+	"digest.daily",
+}
+
+// Длина генерируемого секрета вебхука в байтах до кодирования в hex
+const webhookSecretBytes = 32
+
+// CreateWebhook создает вебхук. Секрет возвращается только в ответе на создание.
+func (s *TaskService) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	webhookURL, err := validateWebhookURL(req.Url)
+	if err != nil {
+		return nil, err
+	}
+	eventTypes, err := validateWebhookEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := strings.TrimSpace(req.Secret)
+	if secret == "" {
+		buf := make([]byte, webhookSecretBytes)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+		secret = hex.EncodeToString(buf)
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createWebhookResp, err := s.dbClient.CreateWebhook(ctx, &dbpb.CreateWebhookRequest{
+		UserId:     userID,
+		Url:        webhookURL,
+		Secret:     secret,
+		EventTypes: eventTypes,
+	})
+	if err != nil {
+		return nil, dbError(err, "create webhook")
+	}
+
+	webhook := toWebhook(createWebhookResp.Webhook)
+	webhook.Secret = createWebhookResp.Webhook.Secret
+	return &pb.CreateWebhookResponse{Webhook: webhook}, nil
+}
+
+// GetWebhooks возвращает вебхуки пользователя
+func (s *TaskService) GetWebhooks(ctx context.Context, req *pb.GetWebhooksRequest) (*pb.GetWebhooksResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	getWebhooksResp, err := s.dbClient.GetWebhooks(ctx, &dbpb.GetWebhooksRequest{UserId: userID})
+	if err != nil {
+		return nil, dbError(err, "get webhooks")
+	}
+
+	webhooks := make([]*pb.Webhook, len(getWebhooksResp.Webhooks))
+	for i, webhook := range getWebhooksResp.Webhooks {
+		webhooks[i] = toWebhook(webhook)
+	}
+
+	return &pb.GetWebhooksResponse{Webhooks: webhooks}, nil
+}
+
+// GetWebhook возвращает вебхук по ID
+func (s *TaskService) GetWebhook(ctx context.Context, req *pb.GetWebhookRequest) (*pb.GetWebhookResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	getWebhookResp, err := s.dbClient.GetWebhook(ctx, &dbpb.GetWebhookRequest{
+		Id:     req.Id,
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, dbError(err, "get webhook")
+	}
+
+	return &pb.GetWebhookResponse{Webhook: toWebhook(getWebhookResp.Webhook)}, nil
+}
+
+// UpdateWebhook заменяет адрес и типы событий вебхука, меняет секрет, если он указан,
+// и включает или отключает вебхук
+func (s *TaskService) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook id is required")
+	}
+	webhookURL, err := validateWebhookURL(req.Url)
+	if err != nil {
+		return nil, err
+	}
+	eventTypes, err := validateWebhookEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updateWebhookResp, err := s.dbClient.UpdateWebhook(ctx, &dbpb.UpdateWebhookRequest{
+		Id:         req.Id,
+		UserId:     userID,
+		Url:        webhookURL,
+		Secret:     strings.TrimSpace(req.Secret),
+		EventTypes: eventTypes,
+		Enabled:    req.Enabled,
+	})
+	if err != nil {
+		return nil, dbError(err, "update webhook")
+	}
+
+	return &pb.UpdateWebhookResponse{Webhook: toWebhook(updateWebhookResp.Webhook)}, nil
+}
+
+// DeleteWebhook удаляет вебхук
+func (s *TaskService) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteWebhookResp, err := s.dbClient.DeleteWebhook(ctx, &dbpb.DeleteWebhookRequest{
+		Id:     req.Id,
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, dbError(err, "delete webhook")
+	}
+
+	if !deleteWebhookResp.Success {
+		return nil, status.Errorf(codes.NotFound, "webhook not found")
+	}
+
+	return &pb.DeleteWebhookResponse{
+		Success: true,
+		Message: "webhook deleted successfully",
+	}, nil
+}
+
+// GetWebhookDeliveries возвращает журнал доставок вебхука
+func (s *TaskService) GetWebhookDeliveries(ctx context.Context, req *pb.GetWebhookDeliveriesRequest) (*pb.GetWebhookDeliveriesResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook id is required")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit cannot exceed 100")
+	}
+	if req.Offset < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset cannot be negative")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveriesResp, err := s.dbClient.GetWebhookDeliveries(ctx, &dbpb.GetWebhookDeliveriesRequest{
+		WebhookId: req.Id,
+		UserId:    userID,
+		Limit:     limit,
+		Offset:    req.Offset,
+	})
+	if err != nil {
+		return nil, dbError(err, "get webhook deliveries")
+	}
+
+	deliveries := make([]*pb.WebhookDelivery, len(deliveriesResp.Deliveries))
+	for i, delivery := range deliveriesResp.Deliveries {
+		deliveries[i] = toWebhookDelivery(delivery)
+	}
+
+	return &pb.GetWebhookDeliveriesResponse{Deliveries: deliveries}, nil
+}
+
+// RedeliverWebhook ставит в очередь повторную отправку доставки с тем же телом и event_id
+func (s *TaskService) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.RedeliverWebhookResponse, error) {
+	if strings.TrimSpace(req.Id) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook id is required")
+	}
+	if strings.TrimSpace(req.DeliveryId) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "delivery id is required")
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	redeliverResp, err := s.dbClient.RedeliverWebhook(ctx, &dbpb.RedeliverWebhookRequest{
+		WebhookId:  req.Id,
+		DeliveryId: req.DeliveryId,
+		UserId:     userID,
+	})
+	if err != nil {
+		return nil, dbError(err, "redeliver webhook")
+	}
+
+	return &pb.RedeliverWebhookResponse{Delivery: toWebhookDelivery(redeliverResp.Delivery)}, nil
+}
+
+// validateWebhookURL проверяет, что адрес вебхука - абсолютный http(s) URL
+func validateWebhookURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", status.Errorf(codes.InvalidArgument, "webhook url is required")
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", status.Errorf(codes.InvalidArgument, "webhook url must be an absolute http or https url")
+	}
+	return raw, nil
+}
+
+// validateWebhookEventTypes проверяет типы событий и убирает повторы
+func validateWebhookEventTypes(eventTypes []string) ([]string, error) {
+	var result []string
+	for _, eventType := range eventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if !slices.Contains(webhookEventTypes, eventType) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown event type %q, expected one of: %s",
+				eventType, strings.Join(webhookEventTypes, ", "))
+		}
+		if !slices.Contains(result, eventType) {
+			result = append(result, eventType)
+		}
+	}
+	return result, nil
+}
+
+// toWebhook преобразует вебхук db_service в вебхук API без секрета
+func toWebhook(webhook *dbpb.Webhook) *pb.Webhook {
+	if webhook == nil {
+		return nil
+	}
+
+	return &pb.Webhook{
+		Id:             webhook.Id,
+		UserId:         webhook.UserId,
+		Url:            webhook.Url,
+		EventTypes:     webhook.EventTypes,
+		Enabled:        webhook.Enabled,
+		FailureCount:   webhook.FailureCount,
+		DisabledReason: webhook.DisabledReason,
+		CreatedAt:      webhook.CreatedAt,
+		UpdatedAt:      webhook.UpdatedAt,
+	}
+}
+
+// toWebhookDelivery преобразует доставку db_service в доставку API
+func toWebhookDelivery(delivery *dbpb.WebhookDelivery) *pb.WebhookDelivery {
+	if delivery == nil {
+		return nil
+	}
+
+	return &pb.WebhookDelivery{
+		Id:             delivery.Id,
+		WebhookId:      delivery.WebhookId,
+		EventId:        delivery.EventId,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		RedeliveryOf:   delivery.RedeliveryOf,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
@@ -0,0 +1,571 @@
+// Package spool - ограниченная очередь записей на диске. Записи дописываются в файлы-сегменты
+// <номер>.seg: длина записи, CRC-32C и данные. Позиция первой неподтвержденной записи хранится
+// в файле cursor, поэтому после перезапуска чтение продолжается с нее; записи, подтвержденные
+// перед сбоем, но не попавшие в cursor, читаются повторно. Очередь рассчитана на одного читателя:
+// Peek возвращает самые старые записи, Ack удаляет их из очереди.
+package spool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	segmentExt = ".seg"
+	cursorFile = "cursor"
+
+	// Заголовок записи: длина данных и CRC-32C данных
+	recordHeaderSize = 8
+)
+
+// Policy - что делать с новой записью, если очередь заполнена
+type Policy string
+
+const (
+	// DropNewest отбрасывает новую запись
+	DropNewest Policy = "drop_newest"
+	// DropOldest удаляет самый старый сегмент, чтобы освободить место
+	DropOldest Policy = "drop_oldest"
+)
+
+var (
+	ErrFull   = errors.New("spool is full")
+	ErrClosed = errors.New("spool is closed")
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Stats - состояние очереди
+type Stats struct {
+	Records   int   `json:"records"`   // записей в очереди
+	Bytes     int64 `json:"bytes"`     // размер сегментов на диске
+	Segments  int   `json:"segments"`  // число сегментов
+	Dropped   int64 `json:"dropped"`   // записей отброшено при заполнении очереди
+	Corrupted int64 `json:"corrupted"` // записей пропущено из-за неверной контрольной суммы
+}
+
+type segment struct {
+	id      uint64
+	size    int64
+	records int // непрочитанных записей
+}
+
+// position - позиция в сегменте
+type position struct {
+	segment uint64
+	offset  int64
+}
+
+type Spool struct {
+	mu           sync.Mutex
+	dir          string
+	maxBytes     int64
+	segmentBytes int64
+	policy       Policy
+
+	segments []*segment
+	file     *os.File // последний сегмент, в который дописываются записи
+	read     position // позиция первой неподтвержденной записи
+	peeked   []position
+	bytes    int64
+	closed   bool
+
+	dropped   int64
+	corrupted int64
+
+	notify chan struct{}
+}
+
+// Open открывает очередь в каталоге dir. Неполная или поврежденная запись в конце сегмента
+// (например, после сбоя во время записи) отбрасывается вместе с остатком сегмента.
+func Open(dir string, maxBytes, segmentBytes int64, policy Policy) (*Spool, error) {
+	switch policy {
+	case DropNewest, DropOldest:
+	default:
+		return nil, fmt.Errorf("unknown spool full policy: %s", policy)
+	}
+	if segmentBytes <= 0 || segmentBytes > maxBytes {
+		segmentBytes = maxBytes
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create spool directory: %w", err)
+	}
+
+	s := &Spool{
+		dir:          dir,
+		maxBytes:     maxBytes,
+		segmentBytes: segmentBytes,
+		policy:       policy,
+		notify:       make(chan struct{}, 1),
+	}
+
+	ids, err := s.listSegments()
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := s.loadCursor()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		if id < cursor.segment {
+			// Сегмент прочитан полностью, но не удален до остановки
+			if err := os.Remove(s.segmentPath(id)); err != nil {
+				return nil, fmt.Errorf("failed to remove spool segment: %w", err)
+			}
+			continue
+		}
+
+		from := int64(0)
+		if id == cursor.segment {
+			from = cursor.offset
+		}
+		seg, err := s.recover(id, from)
+		if err != nil {
+			return nil, err
+		}
+		s.segments = append(s.segments, seg)
+		s.bytes += seg.size
+	}
+
+	if len(s.segments) == 0 {
+		s.segments = append(s.segments, &segment{id: cursor.segment + 1})
+	}
+	if first := s.segments[0]; first.id == cursor.segment {
+		s.read = cursor
+	} else {
+		s.read = position{segment: first.id}
+	}
+
+	last := s.segments[len(s.segments)-1]
+	s.file, err = os.OpenFile(s.segmentPath(last.id), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open spool segment: %w", err)
+	}
+
+	return s, nil
+}
+
+// Append дописывает запись в очередь и сбрасывает ее на диск.
+// Если очереди не хватает места, поведение задает политика: ErrFull или удаление старых записей.
+func (s *Spool) Append(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return ErrClosed
+	}
+
+	need := int64(recordHeaderSize + len(data))
+	if need > s.segmentBytes {
+		s.dropped++
+		return fmt.Errorf("record of %d bytes exceeds spool segment size", len(data))
+	}
+	for s.bytes+need > s.maxBytes {
+		last := s.segments[len(s.segments)-1]
+		if s.read.segment == last.id && s.read.offset >= last.size {
+			// Все записи прочитаны, но последний сегмент еще занимает место:
+			// запись продолжается в новом сегменте, а прочитанный удаляется
+			if _, err := s.roll(); err != nil {
+				return err
+			}
+			if err := s.removeConsumed(); err != nil {
+				return err
+			}
+			if err := s.saveCursor(); err != nil {
+				return err
+			}
+			continue
+		}
+		if s.policy != DropOldest {
+			s.dropped++
+			return ErrFull
+		}
+		if len(s.segments) < 2 {
+			// Единственный сегмент можно удалить, только начав новый
+			if _, err := s.roll(); err != nil {
+				return err
+			}
+		}
+		if err := s.dropOldest(); err != nil {
+			return err
+		}
+	}
+
+	last := s.segments[len(s.segments)-1]
+	if last.size > 0 && last.size+need > s.segmentBytes {
+		var err error
+		if last, err = s.roll(); err != nil {
+			return err
+		}
+	}
+
+	record := make([]byte, need)
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(data)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.Checksum(data, crcTable))
+	copy(record[recordHeaderSize:], data)
+
+	if _, err := s.file.Write(record); err != nil {
+		// Частично записанная запись отбрасывается при следующем открытии
+		return fmt.Errorf("failed to write spool record: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync spool segment: %w", err)
+	}
+
+	last.size += need
+	last.records++
+	s.bytes += need
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Peek возвращает до max самых старых записей, не удаляя их из очереди.
+// Повторный вызов без Ack возвращает те же записи.
+func (s *Spool) Peek(max int) ([][]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, ErrClosed
+	}
+
+	s.peeked = s.peeked[:0]
+	var records [][]byte
+	pos := s.read
+	for len(records) < max {
+		seg := s.segment(pos.segment)
+		if seg == nil {
+			break
+		}
+		if pos.offset >= seg.size {
+			next := s.segmentAfter(seg.id)
+			if next == nil {
+				break
+			}
+			pos = position{segment: next.id}
+			continue
+		}
+
+		batch, ends, err := s.readSegment(seg, pos.offset, max-len(records))
+		records = append(records, batch...)
+		s.peeked = append(s.peeked, ends...)
+		if err == nil {
+			if len(ends) > 0 {
+				pos = ends[len(ends)-1]
+			}
+			continue
+		}
+
+		if len(records) > 0 {
+			// Поврежденная запись будет пропущена при следующем чтении
+			break
+		}
+		log.Printf("Spool segment %d is corrupted at offset %d, skipping %d records: %v", seg.id, pos.offset, seg.records, err)
+		s.corrupted += int64(seg.records)
+		seg.records = 0
+		s.read = position{segment: seg.id, offset: seg.size}
+		if err := s.removeConsumed(); err != nil {
+			return nil, err
+		}
+		pos = s.read
+	}
+	return records, nil
+}
+
+// Ack удаляет из очереди записи, возвращенные последним Peek
+func (s *Spool) Ack() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return ErrClosed
+	}
+	if len(s.peeked) == 0 {
+		return nil
+	}
+
+	for _, end := range s.peeked {
+		seg := s.segment(end.segment)
+		if seg == nil || end.segment < s.read.segment || (end.segment == s.read.segment && end.offset <= s.read.offset) {
+			// Сегмент удален при заполнении очереди
+			continue
+		}
+		seg.records--
+		s.read = end
+	}
+	s.peeked = s.peeked[:0]
+
+	if err := s.removeConsumed(); err != nil {
+		return err
+	}
+	return s.saveCursor()
+}
+
+// Len возвращает число записей в очереди
+func (s *Spool) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.records()
+}
+
+func (s *Spool) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return Stats{
+		Records:   s.records(),
+		Bytes:     s.bytes,
+		Segments:  len(s.segments),
+		Dropped:   s.dropped,
+		Corrupted: s.corrupted,
+	}
+}
+
+// Notify возвращает канал, в который приходит сигнал после добавления записи
+func (s *Spool) Notify() <-chan struct{} {
+	return s.notify
+}
+
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	if err := s.saveCursor(); err != nil {
+		s.file.Close()
+		return err
+	}
+	return s.file.Close()
+}
+
+func (s *Spool) records() int {
+	var n int
+	for _, seg := range s.segments {
+		n += seg.records
+	}
+	return n
+}
+
+// readSegment читает до max записей сегмента начиная с offset и возвращает их
+// вместе с позициями после каждой записи
+func (s *Spool) readSegment(seg *segment, offset int64, max int) ([][]byte, []position, error) {
+	f, err := os.Open(s.segmentPath(seg.id))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open spool segment: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, nil, fmt.Errorf("failed to seek spool segment: %w", err)
+	}
+
+	r := bufio.NewReader(io.LimitReader(f, seg.size-offset))
+	var records [][]byte
+	var ends []position
+	for len(records) < max && offset < seg.size {
+		data, err := readRecord(r)
+		if err != nil {
+			return records, ends, err
+		}
+		offset += int64(recordHeaderSize + len(data))
+		records = append(records, data)
+		ends = append(ends, position{segment: seg.id, offset: offset})
+	}
+	return records, ends, nil
+}
+
+// recover считает записи сегмента начиная с from и обрезает его на первой неполной
+// или поврежденной записи
+func (s *Spool) recover(id uint64, from int64) (*segment, error) {
+	path := s.segmentPath(id)
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open spool segment: %w", err)
+	}
+	defer f.Close()
+
+	seg := &segment{id: id, size: from}
+	if _, err := f.Seek(from, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to seek spool segment: %w", err)
+	}
+	r := bufio.NewReader(f)
+	for {
+		data, err := readRecord(r)
+		if errors.Is(err, io.EOF) {
+			return seg, nil
+		}
+		if err != nil {
+			log.Printf("Truncating spool segment %d at offset %d: %v", id, seg.size, err)
+			if err := os.Truncate(path, seg.size); err != nil {
+				return nil, fmt.Errorf("failed to truncate spool segment: %w", err)
+			}
+			return seg, nil
+		}
+		seg.size += int64(recordHeaderSize + len(data))
+		seg.records++
+	}
+}
+
+// readRecord читает запись; io.EOF означает, что записей больше нет
+func readRecord(r io.Reader) ([]byte, error) {
+	var header [recordHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, errors.New("incomplete record header")
+		}
+		return nil, err
+	}
+
+	data := make([]byte, binary.BigEndian.Uint32(header[0:4]))
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, errors.New("incomplete record")
+	}
+	if crc32.Checksum(data, crcTable) != binary.BigEndian.Uint32(header[4:8]) {
+		return nil, errors.New("checksum mismatch")
+	}
+	return data, nil
+}
+
+// roll закрывает текущий сегмент и начинает новый
+func (s *Spool) roll() (*segment, error) {
+	last := s.segments[len(s.segments)-1]
+	if err := s.file.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close spool segment: %w", err)
+	}
+
+	next := &segment{id: last.id + 1}
+	file, err := os.OpenFile(s.segmentPath(next.id), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create spool segment: %w", err)
+	}
+	s.file = file
+	s.segments = append(s.segments, next)
+	return next, nil
+}
+
+// dropOldest удаляет самый старый сегмент вместе с непрочитанными записями
+func (s *Spool) dropOldest() error {
+	oldest := s.segments[0]
+	if err := os.Remove(s.segmentPath(oldest.id)); err != nil {
+		return fmt.Errorf("failed to remove spool segment: %w", err)
+	}
+	log.Printf("Spool is full, dropped segment %d with %d records", oldest.id, oldest.records)
+
+	s.dropped += int64(oldest.records)
+	s.bytes -= oldest.size
+	s.segments = s.segments[1:]
+	if s.read.segment <= oldest.id {
+		s.read = position{segment: s.segments[0].id}
+	}
+	return s.saveCursor()
+}
+
+// removeConsumed удаляет прочитанные сегменты, кроме последнего, в который идет запись
+func (s *Spool) removeConsumed() error {
+	for len(s.segments) > 1 {
+		first := s.segments[0]
+		if s.read.segment == first.id && s.read.offset < first.size {
+			return nil
+		}
+		if err := os.Remove(s.segmentPath(first.id)); err != nil {
+			return fmt.Errorf("failed to remove spool segment: %w", err)
+		}
+		s.bytes -= first.size
+		s.segments = s.segments[1:]
+		if s.read.segment == first.id {
+			s.read = position{segment: s.segments[0].id}
+		}
+	}
+	return nil
+}
+
+func (s *Spool) segment(id uint64) *segment {
+	for _, seg := range s.segments {
+		if seg.id == id {
+			return seg
+		}
+	}
+	return nil
+}
+
+func (s *Spool) segmentAfter(id uint64) *segment {
+	for _, seg := range s.segments {
+		if seg.id > id {
+			return seg
+		}
+	}
+	return nil
+}
+
+func (s *Spool) segmentPath(id uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", id, segmentExt))
+}
+
+func (s *Spool) listSegments() ([]uint64, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spool directory: %w", err)
+	}
+
+	var ids []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// loadCursor читает позицию чтения: "<сегмент> <смещение>"
+func (s *Spool) loadCursor() (position, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, cursorFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return position{}, nil
+	}
+	if err != nil {
+		return position{}, fmt.Errorf("failed to read spool cursor: %w", err)
+	}
+
+	var cursor position
+	if _, err := fmt.Sscanf(string(data), "%d %d", &cursor.segment, &cursor.offset); err != nil {
+		return position{}, fmt.Errorf("invalid spool cursor %q: %w", data, err)
+	}
+	return cursor, nil
+}
+
+// saveCursor заменяет файл cursor целиком, чтобы после сбоя он не оказался записанным наполовину
+func (s *Spool) saveCursor() error {
+	path := filepath.Join(s.dir, cursorFile)
+	tmp := path + ".tmp"
+	data := fmt.Sprintf("%d %d", s.read.segment, s.read.offset)
+	if err := os.WriteFile(tmp, []byte(data), 0644); err != nil {
+		return fmt.Errorf("failed to write spool cursor: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write spool cursor: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package spool
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+)
+
+// Запись из 8 байт данных занимает в сегменте 16 байт
+const recordSize = recordHeaderSize + 8
+
+func record(i int) []byte {
+	return []byte(fmt.Sprintf("record%02d", i))
+}
+
+func openSpool(t *testing.T, dir string, maxBytes, segmentBytes int64, policy Policy) *Spool {
+	t.Helper()
+	s, err := Open(dir, maxBytes, segmentBytes, policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func appendRecords(t *testing.T, s *Spool, from, to int) {
+	t.Helper()
+	for i := from; i < to; i++ {
+		if err := s.Append(record(i)); err != nil {
+			t.Fatalf("Append(%d): %v", i, err)
+		}
+	}
+}
+
+// expectRecords проверяет, что очередь начинается с записей с номерами want
+func expectRecords(t *testing.T, s *Spool, want ...int) {
+	t.Helper()
+	records, err := s.Peek(len(want) + 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, data := range records {
+		got = append(got, string(data))
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Peek() = %q, want %d records", got, len(want))
+	}
+	for i, n := range want {
+		if got[i] != string(record(n)) {
+			t.Fatalf("Peek() = %q, want %q at %d", got, record(n), i)
+		}
+	}
+}
+
+func TestOpenTruncatesTornRecord(t *testing.T) {
+	dir := t.TempDir()
+	s := openSpool(t, dir, 1024, 1024, DropNewest)
+	appendRecords(t, s, 0, 2)
+	s.Close()
+
+	// Сбой во время записи: в конце сегмента остался заголовок и часть данных
+	f, err := os.OpenFile(s.segmentPath(1), os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte{0, 0, 0, 8, 1, 2, 3, 4, 'r', 'e'}); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	s = openSpool(t, dir, 1024, 1024, DropNewest)
+	if info, err := os.Stat(s.segmentPath(1)); err != nil || info.Size() != 2*recordSize {
+		t.Fatalf("segment was not truncated: %v, %v", info, err)
+	}
+	appendRecords(t, s, 2, 3)
+	expectRecords(t, s, 0, 1, 2)
+}
+
+func TestPeekSkipsCorruptedSegment(t *testing.T) {
+	s := openSpool(t, t.TempDir(), 1024, 2*recordSize, DropNewest)
+	appendRecords(t, s, 0, 4)
+
+	// Данные первой записи первого сегмента повреждены после записи
+	f, err := os.OpenFile(s.segmentPath(1), os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.WriteAt([]byte("X"), recordHeaderSize); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	expectRecords(t, s, 2, 3)
+	if stats := s.Stats(); stats.Corrupted != 2 || stats.Records != 2 || stats.Segments != 1 {
+		t.Fatalf("Stats() = %+v, want 2 corrupted and 2 records in 1 segment", stats)
+	}
+}
+
+func TestCursorSurvivesReopen(t *testing.T) {
+	dir := t.TempDir()
+	s := openSpool(t, dir, 1024, 2*recordSize, DropNewest)
+	appendRecords(t, s, 0, 5)
+	expectRecords(t, s, 0, 1, 2, 3, 4)
+
+	if _, err := s.Peek(3); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Ack(); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	s = openSpool(t, dir, 1024, 2*recordSize, DropNewest)
+	if n := s.Len(); n != 2 {
+		t.Fatalf("Len() = %d after reopen, want 2", n)
+	}
+	expectRecords(t, s, 3, 4)
+}
+
+func TestDropNewestWhenFull(t *testing.T) {
+	s := openSpool(t, t.TempDir(), 3*recordSize, 2*recordSize, DropNewest)
+	appendRecords(t, s, 0, 3)
+
+	if err := s.Append(record(3)); !errors.Is(err, ErrFull) {
+		t.Fatalf("Append() = %v, want ErrFull", err)
+	}
+	if stats := s.Stats(); stats.Dropped != 1 {
+		t.Fatalf("Dropped = %d, want 1", stats.Dropped)
+	}
+	expectRecords(t, s, 0, 1, 2)
+}
+
+func TestDropOldestWhenFull(t *testing.T) {
+	s := openSpool(t, t.TempDir(), 4*recordSize, 2*recordSize, DropOldest)
+	appendRecords(t, s, 0, 5)
+
+	// Пятая запись не помещается, поэтому удален первый сегмент с записями 0 и 1
+	expectRecords(t, s, 2, 3, 4)
+	if stats := s.Stats(); stats.Dropped != 2 || stats.Records != 3 {
+		t.Fatalf("Stats() = %+v, want 2 dropped and 3 records", stats)
+	}
+}
+
+func TestAppendAfterSingleSegmentIsConsumed(t *testing.T) {
+	for _, policy := range []Policy{DropNewest, DropOldest} {
+		t.Run(string(policy), func(t *testing.T) {
+			// Сегмент не может быть больше очереди, поэтому она состоит из одного сегмента
+			s := openSpool(t, t.TempDir(), 2*recordSize, 4*recordSize, policy)
+			appendRecords(t, s, 0, 2)
+			if _, err := s.Peek(2); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.Ack(); err != nil {
+				t.Fatal(err)
+			}
+
+			appendRecords(t, s, 2, 4)
+			expectRecords(t, s, 2, 3)
+			if stats := s.Stats(); stats.Dropped != 0 || stats.Bytes != 2*recordSize {
+				t.Fatalf("Stats() = %+v, want nothing dropped and %d bytes", stats, 2*recordSize)
+			}
+		})
+	}
+}
+
+func TestDropOldestWithSingleSegment(t *testing.T) {
+	s := openSpool(t, t.TempDir(), 2*recordSize, 2*recordSize, DropOldest)
+	appendRecords(t, s, 0, 3)
+
+	expectRecords(t, s, 2)
+	if stats := s.Stats(); stats.Dropped != 2 {
+		t.Fatalf("Dropped = %d, want 2", stats.Dropped)
+	}
+}
@@ -0,0 +1,367 @@
+// Package watch раздает события задач из топика Kafka подписчикам WatchTasks.
+// Каждый экземпляр api_service читает все партиции топика напрямую, без группы
+// потребителей, поэтому событие получают подписчики на любом экземпляре.
+// Resume token - смещения партиций, по нему пропущенные события дочитываются из Kafka.
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/api_service/internal/producer"
+	kafkapb "github.com/bagdasarian/checklist-app/api_service/pkg/pb/kafka"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	// Сколько событий может ждать отправки одному подписчику; при переполнении подписка закрывается
+	subscriberBuffer = 256
+
+	// Пауза между попытками подключиться к Kafka
+	retryDelay = 5 * time.Second
+
+	// Максимальное время дочитывания пропущенных событий
+	catchUpTimeout = time.Minute
+)
+
+var (
+	// ErrNotReady - хаб еще не подключился к топику
+	ErrNotReady = errors.New("task updates are not available yet")
+	// ErrTokenExpired - события после позиции токена уже удалены из Kafka по retention
+	ErrTokenExpired = errors.New("resume token has expired")
+)
+
+// Event - событие задачи с позицией в топике
+type Event struct {
+	Partition int
+	Offset    int64
+	Token     string // resume token позиции сразу после события
+	Event     *kafkapb.TaskEvent
+}
+
+// Subscription - подписка на события одного пользователя
+type Subscription struct {
+	hub    *Hub
+	userID string
+	events chan Event
+	closed bool
+}
+
+// Events возвращает канал событий. Канал закрывается, если подписчик не успевает
+// забирать события; клиенту нужно переподключиться с последним resume token.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Close отменяет подписку
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.remove(s)
+}
+
+type Hub struct {
+	brokers []string
+	topic   string
+
+	mu          sync.Mutex
+	position    Position
+	subscribers map[string]map[*Subscription]struct{}
+}
+
+func NewHub(brokers []string, topic string) *Hub {
+	return &Hub{
+		brokers:     brokers,
+		topic:       topic,
+		subscribers: make(map[string]map[*Subscription]struct{}),
+	}
+}
+
+// Run подключается к топику и читает новые события до отмены контекста.
+// Партиции определяются при запуске, добавленные позже не читаются до перезапуска.
+func (h *Hub) Run(ctx context.Context) {
+	var offsets Position
+	for {
+		var err error
+		offsets, err = h.lastOffsets(ctx)
+		if err == nil {
+			break
+		}
+		log.Printf("Watch hub: failed to read topic %s, retrying in %v: %v", h.topic, retryDelay, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryDelay):
+		}
+	}
+
+	h.mu.Lock()
+	h.position = offsets.clone()
+	h.mu.Unlock()
+	log.Printf("Watch hub started for topic %s (%d partitions)", h.topic, len(offsets))
+
+	var wg sync.WaitGroup
+	for partition, offset := range offsets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h.consume(ctx, partition, offset)
+		}()
+	}
+	wg.Wait()
+}
+
+// Subscribe подписывает пользователя на новые события и возвращает текущую позицию хаба:
+// все события после нее попадут в подписку
+func (h *Hub) Subscribe(userID string) (*Subscription, Position, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.position == nil {
+		return nil, nil, ErrNotReady
+	}
+
+	sub := &Subscription{
+		hub:    h,
+		userID: userID,
+		events: make(chan Event, subscriberBuffer),
+	}
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[*Subscription]struct{})
+	}
+	h.subscribers[userID][sub] = struct{}{}
+	return sub, h.position.clone(), nil
+}
+
+// ReadRange читает события пользователя между позициями from и to из Kafka.
+// Партиции, которых нет в from, пропускаются.
+func (h *Hub) ReadRange(ctx context.Context, userID string, from, to Position, fn func(Event) error) error {
+	ctx, cancel := context.WithTimeout(ctx, catchUpTimeout)
+	defer cancel()
+
+	current := to.clone()
+	for partition, start := range from {
+		if start < to[partition] {
+			current[partition] = start
+		}
+	}
+
+	for _, partition := range slices.Sorted(maps.Keys(to)) {
+		end := to[partition]
+		if current[partition] >= end {
+			continue
+		}
+
+		first, err := h.firstOffset(ctx, partition)
+		if err != nil {
+			return err
+		}
+		if current[partition] < first {
+			return ErrTokenExpired
+		}
+
+		err = h.readPartition(ctx, partition, current[partition], end, func(msg kafka.Message) error {
+			current[partition] = msg.Offset + 1
+			event, ok := decodeEvent(msg)
+			if !ok || event.UserId != userID {
+				return nil
+			}
+			return fn(Event{
+				Partition: partition,
+				Offset:    msg.Offset,
+				Token:     current.Token(),
+				Event:     event,
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *Hub) readPartition(ctx context.Context, partition int, start, end int64, fn func(kafka.Message) error) error {
+	reader := h.newReader(partition)
+	defer reader.Close()
+
+	if err := reader.SetOffset(start); err != nil {
+		return fmt.Errorf("failed to set offset: %w", err)
+	}
+	for offset := start; offset < end; {
+		msg, err := reader.ReadMessage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to read partition %d: %w", partition, err)
+		}
+		if err := fn(msg); err != nil {
+			return err
+		}
+		offset = msg.Offset + 1
+	}
+	return nil
+}
+
+// consume читает партицию с указанного смещения и раздает события подписчикам
+func (h *Hub) consume(ctx context.Context, partition int, offset int64) {
+	reader := h.newReader(partition)
+	defer reader.Close()
+
+	if err := reader.SetOffset(offset); err != nil {
+		log.Printf("Watch hub: failed to set offset for partition %d: %v", partition, err)
+		return
+	}
+	for {
+		msg, err := reader.ReadMessage(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Printf("Watch hub: error reading partition %d: %v", partition, err)
+			time.Sleep(time.Second)
+			continue
+		}
+		h.dispatch(msg)
+	}
+}
+
+// dispatch сдвигает позицию хаба и отправляет событие подписчикам пользователя.
+// Все выполняется под одной блокировкой, поэтому порядок событий в подписке совпадает с порядком позиций.
+func (h *Hub) dispatch(msg kafka.Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.position[msg.Partition] = msg.Offset + 1
+
+	event, ok := decodeEvent(msg)
+	if !ok || len(h.subscribers[event.UserId]) == 0 {
+		return
+	}
+
+	e := Event{
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Token:     h.position.Token(),
+		Event:     event,
+	}
+	for sub := range h.subscribers[event.UserId] {
+		select {
+		case sub.events <- e:
+		default:
+			log.Printf("Watch hub: subscriber of user %s is too slow, closing subscription", event.UserId)
+			h.remove(sub)
+		}
+	}
+}
+
+// remove удаляет подписку и закрывает ее канал; вызывается под h.mu
+func (h *Hub) remove(sub *Subscription) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	close(sub.events)
+
+	delete(h.subscribers[sub.userID], sub)
+	if len(h.subscribers[sub.userID]) == 0 {
+		delete(h.subscribers, sub.userID)
+	}
+}
+
+func (h *Hub) newReader(partition int) *kafka.Reader {
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers:   h.brokers,
+		Topic:     h.topic,
+		Partition: partition,
+		MinBytes:  1,
+		MaxBytes:  10e6,
+		MaxWait:   time.Second,
+	})
+}
+
+// lastOffsets возвращает смещения, с которых начнутся новые сообщения в каждой партиции
+func (h *Hub) lastOffsets(ctx context.Context) (Position, error) {
+	partitions, err := h.partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	offsets := make(Position, len(partitions))
+	for _, partition := range partitions {
+		conn, err := h.dialLeader(ctx, partition)
+		if err != nil {
+			return nil, err
+		}
+		last, err := conn.ReadLastOffset()
+		conn.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read offset of partition %d: %w", partition, err)
+		}
+		offsets[partition] = last
+	}
+	return offsets, nil
+}
+
+func (h *Hub) firstOffset(ctx context.Context, partition int) (int64, error) {
+	conn, err := h.dialLeader(ctx, partition)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	first, err := conn.ReadFirstOffset()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read offset of partition %d: %w", partition, err)
+	}
+	return first, nil
+}
+
+func (h *Hub) partitions(ctx context.Context) ([]int, error) {
+	var lastErr error
+	for _, broker := range h.brokers {
+		conn, err := kafka.DialContext(ctx, "tcp", broker)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		partitions, err := conn.ReadPartitions(h.topic)
+		conn.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		ids := make([]int, len(partitions))
+		for i, partition := range partitions {
+			ids[i] = partition.ID
+		}
+		return ids, nil
+	}
+	return nil, fmt.Errorf("failed to read partitions: %w", lastErr)
+}
+
+func (h *Hub) dialLeader(ctx context.Context, partition int) (*kafka.Conn, error) {
+	var lastErr error
+	for _, broker := range h.brokers {
+		conn, err := kafka.DialLeader(ctx, "tcp", broker, h.topic, partition)
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+	}
+	return nil, fmt.Errorf("failed to connect to leader of partition %d: %w", partition, lastErr)
+}
+
+// decodeEvent разбирает сообщение, отправленное producer.SendEvent
+func decodeEvent(msg kafka.Message) (*kafkapb.TaskEvent, bool) {
+	event, err := producer.DecodeEvent(msg)
+	if err != nil {
+		log.Printf("Watch hub: failed to decode event at %d/%d: %v", msg.Partition, msg.Offset, err)
+		return nil, false
+	}
+	return event, true
+}
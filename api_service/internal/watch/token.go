@@ -0,0 +1,52 @@
+package watch
+
+import (
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Position - позиция чтения топика: для каждой партиции смещение следующего сообщения
+type Position map[int]int64
+
+// Token кодирует позицию в непрозрачный resume token
+func (p Position) Token() string {
+	parts := make([]string, 0, len(p))
+	for _, partition := range slices.Sorted(maps.Keys(p)) {
+		parts = append(parts, fmt.Sprintf("%d:%d", partition, p[partition]))
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(parts, ",")))
+}
+
+func (p Position) clone() Position {
+	return maps.Clone(p)
+}
+
+// ParseToken разбирает resume token, выданный Position.Token
+func ParseToken(token string) (Position, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(data) == 0 {
+		return nil, fmt.Errorf("invalid resume token")
+	}
+
+	position := make(Position)
+	for _, part := range strings.Split(string(data), ",") {
+		partitionStr, offsetStr, ok := strings.Cut(part, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid resume token")
+		}
+		partition, err := strconv.Atoi(partitionStr)
+		if err != nil || partition < 0 {
+			return nil, fmt.Errorf("invalid resume token")
+		}
+		offset, err := strconv.ParseInt(offsetStr, 10, 64)
+		if err != nil || offset < 0 {
+			return nil, fmt.Errorf("invalid resume token")
+		}
+		position[partition] = offset
+	}
+	return position, nil
+}
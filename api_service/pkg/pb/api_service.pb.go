@@ -8,6 +8,7 @@ package pb
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -23,6 +24,233 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Набор задач, возвращаемых GetTasks
+type TaskView int32
+
+const (
+	TaskView_TASK_VIEW_UNSPECIFIED TaskView = 0
+	TaskView_TASK_VIEW_ACTIVE      TaskView = 1 // невыполненные и неархивные
+	TaskView_TASK_VIEW_COMPLETED   TaskView = 2 // выполненные и неархивные
+	TaskView_TASK_VIEW_ARCHIVED    TaskView = 3 // архивные
+	TaskView_TASK_VIEW_ALL         TaskView = 4 // все задачи
+)
+
+// Enum value maps for TaskView.
+var (
+	TaskView_name = map[int32]string{
+		0: "TASK_VIEW_UNSPECIFIED",
+		1: "TASK_VIEW_ACTIVE",
+		2: "TASK_VIEW_COMPLETED",
+		3: "TASK_VIEW_ARCHIVED",
+		4: "TASK_VIEW_ALL",
+	}
+	TaskView_value = map[string]int32{
+		"TASK_VIEW_UNSPECIFIED": 0,
+		"TASK_VIEW_ACTIVE":      1,
+		"TASK_VIEW_COMPLETED":   2,
+		"TASK_VIEW_ARCHIVED":    3,
+		"TASK_VIEW_ALL":         4,
+	}
+)
+
+func (x TaskView) Enum() *TaskView {
+	p := new(TaskView)
+	*p = x
+	return p
+}
+
+func (x TaskView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskView) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_service_proto_enumTypes[0].Descriptor()
+}
+
+func (TaskView) Type() protoreflect.EnumType {
+	return &file_api_service_proto_enumTypes[0]
+}
+
+func (x TaskView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskView.Descriptor instead.
+func (TaskView) EnumDescriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Фильтр задач по сроку; границы дня и недели считаются в часовом поясе пользователя
+type DueFilter int32
+
+const (
+	DueFilter_DUE_FILTER_UNSPECIFIED DueFilter = 0
+	DueFilter_DUE_FILTER_TODAY       DueFilter = 1 // срок сегодня
+	DueFilter_DUE_FILTER_OVERDUE     DueFilter = 2 // срок раньше начала сегодняшнего дня
+	DueFilter_DUE_FILTER_THIS_WEEK   DueFilter = 3 // срок на этой неделе, неделя начинается с week_start
+	DueFilter_DUE_FILTER_NO_DUE_DATE DueFilter = 4 // без срока
+)
+
+// Enum value maps for DueFilter.
+var (
+	DueFilter_name = map[int32]string{
+		0: "DUE_FILTER_UNSPECIFIED",
+		1: "DUE_FILTER_TODAY",
+		2: "DUE_FILTER_OVERDUE",
+		3: "DUE_FILTER_THIS_WEEK",
+		4: "DUE_FILTER_NO_DUE_DATE",
+	}
+	DueFilter_value = map[string]int32{
+		"DUE_FILTER_UNSPECIFIED": 0,
+		"DUE_FILTER_TODAY":       1,
+		"DUE_FILTER_OVERDUE":     2,
+		"DUE_FILTER_THIS_WEEK":   3,
+		"DUE_FILTER_NO_DUE_DATE": 4,
+	}
+)
+
+func (x DueFilter) Enum() *DueFilter {
+	p := new(DueFilter)
+	*p = x
+	return p
+}
+
+func (x DueFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DueFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_service_proto_enumTypes[1].Descriptor()
+}
+
+func (DueFilter) Type() protoreflect.EnumType {
+	return &file_api_service_proto_enumTypes[1]
+}
+
+func (x DueFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DueFilter.Descriptor instead.
+func (DueFilter) EnumDescriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{1}
+}
+
+// Порядок задач в GetTasks
+type TaskSort int32
+
+const (
+	TaskSort_TASK_SORT_UNSPECIFIED  TaskSort = 0
+	TaskSort_TASK_SORT_CREATED_DESC TaskSort = 1 // сначала новые
+	TaskSort_TASK_SORT_CREATED_ASC  TaskSort = 2 // сначала старые
+	TaskSort_TASK_SORT_DUE_ASC      TaskSort = 3 // по сроку, задачи без срока в конце
+	TaskSort_TASK_SORT_TITLE_ASC    TaskSort = 4 // по названию
+)
+
+// Enum value maps for TaskSort.
+var (
+	TaskSort_name = map[int32]string{
+		0: "TASK_SORT_UNSPECIFIED",
+		1: "TASK_SORT_CREATED_DESC",
+		2: "TASK_SORT_CREATED_ASC",
+		3: "TASK_SORT_DUE_ASC",
+		4: "TASK_SORT_TITLE_ASC",
+	}
+	TaskSort_value = map[string]int32{
+		"TASK_SORT_UNSPECIFIED":  0,
+		"TASK_SORT_CREATED_DESC": 1,
+		"TASK_SORT_CREATED_ASC":  2,
+		"TASK_SORT_DUE_ASC":      3,
+		"TASK_SORT_TITLE_ASC":    4,
+	}
+)
+
+func (x TaskSort) Enum() *TaskSort {
+	p := new(TaskSort)
+	*p = x
+	return p
+}
+
+func (x TaskSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_service_proto_enumTypes[2].Descriptor()
+}
+
+func (TaskSort) Type() protoreflect.EnumType {
+	return &file_api_service_proto_enumTypes[2]
+}
+
+func (x TaskSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskSort.Descriptor instead.
+func (TaskSort) EnumDescriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{2}
+}
+
+// Тип изменения задачи в потоке WatchTasks
+type TaskChangeType int32
+
+const (
+	TaskChangeType_TASK_CHANGE_TYPE_UNSPECIFIED TaskChangeType = 0
+	TaskChangeType_TASK_CHANGE_TYPE_CREATED     TaskChangeType = 1
+	TaskChangeType_TASK_CHANGE_TYPE_UPDATED     TaskChangeType = 2 // изменение, откат к ревизии, архивирование
+	TaskChangeType_TASK_CHANGE_TYPE_COMPLETED   TaskChangeType = 3
+	TaskChangeType_TASK_CHANGE_TYPE_DELETED     TaskChangeType = 4 // вместе с задачей удаляются ее подзадачи
+	TaskChangeType_TASK_CHANGE_TYPE_SYNCED      TaskChangeType = 5 // пропущенные изменения отправлены, дальше идут новые
+)
+
+// Enum value maps for TaskChangeType.
+var (
+	TaskChangeType_name = map[int32]string{
+		0: "TASK_CHANGE_TYPE_UNSPECIFIED",
+		1: "TASK_CHANGE_TYPE_CREATED",
+		2: "TASK_CHANGE_TYPE_UPDATED",
+		3: "TASK_CHANGE_TYPE_COMPLETED",
+		4: "TASK_CHANGE_TYPE_DELETED",
+		5: "TASK_CHANGE_TYPE_SYNCED",
+	}
+	TaskChangeType_value = map[string]int32{
+		"TASK_CHANGE_TYPE_UNSPECIFIED": 0,
+		"TASK_CHANGE_TYPE_CREATED":     1,
+		"TASK_CHANGE_TYPE_UPDATED":     2,
+		"TASK_CHANGE_TYPE_COMPLETED":   3,
+		"TASK_CHANGE_TYPE_DELETED":     4,
+		"TASK_CHANGE_TYPE_SYNCED":      5,
+	}
+)
+
+func (x TaskChangeType) Enum() *TaskChangeType {
+	p := new(TaskChangeType)
+	*p = x
+	return p
+}
+
+func (x TaskChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_service_proto_enumTypes[3].Descriptor()
+}
+
+func (TaskChangeType) Type() protoreflect.EnumType {
+	return &file_api_service_proto_enumTypes[3]
+}
+
+func (x TaskChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskChangeType.Descriptor instead.
+func (TaskChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{3}
+}
+
 // Сообщения для аутентификации
 type RegisterUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -276,7 +504,11 @@ func (x *LoginUserResponse) GetToken() string {
 type CreateTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	ParentId      string                 `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // ID родительской задачи для подзадачи
+	ListId        string                 `protobuf:"bytes,6,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`       // user_id будет автоматически извлекаться из JWT токена
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -325,12 +557,46 @@ func (x *CreateTaskRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateTaskRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
 type GetTasksRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// user_id будет автоматически извлекаться из JWT токена
-	IncludeCompleted bool  `protobuf:"varint,1,opt,name=include_completed,json=includeCompleted,proto3" json:"include_completed,omitempty"`
-	Limit            int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset           int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeCompleted bool      `protobuf:"varint,1,opt,name=include_completed,json=includeCompleted,proto3" json:"include_completed,omitempty"`
+	Limit            int32     `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset           int32     `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	ListId           string    `protobuf:"bytes,4,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`            // фильтр по списку, пусто - все задачи
+	View             TaskView  `protobuf:"varint,5,opt,name=view,proto3,enum=checklist.api.TaskView" json:"view,omitempty"` // если не задан, используется include_completed без архивных задач
+	Due              DueFilter `protobuf:"varint,6,opt,name=due,proto3,enum=checklist.api.DueFilter" json:"due,omitempty"`  // фильтр по сроку, нельзя сочетать с due_from и due_to
+	DueFrom          string    `protobuf:"bytes,7,opt,name=due_from,json=dueFrom,proto3" json:"due_from,omitempty"`         // YYYY-MM-DD, срок не раньше этого дня в часовом поясе пользователя
+	DueTo            string    `protobuf:"bytes,8,opt,name=due_to,json=dueTo,proto3" json:"due_to,omitempty"`               // YYYY-MM-DD, срок не позже этого дня
+	Sort             TaskSort  `protobuf:"varint,9,opt,name=sort,proto3,enum=checklist.api.TaskSort" json:"sort,omitempty"` // если не задан, используется default_sort из настроек
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -386,72 +652,71 @@ func (x *GetTasksRequest) GetOffset() int32 {
 	return 0
 }
 
-type DeleteTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // user_id будет автоматически извлекаться из JWT токена
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetTasksRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
 }
 
-func (x *DeleteTaskRequest) Reset() {
-	*x = DeleteTaskRequest{}
-	mi := &file_api_service_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetTasksRequest) GetView() TaskView {
+	if x != nil {
+		return x.View
+	}
+	return TaskView_TASK_VIEW_UNSPECIFIED
 }
 
-func (x *DeleteTaskRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetTasksRequest) GetDue() DueFilter {
+	if x != nil {
+		return x.Due
+	}
+	return DueFilter_DUE_FILTER_UNSPECIFIED
 }
 
-func (*DeleteTaskRequest) ProtoMessage() {}
-
-func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_service_proto_msgTypes[6]
+func (x *GetTasksRequest) GetDueFrom() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DueFrom
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_api_service_proto_rawDescGZIP(), []int{6}
+func (x *GetTasksRequest) GetDueTo() string {
+	if x != nil {
+		return x.DueTo
+	}
+	return ""
 }
 
-func (x *DeleteTaskRequest) GetId() string {
+func (x *GetTasksRequest) GetSort() TaskSort {
 	if x != nil {
-		return x.Id
+		return x.Sort
 	}
-	return ""
+	return TaskSort_TASK_SORT_UNSPECIFIED
 }
 
-type CompleteTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+type WatchTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id будет автоматически извлекаться из JWT токена
+	ResumeToken   string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // resume_token последнего полученного изменения, пусто - только новые изменения
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteTaskRequest) Reset() {
-	*x = CompleteTaskRequest{}
-	mi := &file_api_service_proto_msgTypes[7]
+func (x *WatchTasksRequest) Reset() {
+	*x = WatchTasksRequest{}
+	mi := &file_api_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteTaskRequest) String() string {
+func (x *WatchTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteTaskRequest) ProtoMessage() {}
+func (*WatchTasksRequest) ProtoMessage() {}
 
-func (x *CompleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_service_proto_msgTypes[7]
+func (x *WatchTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,46 +727,44 @@ func (x *CompleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*CompleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_api_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use WatchTasksRequest.ProtoReflect.Descriptor instead.
+func (*WatchTasksRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CompleteTaskRequest) GetId() string {
+func (x *WatchTasksRequest) GetResumeToken() string {
 	if x != nil {
-		return x.Id
+		return x.ResumeToken
 	}
 	return ""
 }
 
-type CreateTaskResponse struct {
+type TaskChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Completed     bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Type          TaskChangeType         `protobuf:"varint,1,opt,name=type,proto3,enum=checklist.api.TaskChangeType" json:"type,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Task          *Task                  `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"` // текущее состояние задачи, пусто для удаленных
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // передается в WatchTasks при переподключении
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskResponse) Reset() {
-	*x = CreateTaskResponse{}
-	mi := &file_api_service_proto_msgTypes[8]
+func (x *TaskChange) Reset() {
+	*x = TaskChange{}
+	mi := &file_api_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskResponse) String() string {
+func (x *TaskChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskResponse) ProtoMessage() {}
+func (*TaskChange) ProtoMessage() {}
 
-func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_service_proto_msgTypes[8]
+func (x *TaskChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,83 +775,70 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_api_service_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *CreateTaskResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CreateTaskResponse) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use TaskChange.ProtoReflect.Descriptor instead.
+func (*TaskChange) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateTaskResponse) GetTitle() string {
+func (x *TaskChange) GetType() TaskChangeType {
 	if x != nil {
-		return x.Title
+		return x.Type
 	}
-	return ""
+	return TaskChangeType_TASK_CHANGE_TYPE_UNSPECIFIED
 }
 
-func (x *CreateTaskResponse) GetDescription() string {
+func (x *TaskChange) GetTaskId() string {
 	if x != nil {
-		return x.Description
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *CreateTaskResponse) GetCompleted() bool {
+func (x *TaskChange) GetTask() *Task {
 	if x != nil {
-		return x.Completed
+		return x.Task
 	}
-	return false
+	return nil
 }
 
-func (x *CreateTaskResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *TaskChange) GetOccurredAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.OccurredAt
 	}
 	return nil
 }
 
-func (x *CreateTaskResponse) GetCompletedAt() *timestamppb.Timestamp {
+func (x *TaskChange) GetResumeToken() string {
 	if x != nil {
-		return x.CompletedAt
+		return x.ResumeToken
 	}
-	return nil
+	return ""
 }
 
-type GetTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DeleteTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ожидаемая версия задачи; через HTTP можно передать заголовком If-Match
+	ExpectedVersion int64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetTasksResponse) Reset() {
-	*x = GetTasksResponse{}
-	mi := &file_api_service_proto_msgTypes[9]
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_api_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTasksResponse) String() string {
+func (x *DeleteTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTasksResponse) ProtoMessage() {}
+func (*DeleteTaskRequest) ProtoMessage() {}
 
-func (x *GetTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_service_proto_msgTypes[9]
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,48 +849,5275 @@ func (x *GetTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTasksResponse.ProtoReflect.Descriptor instead.
-func (*GetTasksResponse) Descriptor() ([]byte, []int) {
-	return file_api_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetTasksResponse) GetTasks() []*Task {
+func (x *DeleteTaskRequest) GetId() string {
 	if x != nil {
-		return x.Tasks
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTasksResponse) GetTotalCount() int32 {
+func (x *DeleteTaskRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.TotalCount
+		return x.ExpectedVersion
 	}
 	return 0
 }
 
-type DeleteTaskResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+type CompleteTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ожидаемая версия задачи; через HTTP можно передать заголовком If-Match
+	ExpectedVersion int64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompleteTaskRequest) Reset() {
+	*x = CompleteTaskRequest{}
+	mi := &file_api_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTaskRequest) ProtoMessage() {}
+
+func (x *CompleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CompleteTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateTaskRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Ожидаемая версия задачи; через HTTP можно передать заголовком If-Match
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	DueAt           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	ClearDueAt      bool                   `protobuf:"varint,6,opt,name=clear_due_at,json=clearDueAt,proto3" json:"clear_due_at,omitempty"`
+	Tags            *TaskTags              `protobuf:"bytes,7,opt,name=tags,proto3" json:"tags,omitempty"` // если задано, теги заменяются целиком
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_api_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetClearDueAt() bool {
+	if x != nil {
+		return x.ClearDueAt
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetTags() *TaskTags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TaskTags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTags) Reset() {
+	*x = TaskTags{}
+	mi := &file_api_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTags) ProtoMessage() {}
+
+func (x *TaskTags) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTags.ProtoReflect.Descriptor instead.
+func (*TaskTags) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TaskTags) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type CreateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Completed     bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Version       int64                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Tags          []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	ParentId      string                 `protobuf:"bytes,11,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	ListId        string                 `protobuf:"bytes,12,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskResponse) Reset() {
+	*x = CreateTaskResponse{}
+	mi := &file_api_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskResponse) ProtoMessage() {}
+
+func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateTaskResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateTaskResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateTaskResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTaskResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTaskResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *CreateTaskResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CreateTaskResponse) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *CreateTaskResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CreateTaskResponse) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *CreateTaskResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateTaskResponse) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateTaskResponse) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+type GetTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTasksResponse) Reset() {
+	*x = GetTasksResponse{}
+	mi := &file_api_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTasksResponse) ProtoMessage() {}
+
+func (x *GetTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetTasksResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetTasksResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type DeleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskResponse) Reset() {
+	*x = DeleteTaskResponse{}
+	mi := &file_api_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskResponse) ProtoMessage() {}
+
+func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteTaskResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteTaskResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CompleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Completed     bool                   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Version       int64                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTaskResponse) Reset() {
+	*x = CompleteTaskResponse{}
+	mi := &file_api_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTaskResponse) ProtoMessage() {}
+
+func (x *CompleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*CompleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CompleteTaskResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteTaskResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *CompleteTaskResponse) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *CompleteTaskResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskResponse) Reset() {
+	*x = UpdateTaskResponse{}
+	mi := &file_api_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskResponse) ProtoMessage() {}
+
+func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type Task struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Completed     bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Version       int64                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"` // увеличивается при каждом изменении задачи, возвращается в заголовке ETag
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Tags          []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	ParentId      string                 `protobuf:"bytes,11,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // пусто для задач верхнего уровня
+	ListId        string                 `protobuf:"bytes,12,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"` // пусто для неархивных задач
+	IcalUid       string                 `protobuf:"bytes,14,opt,name=ical_uid,json=icalUid,proto3" json:"ical_uid,omitempty"`          // UID задачи в календарной ленте и .ics файлах
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_api_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Task) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Task) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Task) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Task) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Task) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *Task) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Task) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Task) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *Task) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Task) GetIcalUid() string {
+	if x != nil {
+		return x.IcalUid
+	}
+	return ""
+}
+
+type ArchiveTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ожидаемая версия задачи; через HTTP можно передать заголовком If-Match
+	ExpectedVersion int64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ArchiveTaskRequest) Reset() {
+	*x = ArchiveTaskRequest{}
+	mi := &file_api_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTaskRequest) ProtoMessage() {}
+
+func (x *ArchiveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTaskRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ArchiveTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ArchiveTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ArchiveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTaskResponse) Reset() {
+	*x = ArchiveTaskResponse{}
+	mi := &file_api_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTaskResponse) ProtoMessage() {}
+
+func (x *ArchiveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTaskResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ArchiveTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type UnarchiveTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ожидаемая версия задачи; через HTTP можно передать заголовком If-Match
+	ExpectedVersion int64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnarchiveTaskRequest) Reset() {
+	*x = UnarchiveTaskRequest{}
+	mi := &file_api_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTaskRequest) ProtoMessage() {}
+
+func (x *UnarchiveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UnarchiveTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnarchiveTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UnarchiveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveTaskResponse) Reset() {
+	*x = UnarchiveTaskResponse{}
+	mi := &file_api_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTaskResponse) ProtoMessage() {}
+
+func (x *UnarchiveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UnarchiveTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type ExportTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // json (по умолчанию), csv или markdown
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTasksRequest) Reset() {
+	*x = ExportTasksRequest{}
+	mi := &file_api_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTasksRequest) ProtoMessage() {}
+
+func (x *ExportTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTasksRequest.ProtoReflect.Descriptor instead.
+func (*ExportTasksRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportTasksRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ImportTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportTasksRequest_Options
+	//	*ImportTasksRequest_Chunk
+	Payload       isImportTasksRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTasksRequest) Reset() {
+	*x = ImportTasksRequest{}
+	mi := &file_api_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTasksRequest) ProtoMessage() {}
+
+func (x *ImportTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTasksRequest.ProtoReflect.Descriptor instead.
+func (*ImportTasksRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportTasksRequest) GetPayload() isImportTasksRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportTasksRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportTasksRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportTasksRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportTasksRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportTasksRequest_Payload interface {
+	isImportTasksRequest_Payload()
+}
+
+type ImportTasksRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"` // первое сообщение потока
+}
+
+type ImportTasksRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"` // очередная часть файла
+}
+
+func (*ImportTasksRequest_Options) isImportTasksRequest_Payload() {}
+
+func (*ImportTasksRequest_Chunk) isImportTasksRequest_Payload() {}
+
+type ImportOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`                // csv, todotxt, json или ics
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // только проверить файл, ничего не создавая
+	ListId        string                 `protobuf:"bytes,3,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`  // список для задач, у которых он не указан в файле
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_api_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportOptions) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportOptions) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+// Ошибка проверки строки файла (для JSON - элемента массива)
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_api_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	TotalRows     int32                  `protobuf:"varint,2,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`             // число задач в файле, включая подзадачи
+	ImportedCount int32                  `protobuf:"varint,3,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"` // 0 при dry_run или при ошибках в файле
+	Errors        []*ImportRowError      `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	Tasks         []*Task                `protobuf:"bytes,5,rep,name=tasks,proto3" json:"tasks,omitempty"` // созданные задачи
+	CreatedLists  []*TaskList            `protobuf:"bytes,6,rep,name=created_lists,json=createdLists,proto3" json:"created_lists,omitempty"`
+	UpdatedCount  int32                  `protobuf:"varint,7,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"` // задачи из .ics, найденные по UID и обновленные
+	UpdatedTasks  []*Task                `protobuf:"bytes,8,rep,name=updated_tasks,json=updatedTasks,proto3" json:"updated_tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTasksResponse) Reset() {
+	*x = ImportTasksResponse{}
+	mi := &file_api_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTasksResponse) ProtoMessage() {}
+
+func (x *ImportTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTasksResponse.ProtoReflect.Descriptor instead.
+func (*ImportTasksResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportTasksResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportTasksResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportTasksResponse) GetImportedCount() int32 {
+	if x != nil {
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportTasksResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ImportTasksResponse) GetCreatedLists() []*TaskList {
+	if x != nil {
+		return x.CreatedLists
+	}
+	return nil
+}
+
+func (x *ImportTasksResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *ImportTasksResponse) GetUpdatedTasks() []*Task {
+	if x != nil {
+		return x.UpdatedTasks
+	}
+	return nil
+}
+
+// Сообщения для календарной ленты
+type GetCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // токен ленты, расширение .ics допускается
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedRequest) Reset() {
+	*x = GetCalendarFeedRequest{}
+	mi := &file_api_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetCalendarFeedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type CalendarToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	FeedPath      string                 `protobuf:"bytes,2,opt,name=feed_path,json=feedPath,proto3" json:"feed_path,omitempty"` // путь ленты для подписки в календаре
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarToken) Reset() {
+	*x = CalendarToken{}
+	mi := &file_api_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarToken) ProtoMessage() {}
+
+func (x *CalendarToken) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarToken.ProtoReflect.Descriptor instead.
+func (*CalendarToken) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CalendarToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CalendarToken) GetFeedPath() string {
+	if x != nil {
+		return x.FeedPath
+	}
+	return ""
+}
+
+func (x *CalendarToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetCalendarTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarTokenRequest) Reset() {
+	*x = GetCalendarTokenRequest{}
+	mi := &file_api_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarTokenRequest) ProtoMessage() {}
+
+func (x *GetCalendarTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{29}
+}
+
+type GetCalendarTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *CalendarToken         `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarTokenResponse) Reset() {
+	*x = GetCalendarTokenResponse{}
+	mi := &file_api_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarTokenResponse) ProtoMessage() {}
+
+func (x *GetCalendarTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarTokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetCalendarTokenResponse) GetToken() *CalendarToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type ResetCalendarTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetCalendarTokenRequest) Reset() {
+	*x = ResetCalendarTokenRequest{}
+	mi := &file_api_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetCalendarTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCalendarTokenRequest) ProtoMessage() {}
+
+func (x *ResetCalendarTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetCalendarTokenRequest.ProtoReflect.Descriptor instead.
+func (*ResetCalendarTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{31}
+}
+
+type ResetCalendarTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *CalendarToken         `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetCalendarTokenResponse) Reset() {
+	*x = ResetCalendarTokenResponse{}
+	mi := &file_api_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetCalendarTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCalendarTokenResponse) ProtoMessage() {}
+
+func (x *ResetCalendarTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetCalendarTokenResponse.ProtoReflect.Descriptor instead.
+func (*ResetCalendarTokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResetCalendarTokenResponse) GetToken() *CalendarToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+// Сообщения для настроек пользователя
+type UserSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeZone      string                 `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`                  // IANA, например Europe/Moscow
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`                                      // BCP 47, например ru-RU
+	WeekStart     int32                  `protobuf:"varint,3,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`              // первый день недели: 0 - воскресенье, 1 - понедельник, ... 6 - суббота
+	DefaultListId string                 `protobuf:"bytes,4,opt,name=default_list_id,json=defaultListId,proto3" json:"default_list_id,omitempty"` // список для новых задач без list_id, пусто - без списка
+	DefaultSort   TaskSort               `protobuf:"varint,5,opt,name=default_sort,json=defaultSort,proto3,enum=checklist.api.TaskSort" json:"default_sort,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSettings) Reset() {
+	*x = UserSettings{}
+	mi := &file_api_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettings) ProtoMessage() {}
+
+func (x *UserSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettings.ProtoReflect.Descriptor instead.
+func (*UserSettings) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UserSettings) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *UserSettings) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *UserSettings) GetWeekStart() int32 {
+	if x != nil {
+		return x.WeekStart
+	}
+	return 0
+}
+
+func (x *UserSettings) GetDefaultListId() string {
+	if x != nil {
+		return x.DefaultListId
+	}
+	return ""
+}
+
+func (x *UserSettings) GetDefaultSort() TaskSort {
+	if x != nil {
+		return x.DefaultSort
+	}
+	return TaskSort_TASK_SORT_UNSPECIFIED
+}
+
+func (x *UserSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsRequest) Reset() {
+	*x = GetSettingsRequest{}
+	mi := &file_api_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsRequest) ProtoMessage() {}
+
+func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{34}
+}
+
+type GetSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *UserSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsResponse) Reset() {
+	*x = GetSettingsResponse{}
+	mi := &file_api_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsResponse) ProtoMessage() {}
+
+func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetSettingsResponse) GetSettings() *UserSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// Изменяются только переданные поля
+type UpdateSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeZone      *string                `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`
+	Locale        *string                `protobuf:"bytes,2,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	WeekStart     *int32                 `protobuf:"varint,3,opt,name=week_start,json=weekStart,proto3,oneof" json:"week_start,omitempty"`
+	DefaultListId *string                `protobuf:"bytes,4,opt,name=default_list_id,json=defaultListId,proto3,oneof" json:"default_list_id,omitempty"`                      // пустая строка убирает список по умолчанию
+	DefaultSort   *TaskSort              `protobuf:"varint,5,opt,name=default_sort,json=defaultSort,proto3,enum=checklist.api.TaskSort,oneof" json:"default_sort,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsRequest) Reset() {
+	*x = UpdateSettingsRequest{}
+	mi := &file_api_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateSettingsRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetWeekStart() int32 {
+	if x != nil && x.WeekStart != nil {
+		return *x.WeekStart
+	}
+	return 0
+}
+
+func (x *UpdateSettingsRequest) GetDefaultListId() string {
+	if x != nil && x.DefaultListId != nil {
+		return *x.DefaultListId
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetDefaultSort() TaskSort {
+	if x != nil && x.DefaultSort != nil {
+		return *x.DefaultSort
+	}
+	return TaskSort_TASK_SORT_UNSPECIFIED
+}
+
+type UpdateSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *UserSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsResponse) Reset() {
+	*x = UpdateSettingsResponse{}
+	mi := &file_api_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateSettingsResponse) GetSettings() *UserSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// Политика автоархивации: выполненные задачи переносятся в архив через archive_after_days дней
+type ArchivePolicy struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Enabled          bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ArchiveAfterDays int32                  `protobuf:"varint,2,opt,name=archive_after_days,json=archiveAfterDays,proto3" json:"archive_after_days,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ArchivePolicy) Reset() {
+	*x = ArchivePolicy{}
+	mi := &file_api_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePolicy) ProtoMessage() {}
+
+func (x *ArchivePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePolicy.ProtoReflect.Descriptor instead.
+func (*ArchivePolicy) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ArchivePolicy) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ArchivePolicy) GetArchiveAfterDays() int32 {
+	if x != nil {
+		return x.ArchiveAfterDays
+	}
+	return 0
+}
+
+func (x *ArchivePolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetArchivePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArchivePolicyRequest) Reset() {
+	*x = GetArchivePolicyRequest{}
+	mi := &file_api_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchivePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivePolicyRequest) ProtoMessage() {}
+
+func (x *GetArchivePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivePolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetArchivePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{39}
+}
+
+type GetArchivePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *ArchivePolicy         `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArchivePolicyResponse) Reset() {
+	*x = GetArchivePolicyResponse{}
+	mi := &file_api_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchivePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivePolicyResponse) ProtoMessage() {}
+
+func (x *GetArchivePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivePolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetArchivePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetArchivePolicyResponse) GetPolicy() *ArchivePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetArchivePolicyRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Enabled          bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ArchiveAfterDays int32                  `protobuf:"varint,2,opt,name=archive_after_days,json=archiveAfterDays,proto3" json:"archive_after_days,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetArchivePolicyRequest) Reset() {
+	*x = SetArchivePolicyRequest{}
+	mi := &file_api_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetArchivePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetArchivePolicyRequest) ProtoMessage() {}
+
+func (x *SetArchivePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetArchivePolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetArchivePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetArchivePolicyRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetArchivePolicyRequest) GetArchiveAfterDays() int32 {
+	if x != nil {
+		return x.ArchiveAfterDays
+	}
+	return 0
+}
+
+type SetArchivePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *ArchivePolicy         `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetArchivePolicyResponse) Reset() {
+	*x = SetArchivePolicyResponse{}
+	mi := &file_api_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetArchivePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetArchivePolicyResponse) ProtoMessage() {}
+
+func (x *SetArchivePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetArchivePolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetArchivePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetArchivePolicyResponse) GetPolicy() *ArchivePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// Сообщения для истории изменений задач
+type GetTaskHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskHistoryRequest) Reset() {
+	*x = GetTaskHistoryRequest{}
+	mi := &file_api_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskHistoryRequest) ProtoMessage() {}
+
+func (x *GetTaskHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetTaskHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTaskHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTaskHistoryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetTaskHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*TaskRevision        `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskHistoryResponse) Reset() {
+	*x = GetTaskHistoryResponse{}
+	mi := &file_api_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskHistoryResponse) ProtoMessage() {}
+
+func (x *GetTaskHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetTaskHistoryResponse) GetRevisions() []*TaskRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *GetTaskHistoryResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type RevertTaskRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"` // номер ревизии, к состоянию после которой откатывается задача
+	// Ожидаемая версия задачи; через HTTP можно передать заголовком If-Match
+	ExpectedVersion int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RevertTaskRequest) Reset() {
+	*x = RevertTaskRequest{}
+	mi := &file_api_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertTaskRequest) ProtoMessage() {}
+
+func (x *RevertTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertTaskRequest.ProtoReflect.Descriptor instead.
+func (*RevertTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RevertTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevertTaskRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RevertTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type RevertTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertTaskResponse) Reset() {
+	*x = RevertTaskResponse{}
+	mi := &file_api_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertTaskResponse) ProtoMessage() {}
+
+func (x *RevertTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertTaskResponse.ProtoReflect.Descriptor instead.
+func (*RevertTaskResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RevertTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type TaskRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`                  // created, completed, deleted, reverted
+	OldTask       *Task                  `protobuf:"bytes,5,opt,name=old_task,json=oldTask,proto3" json:"old_task,omitempty"` // пусто для создания
+	NewTask       *Task                  `protobuf:"bytes,6,opt,name=new_task,json=newTask,proto3" json:"new_task,omitempty"` // пусто для удаления
+	ActorId       string                 `protobuf:"bytes,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskRevision) Reset() {
+	*x = TaskRevision{}
+	mi := &file_api_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRevision) ProtoMessage() {}
+
+func (x *TaskRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRevision.ProtoReflect.Descriptor instead.
+func (*TaskRevision) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TaskRevision) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaskRevision) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *TaskRevision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TaskRevision) GetOldTask() *Task {
+	if x != nil {
+		return x.OldTask
+	}
+	return nil
+}
+
+func (x *TaskRevision) GetNewTask() *Task {
+	if x != nil {
+		return x.NewTask
+	}
+	return nil
+}
+
+func (x *TaskRevision) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *TaskRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Сообщения для списков задач
+type TaskList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskList) Reset() {
+	*x = TaskList{}
+	mi := &file_api_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskList) ProtoMessage() {}
+
+func (x *TaskList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskList.ProtoReflect.Descriptor instead.
+func (*TaskList) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TaskList) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaskList) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TaskList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskList) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateListRequest) Reset() {
+	*x = CreateListRequest{}
+	mi := &file_api_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListRequest) ProtoMessage() {}
+
+func (x *CreateListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListRequest.ProtoReflect.Descriptor instead.
+func (*CreateListRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *TaskList              `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateListResponse) Reset() {
+	*x = CreateListResponse{}
+	mi := &file_api_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListResponse) ProtoMessage() {}
+
+func (x *CreateListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListResponse.ProtoReflect.Descriptor instead.
+func (*CreateListResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateListResponse) GetList() *TaskList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type GetListsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListsRequest) Reset() {
+	*x = GetListsRequest{}
+	mi := &file_api_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListsRequest) ProtoMessage() {}
+
+func (x *GetListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListsRequest.ProtoReflect.Descriptor instead.
+func (*GetListsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{51}
+}
+
+type GetListsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lists         []*TaskList            `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListsResponse) Reset() {
+	*x = GetListsResponse{}
+	mi := &file_api_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListsResponse) ProtoMessage() {}
+
+func (x *GetListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListsResponse.ProtoReflect.Descriptor instead.
+func (*GetListsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetListsResponse) GetLists() []*TaskList {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+type DeleteListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteListRequest) Reset() {
+	*x = DeleteListRequest{}
+	mi := &file_api_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListRequest) ProtoMessage() {}
+
+func (x *DeleteListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListRequest.ProtoReflect.Descriptor instead.
+func (*DeleteListRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteListRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteListResponse) Reset() {
+	*x = DeleteListResponse{}
+	mi := &file_api_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListResponse) ProtoMessage() {}
+
+func (x *DeleteListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListResponse.ProtoReflect.Descriptor instead.
+func (*DeleteListResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteListResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Заготовка задачи в шаблоне
+type TaskBlueprint struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Смещение срока относительно момента создания задач из шаблона
+	DueOffsetSeconds *int64           `protobuf:"varint,3,opt,name=due_offset_seconds,json=dueOffsetSeconds,proto3,oneof" json:"due_offset_seconds,omitempty"`
+	Tags             []string         `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Subtasks         []*TaskBlueprint `protobuf:"bytes,5,rep,name=subtasks,proto3" json:"subtasks,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TaskBlueprint) Reset() {
+	*x = TaskBlueprint{}
+	mi := &file_api_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskBlueprint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskBlueprint) ProtoMessage() {}
+
+func (x *TaskBlueprint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskBlueprint.ProtoReflect.Descriptor instead.
+func (*TaskBlueprint) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TaskBlueprint) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TaskBlueprint) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskBlueprint) GetDueOffsetSeconds() int64 {
+	if x != nil && x.DueOffsetSeconds != nil {
+		return *x.DueOffsetSeconds
+	}
+	return 0
+}
+
+func (x *TaskBlueprint) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TaskBlueprint) GetSubtasks() []*TaskBlueprint {
+	if x != nil {
+		return x.Subtasks
+	}
+	return nil
+}
+
+type TaskTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Items         []*TaskBlueprint       `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTemplate) Reset() {
+	*x = TaskTemplate{}
+	mi := &file_api_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTemplate) ProtoMessage() {}
+
+func (x *TaskTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTemplate.ProtoReflect.Descriptor instead.
+func (*TaskTemplate) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TaskTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetItems() []*TaskBlueprint {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *TaskTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaskTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Items         []*TaskBlueprint       `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_api_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetItems() []*TaskBlueprint {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *TaskTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateResponse) Reset() {
+	*x = CreateTemplateResponse{}
+	mi := &file_api_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateTemplateResponse) GetTemplate() *TaskTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplatesRequest) Reset() {
+	*x = GetTemplatesRequest{}
+	mi := &file_api_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplatesRequest) ProtoMessage() {}
+
+func (x *GetTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{59}
+}
+
+type GetTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*TaskTemplate        `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplatesResponse) Reset() {
+	*x = GetTemplatesResponse{}
+	mi := &file_api_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplatesResponse) ProtoMessage() {}
+
+func (x *GetTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*GetTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetTemplatesResponse) GetTemplates() []*TaskTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type GetTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	mi := &file_api_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *TaskTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateResponse) Reset() {
+	*x = GetTemplateResponse{}
+	mi := &file_api_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateResponse) ProtoMessage() {}
+
+func (x *GetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetTemplateResponse) GetTemplate() *TaskTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Items         []*TaskBlueprint       `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateRequest) Reset() {
+	*x = UpdateTemplateRequest{}
+	mi := &file_api_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetItems() []*TaskBlueprint {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *TaskTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateResponse) Reset() {
+	*x = UpdateTemplateResponse{}
+	mi := &file_api_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateTemplateResponse) GetTemplate() *TaskTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_api_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeleteTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateResponse) Reset() {
+	*x = DeleteTemplateResponse{}
+	mi := &file_api_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type InstantiateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`                  // существующий список для задач
+	NewListName   string                 `protobuf:"bytes,3,opt,name=new_list_name,json=newListName,proto3" json:"new_list_name,omitempty"` // или имя нового списка
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`               // точка отсчета сроков, по умолчанию - сейчас
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateTemplateRequest) Reset() {
+	*x = InstantiateTemplateRequest{}
+	mi := &file_api_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateRequest) ProtoMessage() {}
+
+func (x *InstantiateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *InstantiateTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetNewListName() string {
+	if x != nil {
+		return x.NewListName
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+type InstantiateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	List          *TaskList              `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"` // пусто, если задачи созданы вне списка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateTemplateResponse) Reset() {
+	*x = InstantiateTemplateResponse{}
+	mi := &file_api_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateResponse) ProtoMessage() {}
+
+func (x *InstantiateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *InstantiateTemplateResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *InstantiateTemplateResponse) GetList() *TaskList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type SaveListAsTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	BaseTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=base_time,json=baseTime,proto3" json:"base_time,omitempty"` // точка отсчета смещений, по умолчанию - создание списка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveListAsTemplateRequest) Reset() {
+	*x = SaveListAsTemplateRequest{}
+	mi := &file_api_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveListAsTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveListAsTemplateRequest) ProtoMessage() {}
+
+func (x *SaveListAsTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveListAsTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SaveListAsTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SaveListAsTemplateRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *SaveListAsTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveListAsTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SaveListAsTemplateRequest) GetBaseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BaseTime
+	}
+	return nil
+}
+
+type SaveListAsTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *TaskTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveListAsTemplateResponse) Reset() {
+	*x = SaveListAsTemplateResponse{}
+	mi := &file_api_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveListAsTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveListAsTemplateResponse) ProtoMessage() {}
+
+func (x *SaveListAsTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveListAsTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SaveListAsTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SaveListAsTemplateResponse) GetTemplate() *TaskTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// Сообщения для напоминаний
+type Reminder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	RemindAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	OffsetSeconds *int64                 `protobuf:"varint,4,opt,name=offset_seconds,json=offsetSeconds,proto3,oneof" json:"offset_seconds,omitempty"`
+	FireAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=fire_at,json=fireAt,proto3" json:"fire_at,omitempty"` // пусто, если напоминание задано смещением, а у задачи нет срока
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`               // pending, sent, failed или cancelled
+	Attempts      int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reminder) Reset() {
+	*x = Reminder{}
+	mi := &file_api_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reminder) ProtoMessage() {}
+
+func (x *Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reminder.ProtoReflect.Descriptor instead.
+func (*Reminder) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Reminder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reminder) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Reminder) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetOffsetSeconds() int64 {
+	if x != nil && x.OffsetSeconds != nil {
+		return *x.OffsetSeconds
+	}
+	return 0
+}
+
+func (x *Reminder) GetFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FireAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Reminder) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Reminder) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Reminder) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	RemindAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`                       // указывается одно из полей
+	OffsetSeconds *int64                 `protobuf:"varint,3,opt,name=offset_seconds,json=offsetSeconds,proto3,oneof" json:"offset_seconds,omitempty"` // за сколько секунд до due_at напомнить
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReminderRequest) Reset() {
+	*x = CreateReminderRequest{}
+	mi := &file_api_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReminderRequest) ProtoMessage() {}
+
+func (x *CreateReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReminderRequest.ProtoReflect.Descriptor instead.
+func (*CreateReminderRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateReminderRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CreateReminderRequest) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *CreateReminderRequest) GetOffsetSeconds() int64 {
+	if x != nil && x.OffsetSeconds != nil {
+		return *x.OffsetSeconds
+	}
+	return 0
+}
+
+type CreateReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminder      *Reminder              `protobuf:"bytes,1,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReminderResponse) Reset() {
+	*x = CreateReminderResponse{}
+	mi := &file_api_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReminderResponse) ProtoMessage() {}
+
+func (x *CreateReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReminderResponse.ProtoReflect.Descriptor instead.
+func (*CreateReminderResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateReminderResponse) GetReminder() *Reminder {
+	if x != nil {
+		return x.Reminder
+	}
+	return nil
+}
+
+type GetRemindersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRemindersRequest) Reset() {
+	*x = GetRemindersRequest{}
+	mi := &file_api_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRemindersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRemindersRequest) ProtoMessage() {}
+
+func (x *GetRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRemindersRequest.ProtoReflect.Descriptor instead.
+func (*GetRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetRemindersRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type GetRemindersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminders     []*Reminder            `protobuf:"bytes,1,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRemindersResponse) Reset() {
+	*x = GetRemindersResponse{}
+	mi := &file_api_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRemindersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRemindersResponse) ProtoMessage() {}
+
+func (x *GetRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRemindersResponse.ProtoReflect.Descriptor instead.
+func (*GetRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetRemindersResponse) GetReminders() []*Reminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+type DeleteReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReminderRequest) Reset() {
+	*x = DeleteReminderRequest{}
+	mi := &file_api_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReminderRequest) ProtoMessage() {}
+
+func (x *DeleteReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReminderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReminderRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteReminderRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DeleteReminderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReminderResponse) Reset() {
+	*x = DeleteReminderResponse{}
+	mi := &file_api_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReminderResponse) ProtoMessage() {}
+
+func (x *DeleteReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReminderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReminderResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteReminderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteReminderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщения для дайджеста
+type DigestSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	DeliveryTime  string                 `protobuf:"bytes,2,opt,name=delivery_time,json=deliveryTime,proto3" json:"delivery_time,omitempty"` // HH:MM в часовом поясе time_zone
+	TimeZone      string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`             // часовой пояс из настроек пользователя (/v1/settings)
+	Format        string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`                                 // text, markdown или html
+	LastSentOn    string                 `protobuf:"bytes,5,opt,name=last_sent_on,json=lastSentOn,proto3" json:"last_sent_on,omitempty"`     // дата последней отправки, YYYY-MM-DD
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigestSettings) Reset() {
+	*x = DigestSettings{}
+	mi := &file_api_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestSettings) ProtoMessage() {}
+
+func (x *DigestSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestSettings.ProtoReflect.Descriptor instead.
+func (*DigestSettings) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DigestSettings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *DigestSettings) GetDeliveryTime() string {
+	if x != nil {
+		return x.DeliveryTime
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetLastSentOn() string {
+	if x != nil {
+		return x.LastSentOn
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetDigestSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestSettingsRequest) Reset() {
+	*x = GetDigestSettingsRequest{}
+	mi := &file_api_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestSettingsRequest) ProtoMessage() {}
+
+func (x *GetDigestSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetDigestSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{79}
+}
+
+type GetDigestSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *DigestSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestSettingsResponse) Reset() {
+	*x = GetDigestSettingsResponse{}
+	mi := &file_api_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestSettingsResponse) ProtoMessage() {}
+
+func (x *GetDigestSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetDigestSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetDigestSettingsResponse) GetSettings() *DigestSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SetDigestSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	DeliveryTime  string                 `protobuf:"bytes,2,opt,name=delivery_time,json=deliveryTime,proto3" json:"delivery_time,omitempty"`
+	Format        string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDigestSettingsRequest) Reset() {
+	*x = SetDigestSettingsRequest{}
+	mi := &file_api_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigestSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigestSettingsRequest) ProtoMessage() {}
+
+func (x *SetDigestSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigestSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetDigestSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SetDigestSettingsRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetDigestSettingsRequest) GetDeliveryTime() string {
+	if x != nil {
+		return x.DeliveryTime
+	}
+	return ""
+}
+
+func (x *SetDigestSettingsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type SetDigestSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *DigestSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDigestSettingsResponse) Reset() {
+	*x = SetDigestSettingsResponse{}
+	mi := &file_api_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigestSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigestSettingsResponse) ProtoMessage() {}
+
+func (x *SetDigestSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigestSettingsResponse.ProtoReflect.Descriptor instead.
+func (*SetDigestSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SetDigestSettingsResponse) GetSettings() *DigestSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GetDigestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // text, markdown или html; по умолчанию - формат из настроек
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`     // YYYY-MM-DD, по умолчанию - сегодня в часовом поясе пользователя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestRequest) Reset() {
+	*x = GetDigestRequest{}
+	mi := &file_api_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestRequest) ProtoMessage() {}
+
+func (x *GetDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestRequest.ProtoReflect.Descriptor instead.
+func (*GetDigestRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetDigestRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetDigestRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// Сообщения для статистики
+// Число выполненных задач за период, start - первый день периода (YYYY-MM-DD) в часовом поясе пользователя
+type StatsBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Completed     int32                  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsBucket) Reset() {
+	*x = StatsBucket{}
+	mi := &file_api_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsBucket) ProtoMessage() {}
+
+func (x *StatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsBucket.ProtoReflect.Descriptor instead.
+func (*StatsBucket) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *StatsBucket) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *StatsBucket) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+type TaskStats struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	OpenCount            int32                  `protobuf:"varint,1,opt,name=open_count,json=openCount,proto3" json:"open_count,omitempty"`                                     // невыполненные задачи вне архива
+	OverdueCount         int32                  `protobuf:"varint,2,opt,name=overdue_count,json=overdueCount,proto3" json:"overdue_count,omitempty"`                            // невыполненные задачи вне архива со сроком до начала сегодняшнего дня
+	CompletedCount       int32                  `protobuf:"varint,3,opt,name=completed_count,json=completedCount,proto3" json:"completed_count,omitempty"`                      // выполненные задачи, включая архивные
+	AvgCompletionSeconds float64                `protobuf:"fixed64,4,opt,name=avg_completion_seconds,json=avgCompletionSeconds,proto3" json:"avg_completion_seconds,omitempty"` // среднее время от создания до выполнения
+	CurrentStreakDays    int32                  `protobuf:"varint,5,opt,name=current_streak_days,json=currentStreakDays,proto3" json:"current_streak_days,omitempty"`           // дней подряд с выполненными задачами, заканчивая сегодня или вчера
+	LongestStreakDays    int32                  `protobuf:"varint,6,opt,name=longest_streak_days,json=longestStreakDays,proto3" json:"longest_streak_days,omitempty"`
+	Daily                []*StatsBucket         `protobuf:"bytes,7,rep,name=daily,proto3" json:"daily,omitempty"`     // последние 30 дней
+	Weekly               []*StatsBucket         `protobuf:"bytes,8,rep,name=weekly,proto3" json:"weekly,omitempty"`   // последние 12 недель
+	Monthly              []*StatsBucket         `protobuf:"bytes,9,rep,name=monthly,proto3" json:"monthly,omitempty"` // последние 12 месяцев
+	TimeZone             string                 `protobuf:"bytes,10,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	GeneratedAt          *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TaskStats) Reset() {
+	*x = TaskStats{}
+	mi := &file_api_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskStats) ProtoMessage() {}
+
+func (x *TaskStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskStats.ProtoReflect.Descriptor instead.
+func (*TaskStats) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *TaskStats) GetOpenCount() int32 {
+	if x != nil {
+		return x.OpenCount
+	}
+	return 0
+}
+
+func (x *TaskStats) GetOverdueCount() int32 {
+	if x != nil {
+		return x.OverdueCount
+	}
+	return 0
+}
+
+func (x *TaskStats) GetCompletedCount() int32 {
+	if x != nil {
+		return x.CompletedCount
+	}
+	return 0
+}
+
+func (x *TaskStats) GetAvgCompletionSeconds() float64 {
+	if x != nil {
+		return x.AvgCompletionSeconds
+	}
+	return 0
+}
+
+func (x *TaskStats) GetCurrentStreakDays() int32 {
+	if x != nil {
+		return x.CurrentStreakDays
+	}
+	return 0
+}
+
+func (x *TaskStats) GetLongestStreakDays() int32 {
+	if x != nil {
+		return x.LongestStreakDays
+	}
+	return 0
+}
+
+func (x *TaskStats) GetDaily() []*StatsBucket {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *TaskStats) GetWeekly() []*StatsBucket {
+	if x != nil {
+		return x.Weekly
+	}
+	return nil
+}
+
+func (x *TaskStats) GetMonthly() []*StatsBucket {
+	if x != nil {
+		return x.Monthly
+	}
+	return nil
+}
+
+func (x *TaskStats) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *TaskStats) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+type GetStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_api_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{86}
+}
+
+type GetStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         *TaskStats             `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	mi := &file_api_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetStatsResponse) GetStats() *TaskStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// Сообщения для вебхуков
+type Webhook struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Secret         string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`                           // возвращается только при создании
+	EventTypes     []string               `protobuf:"bytes,5,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // пусто - все события
+	Enabled        bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	FailureCount   int32                  `protobuf:"varint,7,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`      // неудачных доставок подряд
+	DisabledReason string                 `protobuf:"bytes,8,opt,name=disabled_reason,json=disabledReason,proto3" json:"disabled_reason,omitempty"` // причина автоматического отключения
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_api_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Webhook) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *Webhook) GetDisabledReason() string {
+	if x != nil {
+		return x.DisabledReason
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId      string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload        string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"` // JSON тела запроса
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`   // pending, delivered или failed
+	Attempts       int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastStatusCode int32                  `protobuf:"varint,8,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError      string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	RedeliveryOf   string                 `protobuf:"bytes,11,opt,name=redelivery_of,json=redeliveryOf,proto3" json:"redelivery_of,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_api_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetRedeliveryOf() string {
+	if x != nil {
+		return x.RedeliveryOf
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Url    string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Secret string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // если не указан, генерируется
+	// task.created, task.updated, task.completed, task.deleted, task.archived,
+	// task.unarchived, task.reverted, task.reminder, digest.daily; пусто - все события
+	EventTypes    []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_api_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_api_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type GetWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhooksRequest) Reset() {
+	*x = GetWebhooksRequest{}
+	mi := &file_api_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhooksRequest) ProtoMessage() {}
+
+func (x *GetWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{92}
+}
+
+type GetWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhooksResponse) Reset() {
+	*x = GetWebhooksResponse{}
+	mi := &file_api_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhooksResponse) ProtoMessage() {}
+
+func (x *GetWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type GetWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookRequest) Reset() {
+	*x = GetWebhookRequest{}
+	mi := &file_api_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookRequest) ProtoMessage() {}
+
+func (x *GetWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookResponse) Reset() {
+	*x = GetWebhookResponse{}
+	mi := &file_api_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookResponse) ProtoMessage() {}
+
+func (x *GetWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type UpdateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"` // пусто - секрет не меняется
+	EventTypes    []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Enabled       *bool                  `protobuf:"varint,5,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"` // не указано - не меняется; включение сбрасывает счетчик неудач
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_api_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdateWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type UpdateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	mi := &file_api_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UpdateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_api_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DeleteWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskResponse) Reset() {
-	*x = DeleteTaskResponse{}
-	mi := &file_api_service_proto_msgTypes[10]
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_api_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskResponse) String() string {
+func (x *DeleteWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskResponse) ProtoMessage() {}
+func (*DeleteWebhookResponse) ProtoMessage() {}
 
-func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_service_proto_msgTypes[10]
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -651,49 +6128,49 @@ func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_api_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *DeleteTaskResponse) GetSuccess() bool {
+func (x *DeleteWebhookResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *DeleteTaskResponse) GetMessage() string {
+func (x *DeleteWebhookResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type CompleteTaskResponse struct {
+type GetWebhookDeliveriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Completed     bool                   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"` // user_id будет автоматически извлекаться из JWT токена
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteTaskResponse) Reset() {
-	*x = CompleteTaskResponse{}
-	mi := &file_api_service_proto_msgTypes[11]
+func (x *GetWebhookDeliveriesRequest) Reset() {
+	*x = GetWebhookDeliveriesRequest{}
+	mi := &file_api_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteTaskResponse) String() string {
+func (x *GetWebhookDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteTaskResponse) ProtoMessage() {}
+func (*GetWebhookDeliveriesRequest) ProtoMessage() {}
 
-func (x *CompleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_service_proto_msgTypes[11]
+func (x *GetWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -704,60 +6181,54 @@ func (x *CompleteTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*CompleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_api_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *CompleteTaskResponse) GetId() string {
+func (x *GetWebhookDeliveriesRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CompleteTaskResponse) GetCompleted() bool {
+func (x *GetWebhookDeliveriesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Completed
+		return x.Limit
 	}
-	return false
+	return 0
 }
 
-func (x *CompleteTaskResponse) GetCompletedAt() *timestamppb.Timestamp {
+func (x *GetWebhookDeliveriesRequest) GetOffset() int32 {
 	if x != nil {
-		return x.CompletedAt
+		return x.Offset
 	}
-	return nil
+	return 0
 }
 
-type Task struct {
+type GetWebhookDeliveriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Completed     bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
-	mi := &file_api_service_proto_msgTypes[12]
+func (x *GetWebhookDeliveriesResponse) Reset() {
+	*x = GetWebhookDeliveriesResponse{}
+	mi := &file_api_service_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *GetWebhookDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*GetWebhookDeliveriesResponse) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_api_service_proto_msgTypes[12]
+func (x *GetWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,56 +6239,110 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
-	return file_api_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *Task) GetId() string {
+func (x *GetWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
 	if x != nil {
-		return x.Id
+		return x.Deliveries
 	}
-	return ""
+	return nil
 }
 
-func (x *Task) GetUserId() string {
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeliveryId    string                 `protobuf:"bytes,2,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"` // user_id будет автоматически извлекаться из JWT токена
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_api_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[102]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetTitle() string {
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *RedeliverWebhookRequest) GetId() string {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Task) GetDescription() string {
+func (x *RedeliverWebhookRequest) GetDeliveryId() string {
 	if x != nil {
-		return x.Description
+		return x.DeliveryId
 	}
 	return ""
 }
 
-func (x *Task) GetCompleted() bool {
-	if x != nil {
-		return x.Completed
-	}
-	return false
+type RedeliverWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"` // новая доставка в очереди
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RedeliverWebhookResponse) Reset() {
+	*x = RedeliverWebhookResponse{}
+	mi := &file_api_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[103]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+// Deprecated: Use RedeliverWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *RedeliverWebhookResponse) GetDelivery() *WebhookDelivery {
 	if x != nil {
-		return x.CompletedAt
+		return x.Delivery
 	}
 	return nil
 }
@@ -826,7 +6351,7 @@ var File_api_service_proto protoreflect.FileDescriptor
 
 const file_api_service_proto_rawDesc = "" +
 	"\n" +
-	"\x11api_service.proto\x12\rchecklist.api\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"a\n" +
+	"\x11api_service.proto\x12\rchecklist.api\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"a\n" +
 	"\x13RegisterUserRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
@@ -844,18 +6369,53 @@ const file_api_service_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12\x14\n" +
-	"\x05token\x18\x04 \x01(\tR\x05token\"K\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\"\xc8\x01\n" +
 	"\x11CreateTaskRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"l\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x121\n" +
+	"\x06due_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x1b\n" +
+	"\tparent_id\x18\x05 \x01(\tR\bparentId\x12\x17\n" +
+	"\alist_id\x18\x06 \x01(\tR\x06listId\"\xbd\x02\n" +
 	"\x0fGetTasksRequest\x12+\n" +
 	"\x11include_completed\x18\x01 \x01(\bR\x10includeCompleted\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"#\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x17\n" +
+	"\alist_id\x18\x04 \x01(\tR\x06listId\x12+\n" +
+	"\x04view\x18\x05 \x01(\x0e2\x17.checklist.api.TaskViewR\x04view\x12*\n" +
+	"\x03due\x18\x06 \x01(\x0e2\x18.checklist.api.DueFilterR\x03due\x12\x19\n" +
+	"\bdue_from\x18\a \x01(\tR\adueFrom\x12\x15\n" +
+	"\x06due_to\x18\b \x01(\tR\x05dueTo\x12+\n" +
+	"\x04sort\x18\t \x01(\x0e2\x17.checklist.api.TaskSortR\x04sort\"6\n" +
+	"\x11WatchTasksRequest\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\"\xe1\x01\n" +
+	"\n" +
+	"TaskChange\x121\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.checklist.api.TaskChangeTypeR\x04type\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12'\n" +
+	"\x04task\x18\x03 \x01(\v2\x13.checklist.api.TaskR\x04task\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeToken\"N\n" +
 	"\x11DeleteTaskRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"%\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"P\n" +
 	"\x13CompleteTaskRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x8d\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"\xac\x02\n" +
+	"\x11UpdateTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\x121\n" +
+	"\x06due_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12 \n" +
+	"\fclear_due_at\x18\x06 \x01(\bR\n" +
+	"clearDueAt\x12+\n" +
+	"\x04tags\x18\a \x01(\v2\x17.checklist.api.TaskTagsR\x04tagsB\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_description\"\"\n" +
+	"\bTaskTags\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xa4\x03\n" +
 	"\x12CreateTaskResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
@@ -864,18 +6424,27 @@ const file_api_service_proto_rawDesc = "" +
 	"\tcompleted\x18\x05 \x01(\bR\tcompleted\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
-	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"^\n" +
+	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\x121\n" +
+	"\x06due_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12\x1b\n" +
+	"\tparent_id\x18\v \x01(\tR\bparentId\x12\x17\n" +
+	"\alist_id\x18\f \x01(\tR\x06listId\"^\n" +
 	"\x10GetTasksResponse\x12)\n" +
 	"\x05tasks\x18\x01 \x03(\v2\x13.checklist.api.TaskR\x05tasks\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\"H\n" +
 	"\x12DeleteTaskResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x83\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x9d\x01\n" +
 	"\x14CompleteTaskResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\tcompleted\x18\x02 \x01(\bR\tcompleted\x12=\n" +
-	"\fcompleted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xff\x01\n" +
+	"\fcompleted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\"=\n" +
+	"\x12UpdateTaskResponse\x12'\n" +
+	"\x04task\x18\x01 \x01(\v2\x13.checklist.api.TaskR\x04task\"\xee\x03\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
@@ -884,16 +6453,434 @@ const file_api_service_proto_rawDesc = "" +
 	"\tcompleted\x18\x05 \x01(\bR\tcompleted\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
-	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt2\x9d\x05\n" +
+	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\x121\n" +
+	"\x06due_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12\x1b\n" +
+	"\tparent_id\x18\v \x01(\tR\bparentId\x12\x17\n" +
+	"\alist_id\x18\f \x01(\tR\x06listId\x12;\n" +
+	"\varchived_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12\x19\n" +
+	"\bical_uid\x18\x0e \x01(\tR\aicalUid\"O\n" +
+	"\x12ArchiveTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\">\n" +
+	"\x13ArchiveTaskResponse\x12'\n" +
+	"\x04task\x18\x01 \x01(\v2\x13.checklist.api.TaskR\x04task\"Q\n" +
+	"\x14UnarchiveTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"@\n" +
+	"\x15UnarchiveTaskResponse\x12'\n" +
+	"\x04task\x18\x01 \x01(\v2\x13.checklist.api.TaskR\x04task\",\n" +
+	"\x12ExportTasksRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\"q\n" +
+	"\x12ImportTasksRequest\x128\n" +
+	"\aoptions\x18\x01 \x01(\v2\x1c.checklist.api.ImportOptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"Y\n" +
+	"\rImportOptions\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x17\n" +
+	"\alist_id\x18\x03 \x01(\tR\x06listId\"<\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xf3\x02\n" +
+	"\x13ImportTasksResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x02 \x01(\x05R\ttotalRows\x12%\n" +
+	"\x0eimported_count\x18\x03 \x01(\x05R\rimportedCount\x125\n" +
+	"\x06errors\x18\x04 \x03(\v2\x1d.checklist.api.ImportRowErrorR\x06errors\x12)\n" +
+	"\x05tasks\x18\x05 \x03(\v2\x13.checklist.api.TaskR\x05tasks\x12<\n" +
+	"\rcreated_lists\x18\x06 \x03(\v2\x17.checklist.api.TaskListR\fcreatedLists\x12#\n" +
+	"\rupdated_count\x18\a \x01(\x05R\fupdatedCount\x128\n" +
+	"\rupdated_tasks\x18\b \x03(\v2\x13.checklist.api.TaskR\fupdatedTasks\".\n" +
+	"\x16GetCalendarFeedRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"}\n" +
+	"\rCalendarToken\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
+	"\tfeed_path\x18\x02 \x01(\tR\bfeedPath\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x19\n" +
+	"\x17GetCalendarTokenRequest\"N\n" +
+	"\x18GetCalendarTokenResponse\x122\n" +
+	"\x05token\x18\x01 \x01(\v2\x1c.checklist.api.CalendarTokenR\x05token\"\x1b\n" +
+	"\x19ResetCalendarTokenRequest\"P\n" +
+	"\x1aResetCalendarTokenResponse\x122\n" +
+	"\x05token\x18\x01 \x01(\v2\x1c.checklist.api.CalendarTokenR\x05token\"\x81\x02\n" +
+	"\fUserSettings\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x03 \x01(\x05R\tweekStart\x12&\n" +
+	"\x0fdefault_list_id\x18\x04 \x01(\tR\rdefaultListId\x12:\n" +
+	"\fdefault_sort\x18\x05 \x01(\x0e2\x17.checklist.api.TaskSortR\vdefaultSort\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x14\n" +
+	"\x12GetSettingsRequest\"N\n" +
+	"\x13GetSettingsResponse\x127\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1b.checklist.api.UserSettingsR\bsettings\"\xb5\x02\n" +
+	"\x15UpdateSettingsRequest\x12 \n" +
+	"\ttime_zone\x18\x01 \x01(\tH\x00R\btimeZone\x88\x01\x01\x12\x1b\n" +
+	"\x06locale\x18\x02 \x01(\tH\x01R\x06locale\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"week_start\x18\x03 \x01(\x05H\x02R\tweekStart\x88\x01\x01\x12+\n" +
+	"\x0fdefault_list_id\x18\x04 \x01(\tH\x03R\rdefaultListId\x88\x01\x01\x12?\n" +
+	"\fdefault_sort\x18\x05 \x01(\x0e2\x17.checklist.api.TaskSortH\x04R\vdefaultSort\x88\x01\x01B\f\n" +
+	"\n" +
+	"_time_zoneB\t\n" +
+	"\a_localeB\r\n" +
+	"\v_week_startB\x12\n" +
+	"\x10_default_list_idB\x0f\n" +
+	"\r_default_sort\"Q\n" +
+	"\x16UpdateSettingsResponse\x127\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1b.checklist.api.UserSettingsR\bsettings\"\x92\x01\n" +
+	"\rArchivePolicy\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12,\n" +
+	"\x12archive_after_days\x18\x02 \x01(\x05R\x10archiveAfterDays\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x19\n" +
+	"\x17GetArchivePolicyRequest\"P\n" +
+	"\x18GetArchivePolicyResponse\x124\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1c.checklist.api.ArchivePolicyR\x06policy\"a\n" +
+	"\x17SetArchivePolicyRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12,\n" +
+	"\x12archive_after_days\x18\x02 \x01(\x05R\x10archiveAfterDays\"P\n" +
+	"\x18SetArchivePolicyResponse\x124\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1c.checklist.api.ArchivePolicyR\x06policy\"U\n" +
+	"\x15GetTaskHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"t\n" +
+	"\x16GetTaskHistoryResponse\x129\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1b.checklist.api.TaskRevisionR\trevisions\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"j\n" +
+	"\x11RevertTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"=\n" +
+	"\x12RevertTaskResponse\x12'\n" +
+	"\x04task\x18\x01 \x01(\v2\x13.checklist.api.TaskR\x04task\"\xa1\x02\n" +
+	"\fTaskRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x05R\brevision\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12.\n" +
+	"\bold_task\x18\x05 \x01(\v2\x13.checklist.api.TaskR\aoldTask\x12.\n" +
+	"\bnew_task\x18\x06 \x01(\v2\x13.checklist.api.TaskR\anewTask\x12\x19\n" +
+	"\bactor_id\x18\a \x01(\tR\aactorId\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x82\x01\n" +
+	"\bTaskList\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"'\n" +
+	"\x11CreateListRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"A\n" +
+	"\x12CreateListResponse\x12+\n" +
+	"\x04list\x18\x01 \x01(\v2\x17.checklist.api.TaskListR\x04list\"\x11\n" +
+	"\x0fGetListsRequest\"A\n" +
+	"\x10GetListsResponse\x12-\n" +
+	"\x05lists\x18\x01 \x03(\v2\x17.checklist.api.TaskListR\x05lists\"#\n" +
+	"\x11DeleteListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x12DeleteListResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xdf\x01\n" +
+	"\rTaskBlueprint\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x121\n" +
+	"\x12due_offset_seconds\x18\x03 \x01(\x03H\x00R\x10dueOffsetSeconds\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x128\n" +
+	"\bsubtasks\x18\x05 \x03(\v2\x1c.checklist.api.TaskBlueprintR\bsubtasksB\x15\n" +
+	"\x13_due_offset_seconds\"\x97\x02\n" +
+	"\fTaskTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x122\n" +
+	"\x05items\x18\x05 \x03(\v2\x1c.checklist.api.TaskBlueprintR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x81\x01\n" +
+	"\x15CreateTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x122\n" +
+	"\x05items\x18\x03 \x03(\v2\x1c.checklist.api.TaskBlueprintR\x05items\"Q\n" +
+	"\x16CreateTemplateResponse\x127\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1b.checklist.api.TaskTemplateR\btemplate\"\x15\n" +
+	"\x13GetTemplatesRequest\"Q\n" +
+	"\x14GetTemplatesResponse\x129\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1b.checklist.api.TaskTemplateR\ttemplates\"$\n" +
+	"\x12GetTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"N\n" +
+	"\x13GetTemplateResponse\x127\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1b.checklist.api.TaskTemplateR\btemplate\"\x91\x01\n" +
+	"\x15UpdateTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
+	"\x05items\x18\x04 \x03(\v2\x1c.checklist.api.TaskBlueprintR\x05items\"Q\n" +
+	"\x16UpdateTemplateResponse\x127\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1b.checklist.api.TaskTemplateR\btemplate\"'\n" +
+	"\x15DeleteTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
+	"\x16DeleteTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa0\x01\n" +
+	"\x1aInstantiateTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\tR\x06listId\x12\"\n" +
+	"\rnew_list_name\x18\x03 \x01(\tR\vnewListName\x125\n" +
+	"\bstart_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\"u\n" +
+	"\x1bInstantiateTemplateResponse\x12)\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x13.checklist.api.TaskR\x05tasks\x12+\n" +
+	"\x04list\x18\x02 \x01(\v2\x17.checklist.api.TaskListR\x04list\"\xa3\x01\n" +
+	"\x19SaveListAsTemplateRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\tR\x06listId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x127\n" +
+	"\tbase_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bbaseTime\"U\n" +
+	"\x1aSaveListAsTemplateResponse\x127\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1b.checklist.api.TaskTemplateR\btemplate\"\xa3\x03\n" +
+	"\bReminder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x127\n" +
+	"\tremind_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12*\n" +
+	"\x0eoffset_seconds\x18\x04 \x01(\x03H\x00R\roffsetSeconds\x88\x01\x01\x123\n" +
+	"\afire_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06fireAt\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x123\n" +
+	"\asent_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x11\n" +
+	"\x0f_offset_seconds\"\xa8\x01\n" +
+	"\x15CreateReminderRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x127\n" +
+	"\tremind_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12*\n" +
+	"\x0eoffset_seconds\x18\x03 \x01(\x03H\x00R\roffsetSeconds\x88\x01\x01B\x11\n" +
+	"\x0f_offset_seconds\"M\n" +
+	"\x16CreateReminderResponse\x123\n" +
+	"\breminder\x18\x01 \x01(\v2\x17.checklist.api.ReminderR\breminder\".\n" +
+	"\x13GetRemindersRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"M\n" +
+	"\x14GetRemindersResponse\x125\n" +
+	"\treminders\x18\x01 \x03(\v2\x17.checklist.api.ReminderR\treminders\"@\n" +
+	"\x15DeleteReminderRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"L\n" +
+	"\x16DeleteReminderResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xe1\x01\n" +
+	"\x0eDigestSettings\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
+	"\rdelivery_time\x18\x02 \x01(\tR\fdeliveryTime\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x12 \n" +
+	"\flast_sent_on\x18\x05 \x01(\tR\n" +
+	"lastSentOn\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x1a\n" +
+	"\x18GetDigestSettingsRequest\"V\n" +
+	"\x19GetDigestSettingsResponse\x129\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1d.checklist.api.DigestSettingsR\bsettings\"\x82\x01\n" +
+	"\x18SetDigestSettingsRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
+	"\rdelivery_time\x18\x02 \x01(\tR\fdeliveryTime\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06formatJ\x04\b\x03\x10\x04R\ttime_zone\"V\n" +
+	"\x19SetDigestSettingsResponse\x129\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1d.checklist.api.DigestSettingsR\bsettings\">\n" +
+	"\x10GetDigestRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"A\n" +
+	"\vStatsBucket\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\x05R\tcompleted\"\x86\x04\n" +
+	"\tTaskStats\x12\x1d\n" +
+	"\n" +
+	"open_count\x18\x01 \x01(\x05R\topenCount\x12#\n" +
+	"\roverdue_count\x18\x02 \x01(\x05R\foverdueCount\x12'\n" +
+	"\x0fcompleted_count\x18\x03 \x01(\x05R\x0ecompletedCount\x124\n" +
+	"\x16avg_completion_seconds\x18\x04 \x01(\x01R\x14avgCompletionSeconds\x12.\n" +
+	"\x13current_streak_days\x18\x05 \x01(\x05R\x11currentStreakDays\x12.\n" +
+	"\x13longest_streak_days\x18\x06 \x01(\x05R\x11longestStreakDays\x120\n" +
+	"\x05daily\x18\a \x03(\v2\x1a.checklist.api.StatsBucketR\x05daily\x122\n" +
+	"\x06weekly\x18\b \x03(\v2\x1a.checklist.api.StatsBucketR\x06weekly\x124\n" +
+	"\amonthly\x18\t \x03(\v2\x1a.checklist.api.StatsBucketR\amonthly\x12\x1b\n" +
+	"\ttime_zone\x18\n" +
+	" \x01(\tR\btimeZone\x12=\n" +
+	"\fgenerated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"\x11\n" +
+	"\x0fGetStatsRequest\"B\n" +
+	"\x10GetStatsResponse\x12.\n" +
+	"\x05stats\x18\x01 \x01(\v2\x18.checklist.api.TaskStatsR\x05stats\"\xdb\x02\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x05 \x03(\tR\n" +
+	"eventTypes\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x12#\n" +
+	"\rfailure_count\x18\a \x01(\x05R\ffailureCount\x12'\n" +
+	"\x0fdisabled_reason\x18\b \x01(\tR\x0edisabledReason\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf0\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12(\n" +
+	"\x10last_status_code\x18\b \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12B\n" +
+	"\x0fnext_attempt_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12#\n" +
+	"\rredelivery_of\x18\v \x01(\tR\fredeliveryOf\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"a\n" +
+	"\x14CreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\"I\n" +
+	"\x15CreateWebhookResponse\x120\n" +
+	"\awebhook\x18\x01 \x01(\v2\x16.checklist.api.WebhookR\awebhook\"\x14\n" +
+	"\x12GetWebhooksRequest\"I\n" +
+	"\x13GetWebhooksResponse\x122\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x16.checklist.api.WebhookR\bwebhooks\"#\n" +
+	"\x11GetWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"F\n" +
+	"\x12GetWebhookResponse\x120\n" +
+	"\awebhook\x18\x01 \x01(\v2\x16.checklist.api.WebhookR\awebhook\"\x9c\x01\n" +
+	"\x14UpdateWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x1d\n" +
+	"\aenabled\x18\x05 \x01(\bH\x00R\aenabled\x88\x01\x01B\n" +
+	"\n" +
+	"\b_enabled\"I\n" +
+	"\x15UpdateWebhookResponse\x120\n" +
+	"\awebhook\x18\x01 \x01(\v2\x16.checklist.api.WebhookR\awebhook\"&\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"K\n" +
+	"\x15DeleteWebhookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"[\n" +
+	"\x1bGetWebhookDeliveriesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"^\n" +
+	"\x1cGetWebhookDeliveriesResponse\x12>\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1e.checklist.api.WebhookDeliveryR\n" +
+	"deliveries\"J\n" +
+	"\x17RedeliverWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vdelivery_id\x18\x02 \x01(\tR\n" +
+	"deliveryId\"V\n" +
+	"\x18RedeliverWebhookResponse\x12:\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x1e.checklist.api.WebhookDeliveryR\bdelivery*\x7f\n" +
+	"\bTaskView\x12\x19\n" +
+	"\x15TASK_VIEW_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TASK_VIEW_ACTIVE\x10\x01\x12\x17\n" +
+	"\x13TASK_VIEW_COMPLETED\x10\x02\x12\x16\n" +
+	"\x12TASK_VIEW_ARCHIVED\x10\x03\x12\x11\n" +
+	"\rTASK_VIEW_ALL\x10\x04*\x8b\x01\n" +
+	"\tDueFilter\x12\x1a\n" +
+	"\x16DUE_FILTER_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10DUE_FILTER_TODAY\x10\x01\x12\x16\n" +
+	"\x12DUE_FILTER_OVERDUE\x10\x02\x12\x18\n" +
+	"\x14DUE_FILTER_THIS_WEEK\x10\x03\x12\x1a\n" +
+	"\x16DUE_FILTER_NO_DUE_DATE\x10\x04*\x8c\x01\n" +
+	"\bTaskSort\x12\x19\n" +
+	"\x15TASK_SORT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16TASK_SORT_CREATED_DESC\x10\x01\x12\x19\n" +
+	"\x15TASK_SORT_CREATED_ASC\x10\x02\x12\x15\n" +
+	"\x11TASK_SORT_DUE_ASC\x10\x03\x12\x17\n" +
+	"\x13TASK_SORT_TITLE_ASC\x10\x04*\xc9\x01\n" +
+	"\x0eTaskChangeType\x12 \n" +
+	"\x1cTASK_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18TASK_CHANGE_TYPE_CREATED\x10\x01\x12\x1c\n" +
+	"\x18TASK_CHANGE_TYPE_UPDATED\x10\x02\x12\x1e\n" +
+	"\x1aTASK_CHANGE_TYPE_COMPLETED\x10\x03\x12\x1c\n" +
+	"\x18TASK_CHANGE_TYPE_DELETED\x10\x04\x12\x1b\n" +
+	"\x17TASK_CHANGE_TYPE_SYNCED\x10\x052\xa0*\n" +
 	"\vTaskService\x12u\n" +
 	"\fRegisterUser\x12\".checklist.api.RegisterUserRequest\x1a#.checklist.api.RegisterUserResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12i\n" +
 	"\tLoginUser\x12\x1f.checklist.api.LoginUserRequest\x1a .checklist.api.LoginUserResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12g\n" +
 	"\n" +
 	"CreateTask\x12 .checklist.api.CreateTaskRequest\x1a!.checklist.api.CreateTaskResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/tasks\x12^\n" +
-	"\bGetTasks\x12\x1e.checklist.api.GetTasksRequest\x1a\x1f.checklist.api.GetTasksResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/tasks\x12i\n" +
+	"\bGetTasks\x12\x1e.checklist.api.GetTasksRequest\x1a\x1f.checklist.api.GetTasksResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/tasks\x12d\n" +
+	"\n" +
+	"WatchTasks\x12 .checklist.api.WatchTasksRequest\x1a\x19.checklist.api.TaskChange\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/tasks/watch0\x01\x12i\n" +
 	"\n" +
 	"DeleteTask\x12 .checklist.api.DeleteTaskRequest\x1a!.checklist.api.DeleteTaskResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/tasks/{id}\x12x\n" +
-	"\fCompleteTask\x12\".checklist.api.CompleteTaskRequest\x1a#.checklist.api.CompleteTaskResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x1a\x17/v1/tasks/{id}/completeB\x06Z\x04.;pbb\x06proto3"
+	"\fCompleteTask\x12\".checklist.api.CompleteTaskRequest\x1a#.checklist.api.CompleteTaskResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x1a\x17/v1/tasks/{id}/complete\x12l\n" +
+	"\n" +
+	"UpdateTask\x12 .checklist.api.UpdateTaskRequest\x1a!.checklist.api.UpdateTaskResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*2\x0e/v1/tasks/{id}\x12w\n" +
+	"\vArchiveTask\x12!.checklist.api.ArchiveTaskRequest\x1a\".checklist.api.ArchiveTaskResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/tasks/{id}/archive\x12\x7f\n" +
+	"\rUnarchiveTask\x12#.checklist.api.UnarchiveTaskRequest\x1a$.checklist.api.UnarchiveTaskResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/tasks/{id}/unarchive\x12\\\n" +
+	"\vExportTasks\x12!.checklist.api.ExportTasksRequest\x1a\x14.google.api.HttpBody\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/export0\x01\x12X\n" +
+	"\vImportTasks\x12!.checklist.api.ImportTasksRequest\x1a\".checklist.api.ImportTasksResponse\"\x00(\x01\x12l\n" +
+	"\x0fGetCalendarFeed\x12%.checklist.api.GetCalendarFeedRequest\x1a\x14.google.api.HttpBody\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/calendar/{token}\x12\x82\x01\n" +
+	"\x10GetCalendarToken\x12&.checklist.api.GetCalendarTokenRequest\x1a'.checklist.api.GetCalendarTokenResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/settings/calendar\x12\x91\x01\n" +
+	"\x12ResetCalendarToken\x12(.checklist.api.ResetCalendarTokenRequest\x1a).checklist.api.ResetCalendarTokenResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/settings/calendar/reset\x12j\n" +
+	"\vGetSettings\x12!.checklist.api.GetSettingsRequest\x1a\".checklist.api.GetSettingsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/settings\x12v\n" +
+	"\x0eUpdateSettings\x12$.checklist.api.UpdateSettingsRequest\x1a%.checklist.api.UpdateSettingsResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*2\f/v1/settings\x12\x81\x01\n" +
+	"\x10GetArchivePolicy\x12&.checklist.api.GetArchivePolicyRequest\x1a'.checklist.api.GetArchivePolicyResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/settings/archive\x12\x84\x01\n" +
+	"\x10SetArchivePolicy\x12&.checklist.api.SetArchivePolicyRequest\x1a'.checklist.api.SetArchivePolicyResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v1/settings/archive\x12}\n" +
+	"\x0eGetTaskHistory\x12$.checklist.api.GetTaskHistoryRequest\x1a%.checklist.api.GetTaskHistoryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/tasks/{id}/history\x12s\n" +
+	"\n" +
+	"RevertTask\x12 .checklist.api.RevertTaskRequest\x1a!.checklist.api.RevertTaskResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/tasks/{id}/revert\x12g\n" +
+	"\n" +
+	"CreateList\x12 .checklist.api.CreateListRequest\x1a!.checklist.api.CreateListResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/lists\x12^\n" +
+	"\bGetLists\x12\x1e.checklist.api.GetListsRequest\x1a\x1f.checklist.api.GetListsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/lists\x12i\n" +
+	"\n" +
+	"DeleteList\x12 .checklist.api.DeleteListRequest\x1a!.checklist.api.DeleteListResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/lists/{id}\x12\x92\x01\n" +
+	"\x12SaveListAsTemplate\x12(.checklist.api.SaveListAsTemplateRequest\x1a).checklist.api.SaveListAsTemplateResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/lists/{list_id}/template\x12w\n" +
+	"\x0eCreateTemplate\x12$.checklist.api.CreateTemplateRequest\x1a%.checklist.api.CreateTemplateResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/templates\x12n\n" +
+	"\fGetTemplates\x12\".checklist.api.GetTemplatesRequest\x1a#.checklist.api.GetTemplatesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/templates\x12p\n" +
+	"\vGetTemplate\x12!.checklist.api.GetTemplateRequest\x1a\".checklist.api.GetTemplateResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/templates/{id}\x12|\n" +
+	"\x0eUpdateTemplate\x12$.checklist.api.UpdateTemplateRequest\x1a%.checklist.api.UpdateTemplateResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/v1/templates/{id}\x12y\n" +
+	"\x0eDeleteTemplate\x12$.checklist.api.DeleteTemplateRequest\x1a%.checklist.api.DeleteTemplateResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/templates/{id}\x12\x97\x01\n" +
+	"\x13InstantiateTemplate\x12).checklist.api.InstantiateTemplateRequest\x1a*.checklist.api.InstantiateTemplateResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/templates/{id}/instantiate\x12\x87\x01\n" +
+	"\x0eCreateReminder\x12$.checklist.api.CreateReminderRequest\x1a%.checklist.api.CreateReminderResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/tasks/{task_id}/reminders\x12~\n" +
+	"\fGetReminders\x12\".checklist.api.GetRemindersRequest\x1a#.checklist.api.GetRemindersResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/tasks/{task_id}/reminders\x12\x89\x01\n" +
+	"\x0eDeleteReminder\x12$.checklist.api.DeleteReminderRequest\x1a%.checklist.api.DeleteReminderResponse\"*\x82\xd3\xe4\x93\x02$*\"/v1/tasks/{task_id}/reminders/{id}\x12\x83\x01\n" +
+	"\x11GetDigestSettings\x12'.checklist.api.GetDigestSettingsRequest\x1a(.checklist.api.GetDigestSettingsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/settings/digest\x12\x86\x01\n" +
+	"\x11SetDigestSettings\x12'.checklist.api.SetDigestSettingsRequest\x1a(.checklist.api.SetDigestSettingsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/settings/digest\x12V\n" +
+	"\tGetDigest\x12\x1f.checklist.api.GetDigestRequest\x1a\x14.google.api.HttpBody\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/digest\x12^\n" +
+	"\bGetStats\x12\x1e.checklist.api.GetStatsRequest\x1a\x1f.checklist.api.GetStatsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/stats\x12s\n" +
+	"\rCreateWebhook\x12#.checklist.api.CreateWebhookRequest\x1a$.checklist.api.CreateWebhookResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/webhooks\x12j\n" +
+	"\vGetWebhooks\x12!.checklist.api.GetWebhooksRequest\x1a\".checklist.api.GetWebhooksResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/webhooks\x12l\n" +
+	"\n" +
+	"GetWebhook\x12 .checklist.api.GetWebhookRequest\x1a!.checklist.api.GetWebhookResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/webhooks/{id}\x12x\n" +
+	"\rUpdateWebhook\x12#.checklist.api.UpdateWebhookRequest\x1a$.checklist.api.UpdateWebhookResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/v1/webhooks/{id}\x12u\n" +
+	"\rDeleteWebhook\x12#.checklist.api.DeleteWebhookRequest\x1a$.checklist.api.DeleteWebhookResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/webhooks/{id}\x12\x95\x01\n" +
+	"\x14GetWebhookDeliveries\x12*.checklist.api.GetWebhookDeliveriesRequest\x1a+.checklist.api.GetWebhookDeliveriesResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/webhooks/{id}/deliveries\x12\xa4\x01\n" +
+	"\x10RedeliverWebhook\x12&.checklist.api.RedeliverWebhookRequest\x1a'.checklist.api.RedeliverWebhookResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/v1/webhooks/{id}/deliveries/{delivery_id}/redeliverB\x06Z\x04.;pbb\x06proto3"
 
 var (
 	file_api_service_proto_rawDescOnce sync.Once
@@ -907,48 +6894,303 @@ func file_api_service_proto_rawDescGZIP() []byte {
 	return file_api_service_proto_rawDescData
 }
 
-var file_api_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_service_proto_msgTypes = make([]protoimpl.MessageInfo, 104)
 var file_api_service_proto_goTypes = []any{
-	(*RegisterUserRequest)(nil),   // 0: checklist.api.RegisterUserRequest
-	(*LoginUserRequest)(nil),      // 1: checklist.api.LoginUserRequest
-	(*RegisterUserResponse)(nil),  // 2: checklist.api.RegisterUserResponse
-	(*LoginUserResponse)(nil),     // 3: checklist.api.LoginUserResponse
-	(*CreateTaskRequest)(nil),     // 4: checklist.api.CreateTaskRequest
-	(*GetTasksRequest)(nil),       // 5: checklist.api.GetTasksRequest
-	(*DeleteTaskRequest)(nil),     // 6: checklist.api.DeleteTaskRequest
-	(*CompleteTaskRequest)(nil),   // 7: checklist.api.CompleteTaskRequest
-	(*CreateTaskResponse)(nil),    // 8: checklist.api.CreateTaskResponse
-	(*GetTasksResponse)(nil),      // 9: checklist.api.GetTasksResponse
-	(*DeleteTaskResponse)(nil),    // 10: checklist.api.DeleteTaskResponse
-	(*CompleteTaskResponse)(nil),  // 11: checklist.api.CompleteTaskResponse
-	(*Task)(nil),                  // 12: checklist.api.Task
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(TaskView)(0),                        // 0: checklist.api.TaskView
+	(DueFilter)(0),                       // 1: checklist.api.DueFilter
+	(TaskSort)(0),                        // 2: checklist.api.TaskSort
+	(TaskChangeType)(0),                  // 3: checklist.api.TaskChangeType
+	(*RegisterUserRequest)(nil),          // 4: checklist.api.RegisterUserRequest
+	(*LoginUserRequest)(nil),             // 5: checklist.api.LoginUserRequest
+	(*RegisterUserResponse)(nil),         // 6: checklist.api.RegisterUserResponse
+	(*LoginUserResponse)(nil),            // 7: checklist.api.LoginUserResponse
+	(*CreateTaskRequest)(nil),            // 8: checklist.api.CreateTaskRequest
+	(*GetTasksRequest)(nil),              // 9: checklist.api.GetTasksRequest
+	(*WatchTasksRequest)(nil),            // 10: checklist.api.WatchTasksRequest
+	(*TaskChange)(nil),                   // 11: checklist.api.TaskChange
+	(*DeleteTaskRequest)(nil),            // 12: checklist.api.DeleteTaskRequest
+	(*CompleteTaskRequest)(nil),          // 13: checklist.api.CompleteTaskRequest
+	(*UpdateTaskRequest)(nil),            // 14: checklist.api.UpdateTaskRequest
+	(*TaskTags)(nil),                     // 15: checklist.api.TaskTags
+	(*CreateTaskResponse)(nil),           // 16: checklist.api.CreateTaskResponse
+	(*GetTasksResponse)(nil),             // 17: checklist.api.GetTasksResponse
+	(*DeleteTaskResponse)(nil),           // 18: checklist.api.DeleteTaskResponse
+	(*CompleteTaskResponse)(nil),         // 19: checklist.api.CompleteTaskResponse
+	(*UpdateTaskResponse)(nil),           // 20: checklist.api.UpdateTaskResponse
+	(*Task)(nil),                         // 21: checklist.api.Task
+	(*ArchiveTaskRequest)(nil),           // 22: checklist.api.ArchiveTaskRequest
+	(*ArchiveTaskResponse)(nil),          // 23: checklist.api.ArchiveTaskResponse
+	(*UnarchiveTaskRequest)(nil),         // 24: checklist.api.UnarchiveTaskRequest
+	(*UnarchiveTaskResponse)(nil),        // 25: checklist.api.UnarchiveTaskResponse
+	(*ExportTasksRequest)(nil),           // 26: checklist.api.ExportTasksRequest
+	(*ImportTasksRequest)(nil),           // 27: checklist.api.ImportTasksRequest
+	(*ImportOptions)(nil),                // 28: checklist.api.ImportOptions
+	(*ImportRowError)(nil),               // 29: checklist.api.ImportRowError
+	(*ImportTasksResponse)(nil),          // 30: checklist.api.ImportTasksResponse
+	(*GetCalendarFeedRequest)(nil),       // 31: checklist.api.GetCalendarFeedRequest
+	(*CalendarToken)(nil),                // 32: checklist.api.CalendarToken
+	(*GetCalendarTokenRequest)(nil),      // 33: checklist.api.GetCalendarTokenRequest
+	(*GetCalendarTokenResponse)(nil),     // 34: checklist.api.GetCalendarTokenResponse
+	(*ResetCalendarTokenRequest)(nil),    // 35: checklist.api.ResetCalendarTokenRequest
+	(*ResetCalendarTokenResponse)(nil),   // 36: checklist.api.ResetCalendarTokenResponse
+	(*UserSettings)(nil),                 // 37: checklist.api.UserSettings
+	(*GetSettingsRequest)(nil),           // 38: checklist.api.GetSettingsRequest
+	(*GetSettingsResponse)(nil),          // 39: checklist.api.GetSettingsResponse
+	(*UpdateSettingsRequest)(nil),        // 40: checklist.api.UpdateSettingsRequest
+	(*UpdateSettingsResponse)(nil),       // 41: checklist.api.UpdateSettingsResponse
+	(*ArchivePolicy)(nil),                // 42: checklist.api.ArchivePolicy
+	(*GetArchivePolicyRequest)(nil),      // 43: checklist.api.GetArchivePolicyRequest
+	(*GetArchivePolicyResponse)(nil),     // 44: checklist.api.GetArchivePolicyResponse
+	(*SetArchivePolicyRequest)(nil),      // 45: checklist.api.SetArchivePolicyRequest
+	(*SetArchivePolicyResponse)(nil),     // 46: checklist.api.SetArchivePolicyResponse
+	(*GetTaskHistoryRequest)(nil),        // 47: checklist.api.GetTaskHistoryRequest
+	(*GetTaskHistoryResponse)(nil),       // 48: checklist.api.GetTaskHistoryResponse
+	(*RevertTaskRequest)(nil),            // 49: checklist.api.RevertTaskRequest
+	(*RevertTaskResponse)(nil),           // 50: checklist.api.RevertTaskResponse
+	(*TaskRevision)(nil),                 // 51: checklist.api.TaskRevision
+	(*TaskList)(nil),                     // 52: checklist.api.TaskList
+	(*CreateListRequest)(nil),            // 53: checklist.api.CreateListRequest
+	(*CreateListResponse)(nil),           // 54: checklist.api.CreateListResponse
+	(*GetListsRequest)(nil),              // 55: checklist.api.GetListsRequest
+	(*GetListsResponse)(nil),             // 56: checklist.api.GetListsResponse
+	(*DeleteListRequest)(nil),            // 57: checklist.api.DeleteListRequest
+	(*DeleteListResponse)(nil),           // 58: checklist.api.DeleteListResponse
+	(*TaskBlueprint)(nil),                // 59: checklist.api.TaskBlueprint
+	(*TaskTemplate)(nil),                 // 60: checklist.api.TaskTemplate
+	(*CreateTemplateRequest)(nil),        // 61: checklist.api.CreateTemplateRequest
+	(*CreateTemplateResponse)(nil),       // 62: checklist.api.CreateTemplateResponse
+	(*GetTemplatesRequest)(nil),          // 63: checklist.api.GetTemplatesRequest
+	(*GetTemplatesResponse)(nil),         // 64: checklist.api.GetTemplatesResponse
+	(*GetTemplateRequest)(nil),           // 65: checklist.api.GetTemplateRequest
+	(*GetTemplateResponse)(nil),          // 66: checklist.api.GetTemplateResponse
+	(*UpdateTemplateRequest)(nil),        // 67: checklist.api.UpdateTemplateRequest
+	(*UpdateTemplateResponse)(nil),       // 68: checklist.api.UpdateTemplateResponse
+	(*DeleteTemplateRequest)(nil),        // 69: checklist.api.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil),       // 70: checklist.api.DeleteTemplateResponse
+	(*InstantiateTemplateRequest)(nil),   // 71: checklist.api.InstantiateTemplateRequest
+	(*InstantiateTemplateResponse)(nil),  // 72: checklist.api.InstantiateTemplateResponse
+	(*SaveListAsTemplateRequest)(nil),    // 73: checklist.api.SaveListAsTemplateRequest
+	(*SaveListAsTemplateResponse)(nil),   // 74: checklist.api.SaveListAsTemplateResponse
+	(*Reminder)(nil),                     // 75: checklist.api.Reminder
+	(*CreateReminderRequest)(nil),        // 76: checklist.api.CreateReminderRequest
+	(*CreateReminderResponse)(nil),       // 77: checklist.api.CreateReminderResponse
+	(*GetRemindersRequest)(nil),          // 78: checklist.api.GetRemindersRequest
+	(*GetRemindersResponse)(nil),         // 79: checklist.api.GetRemindersResponse
+	(*DeleteReminderRequest)(nil),        // 80: checklist.api.DeleteReminderRequest
+	(*DeleteReminderResponse)(nil),       // 81: checklist.api.DeleteReminderResponse
+	(*DigestSettings)(nil),               // 82: checklist.api.DigestSettings
+	(*GetDigestSettingsRequest)(nil),     // 83: checklist.api.GetDigestSettingsRequest
+	(*GetDigestSettingsResponse)(nil),    // 84: checklist.api.GetDigestSettingsResponse
+	(*SetDigestSettingsRequest)(nil),     // 85: checklist.api.SetDigestSettingsRequest
+	(*SetDigestSettingsResponse)(nil),    // 86: checklist.api.SetDigestSettingsResponse
+	(*GetDigestRequest)(nil),             // 87: checklist.api.GetDigestRequest
+	(*StatsBucket)(nil),                  // 88: checklist.api.StatsBucket
+	(*TaskStats)(nil),                    // 89: checklist.api.TaskStats
+	(*GetStatsRequest)(nil),              // 90: checklist.api.GetStatsRequest
+	(*GetStatsResponse)(nil),             // 91: checklist.api.GetStatsResponse
+	(*Webhook)(nil),                      // 92: checklist.api.Webhook
+	(*WebhookDelivery)(nil),              // 93: checklist.api.WebhookDelivery
+	(*CreateWebhookRequest)(nil),         // 94: checklist.api.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),        // 95: checklist.api.CreateWebhookResponse
+	(*GetWebhooksRequest)(nil),           // 96: checklist.api.GetWebhooksRequest
+	(*GetWebhooksResponse)(nil),          // 97: checklist.api.GetWebhooksResponse
+	(*GetWebhookRequest)(nil),            // 98: checklist.api.GetWebhookRequest
+	(*GetWebhookResponse)(nil),           // 99: checklist.api.GetWebhookResponse
+	(*UpdateWebhookRequest)(nil),         // 100: checklist.api.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),        // 101: checklist.api.UpdateWebhookResponse
+	(*DeleteWebhookRequest)(nil),         // 102: checklist.api.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),        // 103: checklist.api.DeleteWebhookResponse
+	(*GetWebhookDeliveriesRequest)(nil),  // 104: checklist.api.GetWebhookDeliveriesRequest
+	(*GetWebhookDeliveriesResponse)(nil), // 105: checklist.api.GetWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),      // 106: checklist.api.RedeliverWebhookRequest
+	(*RedeliverWebhookResponse)(nil),     // 107: checklist.api.RedeliverWebhookResponse
+	(*timestamppb.Timestamp)(nil),        // 108: google.protobuf.Timestamp
+	(*httpbody.HttpBody)(nil),            // 109: google.api.HttpBody
 }
 var file_api_service_proto_depIdxs = []int32{
-	13, // 0: checklist.api.RegisterUserResponse.created_at:type_name -> google.protobuf.Timestamp
-	13, // 1: checklist.api.CreateTaskResponse.created_at:type_name -> google.protobuf.Timestamp
-	13, // 2: checklist.api.CreateTaskResponse.completed_at:type_name -> google.protobuf.Timestamp
-	12, // 3: checklist.api.GetTasksResponse.tasks:type_name -> checklist.api.Task
-	13, // 4: checklist.api.CompleteTaskResponse.completed_at:type_name -> google.protobuf.Timestamp
-	13, // 5: checklist.api.Task.created_at:type_name -> google.protobuf.Timestamp
-	13, // 6: checklist.api.Task.completed_at:type_name -> google.protobuf.Timestamp
-	0,  // 7: checklist.api.TaskService.RegisterUser:input_type -> checklist.api.RegisterUserRequest
-	1,  // 8: checklist.api.TaskService.LoginUser:input_type -> checklist.api.LoginUserRequest
-	4,  // 9: checklist.api.TaskService.CreateTask:input_type -> checklist.api.CreateTaskRequest
-	5,  // 10: checklist.api.TaskService.GetTasks:input_type -> checklist.api.GetTasksRequest
-	6,  // 11: checklist.api.TaskService.DeleteTask:input_type -> checklist.api.DeleteTaskRequest
-	7,  // 12: checklist.api.TaskService.CompleteTask:input_type -> checklist.api.CompleteTaskRequest
-	2,  // 13: checklist.api.TaskService.RegisterUser:output_type -> checklist.api.RegisterUserResponse
-	3,  // 14: checklist.api.TaskService.LoginUser:output_type -> checklist.api.LoginUserResponse
-	8,  // 15: checklist.api.TaskService.CreateTask:output_type -> checklist.api.CreateTaskResponse
-	9,  // 16: checklist.api.TaskService.GetTasks:output_type -> checklist.api.GetTasksResponse
-	10, // 17: checklist.api.TaskService.DeleteTask:output_type -> checklist.api.DeleteTaskResponse
-	11, // 18: checklist.api.TaskService.CompleteTask:output_type -> checklist.api.CompleteTaskResponse
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	108, // 0: checklist.api.RegisterUserResponse.created_at:type_name -> google.protobuf.Timestamp
+	108, // 1: checklist.api.CreateTaskRequest.due_at:type_name -> google.protobuf.Timestamp
+	0,   // 2: checklist.api.GetTasksRequest.view:type_name -> checklist.api.TaskView
+	1,   // 3: checklist.api.GetTasksRequest.due:type_name -> checklist.api.DueFilter
+	2,   // 4: checklist.api.GetTasksRequest.sort:type_name -> checklist.api.TaskSort
+	3,   // 5: checklist.api.TaskChange.type:type_name -> checklist.api.TaskChangeType
+	21,  // 6: checklist.api.TaskChange.task:type_name -> checklist.api.Task
+	108, // 7: checklist.api.TaskChange.occurred_at:type_name -> google.protobuf.Timestamp
+	108, // 8: checklist.api.UpdateTaskRequest.due_at:type_name -> google.protobuf.Timestamp
+	15,  // 9: checklist.api.UpdateTaskRequest.tags:type_name -> checklist.api.TaskTags
+	108, // 10: checklist.api.CreateTaskResponse.created_at:type_name -> google.protobuf.Timestamp
+	108, // 11: checklist.api.CreateTaskResponse.completed_at:type_name -> google.protobuf.Timestamp
+	108, // 12: checklist.api.CreateTaskResponse.due_at:type_name -> google.protobuf.Timestamp
+	21,  // 13: checklist.api.GetTasksResponse.tasks:type_name -> checklist.api.Task
+	108, // 14: checklist.api.CompleteTaskResponse.completed_at:type_name -> google.protobuf.Timestamp
+	21,  // 15: checklist.api.UpdateTaskResponse.task:type_name -> checklist.api.Task
+	108, // 16: checklist.api.Task.created_at:type_name -> google.protobuf.Timestamp
+	108, // 17: checklist.api.Task.completed_at:type_name -> google.protobuf.Timestamp
+	108, // 18: checklist.api.Task.due_at:type_name -> google.protobuf.Timestamp
+	108, // 19: checklist.api.Task.archived_at:type_name -> google.protobuf.Timestamp
+	21,  // 20: checklist.api.ArchiveTaskResponse.task:type_name -> checklist.api.Task
+	21,  // 21: checklist.api.UnarchiveTaskResponse.task:type_name -> checklist.api.Task
+	28,  // 22: checklist.api.ImportTasksRequest.options:type_name -> checklist.api.ImportOptions
+	29,  // 23: checklist.api.ImportTasksResponse.errors:type_name -> checklist.api.ImportRowError
+	21,  // 24: checklist.api.ImportTasksResponse.tasks:type_name -> checklist.api.Task
+	52,  // 25: checklist.api.ImportTasksResponse.created_lists:type_name -> checklist.api.TaskList
+	21,  // 26: checklist.api.ImportTasksResponse.updated_tasks:type_name -> checklist.api.Task
+	108, // 27: checklist.api.CalendarToken.created_at:type_name -> google.protobuf.Timestamp
+	32,  // 28: checklist.api.GetCalendarTokenResponse.token:type_name -> checklist.api.CalendarToken
+	32,  // 29: checklist.api.ResetCalendarTokenResponse.token:type_name -> checklist.api.CalendarToken
+	2,   // 30: checklist.api.UserSettings.default_sort:type_name -> checklist.api.TaskSort
+	108, // 31: checklist.api.UserSettings.updated_at:type_name -> google.protobuf.Timestamp
+	37,  // 32: checklist.api.GetSettingsResponse.settings:type_name -> checklist.api.UserSettings
+	2,   // 33: checklist.api.UpdateSettingsRequest.default_sort:type_name -> checklist.api.TaskSort
+	37,  // 34: checklist.api.UpdateSettingsResponse.settings:type_name -> checklist.api.UserSettings
+	108, // 35: checklist.api.ArchivePolicy.updated_at:type_name -> google.protobuf.Timestamp
+	42,  // 36: checklist.api.GetArchivePolicyResponse.policy:type_name -> checklist.api.ArchivePolicy
+	42,  // 37: checklist.api.SetArchivePolicyResponse.policy:type_name -> checklist.api.ArchivePolicy
+	51,  // 38: checklist.api.GetTaskHistoryResponse.revisions:type_name -> checklist.api.TaskRevision
+	21,  // 39: checklist.api.RevertTaskResponse.task:type_name -> checklist.api.Task
+	21,  // 40: checklist.api.TaskRevision.old_task:type_name -> checklist.api.Task
+	21,  // 41: checklist.api.TaskRevision.new_task:type_name -> checklist.api.Task
+	108, // 42: checklist.api.TaskRevision.created_at:type_name -> google.protobuf.Timestamp
+	108, // 43: checklist.api.TaskList.created_at:type_name -> google.protobuf.Timestamp
+	52,  // 44: checklist.api.CreateListResponse.list:type_name -> checklist.api.TaskList
+	52,  // 45: checklist.api.GetListsResponse.lists:type_name -> checklist.api.TaskList
+	59,  // 46: checklist.api.TaskBlueprint.subtasks:type_name -> checklist.api.TaskBlueprint
+	59,  // 47: checklist.api.TaskTemplate.items:type_name -> checklist.api.TaskBlueprint
+	108, // 48: checklist.api.TaskTemplate.created_at:type_name -> google.protobuf.Timestamp
+	108, // 49: checklist.api.TaskTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	59,  // 50: checklist.api.CreateTemplateRequest.items:type_name -> checklist.api.TaskBlueprint
+	60,  // 51: checklist.api.CreateTemplateResponse.template:type_name -> checklist.api.TaskTemplate
+	60,  // 52: checklist.api.GetTemplatesResponse.templates:type_name -> checklist.api.TaskTemplate
+	60,  // 53: checklist.api.GetTemplateResponse.template:type_name -> checklist.api.TaskTemplate
+	59,  // 54: checklist.api.UpdateTemplateRequest.items:type_name -> checklist.api.TaskBlueprint
+	60,  // 55: checklist.api.UpdateTemplateResponse.template:type_name -> checklist.api.TaskTemplate
+	108, // 56: checklist.api.InstantiateTemplateRequest.start_at:type_name -> google.protobuf.Timestamp
+	21,  // 57: checklist.api.InstantiateTemplateResponse.tasks:type_name -> checklist.api.Task
+	52,  // 58: checklist.api.InstantiateTemplateResponse.list:type_name -> checklist.api.TaskList
+	108, // 59: checklist.api.SaveListAsTemplateRequest.base_time:type_name -> google.protobuf.Timestamp
+	60,  // 60: checklist.api.SaveListAsTemplateResponse.template:type_name -> checklist.api.TaskTemplate
+	108, // 61: checklist.api.Reminder.remind_at:type_name -> google.protobuf.Timestamp
+	108, // 62: checklist.api.Reminder.fire_at:type_name -> google.protobuf.Timestamp
+	108, // 63: checklist.api.Reminder.sent_at:type_name -> google.protobuf.Timestamp
+	108, // 64: checklist.api.Reminder.created_at:type_name -> google.protobuf.Timestamp
+	108, // 65: checklist.api.CreateReminderRequest.remind_at:type_name -> google.protobuf.Timestamp
+	75,  // 66: checklist.api.CreateReminderResponse.reminder:type_name -> checklist.api.Reminder
+	75,  // 67: checklist.api.GetRemindersResponse.reminders:type_name -> checklist.api.Reminder
+	108, // 68: checklist.api.DigestSettings.updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 69: checklist.api.GetDigestSettingsResponse.settings:type_name -> checklist.api.DigestSettings
+	82,  // 70: checklist.api.SetDigestSettingsResponse.settings:type_name -> checklist.api.DigestSettings
+	88,  // 71: checklist.api.TaskStats.daily:type_name -> checklist.api.StatsBucket
+	88,  // 72: checklist.api.TaskStats.weekly:type_name -> checklist.api.StatsBucket
+	88,  // 73: checklist.api.TaskStats.monthly:type_name -> checklist.api.StatsBucket
+	108, // 74: checklist.api.TaskStats.generated_at:type_name -> google.protobuf.Timestamp
+	89,  // 75: checklist.api.GetStatsResponse.stats:type_name -> checklist.api.TaskStats
+	108, // 76: checklist.api.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	108, // 77: checklist.api.Webhook.updated_at:type_name -> google.protobuf.Timestamp
+	108, // 78: checklist.api.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	108, // 79: checklist.api.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	108, // 80: checklist.api.WebhookDelivery.updated_at:type_name -> google.protobuf.Timestamp
+	92,  // 81: checklist.api.CreateWebhookResponse.webhook:type_name -> checklist.api.Webhook
+	92,  // 82: checklist.api.GetWebhooksResponse.webhooks:type_name -> checklist.api.Webhook
+	92,  // 83: checklist.api.GetWebhookResponse.webhook:type_name -> checklist.api.Webhook
+	92,  // 84: checklist.api.UpdateWebhookResponse.webhook:type_name -> checklist.api.Webhook
+	93,  // 85: checklist.api.GetWebhookDeliveriesResponse.deliveries:type_name -> checklist.api.WebhookDelivery
+	93,  // 86: checklist.api.RedeliverWebhookResponse.delivery:type_name -> checklist.api.WebhookDelivery
+	4,   // 87: checklist.api.TaskService.RegisterUser:input_type -> checklist.api.RegisterUserRequest
+	5,   // 88: checklist.api.TaskService.LoginUser:input_type -> checklist.api.LoginUserRequest
+	8,   // 89: checklist.api.TaskService.CreateTask:input_type -> checklist.api.CreateTaskRequest
+	9,   // 90: checklist.api.TaskService.GetTasks:input_type -> checklist.api.GetTasksRequest
+	10,  // 91: checklist.api.TaskService.WatchTasks:input_type -> checklist.api.WatchTasksRequest
+	12,  // 92: checklist.api.TaskService.DeleteTask:input_type -> checklist.api.DeleteTaskRequest
+	13,  // 93: checklist.api.TaskService.CompleteTask:input_type -> checklist.api.CompleteTaskRequest
+	14,  // 94: checklist.api.TaskService.UpdateTask:input_type -> checklist.api.UpdateTaskRequest
+	22,  // 95: checklist.api.TaskService.ArchiveTask:input_type -> checklist.api.ArchiveTaskRequest
+	24,  // 96: checklist.api.TaskService.UnarchiveTask:input_type -> checklist.api.UnarchiveTaskRequest
+	26,  // 97: checklist.api.TaskService.ExportTasks:input_type -> checklist.api.ExportTasksRequest
+	27,  // 98: checklist.api.TaskService.ImportTasks:input_type -> checklist.api.ImportTasksRequest
+	31,  // 99: checklist.api.TaskService.GetCalendarFeed:input_type -> checklist.api.GetCalendarFeedRequest
+	33,  // 100: checklist.api.TaskService.GetCalendarToken:input_type -> checklist.api.GetCalendarTokenRequest
+	35,  // 101: checklist.api.TaskService.ResetCalendarToken:input_type -> checklist.api.ResetCalendarTokenRequest
+	38,  // 102: checklist.api.TaskService.GetSettings:input_type -> checklist.api.GetSettingsRequest
+	40,  // 103: checklist.api.TaskService.UpdateSettings:input_type -> checklist.api.UpdateSettingsRequest
+	43,  // 104: checklist.api.TaskService.GetArchivePolicy:input_type -> checklist.api.GetArchivePolicyRequest
+	45,  // 105: checklist.api.TaskService.SetArchivePolicy:input_type -> checklist.api.SetArchivePolicyRequest
+	47,  // 106: checklist.api.TaskService.GetTaskHistory:input_type -> checklist.api.GetTaskHistoryRequest
+	49,  // 107: checklist.api.TaskService.RevertTask:input_type -> checklist.api.RevertTaskRequest
+	53,  // 108: checklist.api.TaskService.CreateList:input_type -> checklist.api.CreateListRequest
+	55,  // 109: checklist.api.TaskService.GetLists:input_type -> checklist.api.GetListsRequest
+	57,  // 110: checklist.api.TaskService.DeleteList:input_type -> checklist.api.DeleteListRequest
+	73,  // 111: checklist.api.TaskService.SaveListAsTemplate:input_type -> checklist.api.SaveListAsTemplateRequest
+	61,  // 112: checklist.api.TaskService.CreateTemplate:input_type -> checklist.api.CreateTemplateRequest
+	63,  // 113: checklist.api.TaskService.GetTemplates:input_type -> checklist.api.GetTemplatesRequest
+	65,  // 114: checklist.api.TaskService.GetTemplate:input_type -> checklist.api.GetTemplateRequest
+	67,  // 115: checklist.api.TaskService.UpdateTemplate:input_type -> checklist.api.UpdateTemplateRequest
+	69,  // 116: checklist.api.TaskService.DeleteTemplate:input_type -> checklist.api.DeleteTemplateRequest
+	71,  // 117: checklist.api.TaskService.InstantiateTemplate:input_type -> checklist.api.InstantiateTemplateRequest
+	76,  // 118: checklist.api.TaskService.CreateReminder:input_type -> checklist.api.CreateReminderRequest
+	78,  // 119: checklist.api.TaskService.GetReminders:input_type -> checklist.api.GetRemindersRequest
+	80,  // 120: checklist.api.TaskService.DeleteReminder:input_type -> checklist.api.DeleteReminderRequest
+	83,  // 121: checklist.api.TaskService.GetDigestSettings:input_type -> checklist.api.GetDigestSettingsRequest
+	85,  // 122: checklist.api.TaskService.SetDigestSettings:input_type -> checklist.api.SetDigestSettingsRequest
+	87,  // 123: checklist.api.TaskService.GetDigest:input_type -> checklist.api.GetDigestRequest
+	90,  // 124: checklist.api.TaskService.GetStats:input_type -> checklist.api.GetStatsRequest
+	94,  // 125: checklist.api.TaskService.CreateWebhook:input_type -> checklist.api.CreateWebhookRequest
+	96,  // 126: checklist.api.TaskService.GetWebhooks:input_type -> checklist.api.GetWebhooksRequest
+	98,  // 127: checklist.api.TaskService.GetWebhook:input_type -> checklist.api.GetWebhookRequest
+	100, // 128: checklist.api.TaskService.UpdateWebhook:input_type -> checklist.api.UpdateWebhookRequest
+	102, // 129: checklist.api.TaskService.DeleteWebhook:input_type -> checklist.api.DeleteWebhookRequest
+	104, // 130: checklist.api.TaskService.GetWebhookDeliveries:input_type -> checklist.api.GetWebhookDeliveriesRequest
+	106, // 131: checklist.api.TaskService.RedeliverWebhook:input_type -> checklist.api.RedeliverWebhookRequest
+	6,   // 132: checklist.api.TaskService.RegisterUser:output_type -> checklist.api.RegisterUserResponse
+	7,   // 133: checklist.api.TaskService.LoginUser:output_type -> checklist.api.LoginUserResponse
+	16,  // 134: checklist.api.TaskService.CreateTask:output_type -> checklist.api.CreateTaskResponse
+	17,  // 135: checklist.api.TaskService.GetTasks:output_type -> checklist.api.GetTasksResponse
+	11,  // 136: checklist.api.TaskService.WatchTasks:output_type -> checklist.api.TaskChange
+	18,  // 137: checklist.api.TaskService.DeleteTask:output_type -> checklist.api.DeleteTaskResponse
+	19,  // 138: checklist.api.TaskService.CompleteTask:output_type -> checklist.api.CompleteTaskResponse
+	20,  // 139: checklist.api.TaskService.UpdateTask:output_type -> checklist.api.UpdateTaskResponse
+	23,  // 140: checklist.api.TaskService.ArchiveTask:output_type -> checklist.api.ArchiveTaskResponse
+	25,  // 141: checklist.api.TaskService.UnarchiveTask:output_type -> checklist.api.UnarchiveTaskResponse
+	109, // 142: checklist.api.TaskService.ExportTasks:output_type -> google.api.HttpBody
+	30,  // 143: checklist.api.TaskService.ImportTasks:output_type -> checklist.api.ImportTasksResponse
+	109, // 144: checklist.api.TaskService.GetCalendarFeed:output_type -> google.api.HttpBody
+	34,  // 145: checklist.api.TaskService.GetCalendarToken:output_type -> checklist.api.GetCalendarTokenResponse
+	36,  // 146: checklist.api.TaskService.ResetCalendarToken:output_type -> checklist.api.ResetCalendarTokenResponse
+	39,  // 147: checklist.api.TaskService.GetSettings:output_type -> checklist.api.GetSettingsResponse
+	41,  // 148: checklist.api.TaskService.UpdateSettings:output_type -> checklist.api.UpdateSettingsResponse
+	44,  // 149: checklist.api.TaskService.GetArchivePolicy:output_type -> checklist.api.GetArchivePolicyResponse
+	46,  // 150: checklist.api.TaskService.SetArchivePolicy:output_type -> checklist.api.SetArchivePolicyResponse
+	48,  // 151: checklist.api.TaskService.GetTaskHistory:output_type -> checklist.api.GetTaskHistoryResponse
+	50,  // 152: checklist.api.TaskService.RevertTask:output_type -> checklist.api.RevertTaskResponse
+	54,  // 153: checklist.api.TaskService.CreateList:output_type -> checklist.api.CreateListResponse
+	56,  // 154: checklist.api.TaskService.GetLists:output_type -> checklist.api.GetListsResponse
+	58,  // 155: checklist.api.TaskService.DeleteList:output_type -> checklist.api.DeleteListResponse
+	74,  // 156: checklist.api.TaskService.SaveListAsTemplate:output_type -> checklist.api.SaveListAsTemplateResponse
+	62,  // 157: checklist.api.TaskService.CreateTemplate:output_type -> checklist.api.CreateTemplateResponse
+	64,  // 158: checklist.api.TaskService.GetTemplates:output_type -> checklist.api.GetTemplatesResponse
+	66,  // 159: checklist.api.TaskService.GetTemplate:output_type -> checklist.api.GetTemplateResponse
+	68,  // 160: checklist.api.TaskService.UpdateTemplate:output_type -> checklist.api.UpdateTemplateResponse
+	70,  // 161: checklist.api.TaskService.DeleteTemplate:output_type -> checklist.api.DeleteTemplateResponse
+	72,  // 162: checklist.api.TaskService.InstantiateTemplate:output_type -> checklist.api.InstantiateTemplateResponse
+	77,  // 163: checklist.api.TaskService.CreateReminder:output_type -> checklist.api.CreateReminderResponse
+	79,  // 164: checklist.api.TaskService.GetReminders:output_type -> checklist.api.GetRemindersResponse
+	81,  // 165: checklist.api.TaskService.DeleteReminder:output_type -> checklist.api.DeleteReminderResponse
+	84,  // 166: checklist.api.TaskService.GetDigestSettings:output_type -> checklist.api.GetDigestSettingsResponse
+	86,  // 167: checklist.api.TaskService.SetDigestSettings:output_type -> checklist.api.SetDigestSettingsResponse
+	109, // 168: checklist.api.TaskService.GetDigest:output_type -> google.api.HttpBody
+	91,  // 169: checklist.api.TaskService.GetStats:output_type -> checklist.api.GetStatsResponse
+	95,  // 170: checklist.api.TaskService.CreateWebhook:output_type -> checklist.api.CreateWebhookResponse
+	97,  // 171: checklist.api.TaskService.GetWebhooks:output_type -> checklist.api.GetWebhooksResponse
+	99,  // 172: checklist.api.TaskService.GetWebhook:output_type -> checklist.api.GetWebhookResponse
+	101, // 173: checklist.api.TaskService.UpdateWebhook:output_type -> checklist.api.UpdateWebhookResponse
+	103, // 174: checklist.api.TaskService.DeleteWebhook:output_type -> checklist.api.DeleteWebhookResponse
+	105, // 175: checklist.api.TaskService.GetWebhookDeliveries:output_type -> checklist.api.GetWebhookDeliveriesResponse
+	107, // 176: checklist.api.TaskService.RedeliverWebhook:output_type -> checklist.api.RedeliverWebhookResponse
+	132, // [132:177] is the sub-list for method output_type
+	87,  // [87:132] is the sub-list for method input_type
+	87,  // [87:87] is the sub-list for extension type_name
+	87,  // [87:87] is the sub-list for extension extendee
+	0,   // [0:87] is the sub-list for field type_name
 }
 
 func init() { file_api_service_proto_init() }
@@ -956,18 +7198,29 @@ func file_api_service_proto_init() {
 	if File_api_service_proto != nil {
 		return
 	}
+	file_api_service_proto_msgTypes[10].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[23].OneofWrappers = []any{
+		(*ImportTasksRequest_Options)(nil),
+		(*ImportTasksRequest_Chunk)(nil),
+	}
+	file_api_service_proto_msgTypes[36].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[55].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[71].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[72].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[96].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_service_proto_rawDesc), len(file_api_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      4,
+			NumMessages:   104,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_service_proto_goTypes,
 		DependencyIndexes: file_api_service_proto_depIdxs,
+		EnumInfos:         file_api_service_proto_enumTypes,
 		MessageInfos:      file_api_service_proto_msgTypes,
 	}.Build()
 	File_api_service_proto = out.File
@@ -6,8 +6,11 @@ import (
 	"net"
 
 	"github.com/bagdasarian/checklist-app/db_service/config"
+	"github.com/bagdasarian/checklist-app/db_service/internal/notifier"
+	"github.com/bagdasarian/checklist-app/db_service/internal/outbox"
 	"github.com/bagdasarian/checklist-app/db_service/internal/repository/postgres"
 	"github.com/bagdasarian/checklist-app/db_service/internal/server"
+	"github.com/bagdasarian/checklist-app/db_service/internal/worker"
 	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
 	"google.golang.org/grpc"
 )
@@ -33,9 +36,51 @@ func main() {
 
 	userRepo := postgres.NewUserRepository(db)
 	taskRepo := postgres.NewTaskRepository(db, redisClient)
+	listRepo := postgres.NewListRepository(db)
+	templateRepo := postgres.NewTemplateRepository(db)
+	archiveRepo := postgres.NewArchivePolicyRepository(db)
+	calendarRepo := postgres.NewCalendarTokenRepository(db)
+	webhookRepo := postgres.NewWebhookRepository(db)
+	reminderRepo := postgres.NewReminderRepository(db)
+	digestRepo := postgres.NewDigestRepository(db)
+	settingsRepo := postgres.NewSettingsRepository(db)
+	statsRepo := postgres.NewStatsRepository(db, redisClient)
+	outboxRepo := postgres.NewOutboxRepository(db)
 
-	grpcServer := grpc.NewServer()
-	taskService := server.NewTaskService(userRepo, taskRepo)
+	if cfg.Archive.Enabled {
+		archiver := worker.NewArchiver(archiveRepo, taskRepo, cfg.GetArchiveInterval())
+		go archiver.Start(ctx)
+	}
+
+	userNotifier, err := notifier.New(cfg, userRepo, webhookRepo)
+	if err != nil {
+		log.Fatalf("Failed to create notifier: %v", err)
+	}
+
+	if cfg.Reminder.Enabled {
+		scheduler := worker.NewReminderScheduler(reminderRepo, userNotifier, cfg.GetReminderInterval(),
+			cfg.Reminder.BatchSize, cfg.Reminder.MaxAttempts)
+		go scheduler.Start(ctx)
+	}
+
+	if cfg.Digest.Enabled {
+		scheduler := worker.NewDigestScheduler(digestRepo, userNotifier, cfg.GetDigestInterval(), cfg.Digest.BatchSize)
+		go scheduler.Start(ctx)
+	}
+
+	if cfg.Outbox.Enabled {
+		publisher, err := worker.NewOutboxPublisher(ctx, cfg, redisClient.Client)
+		if err != nil {
+			log.Fatalf("Failed to create outbox publisher: %v", err)
+		}
+		relay := worker.NewOutboxRelay(outboxRepo, publisher, cfg.GetOutboxInterval(),
+			cfg.Outbox.BatchSize, cfg.Outbox.MaxAttempts, cfg.GetOutboxRetention())
+		defer relay.Close()
+		go relay.Start(ctx)
+	}
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(outbox.UnaryServerInterceptor()))
+	taskService := server.NewTaskService(userRepo, taskRepo, listRepo, templateRepo, archiveRepo, calendarRepo, webhookRepo, reminderRepo, digestRepo, settingsRepo, statsRepo)
 	pb.RegisterDatabaseServiceServer(grpcServer, taskService)
 
 	lis, err := net.Listen("tcp", ":"+cfg.GRPC.Port)
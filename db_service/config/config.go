@@ -2,6 +2,9 @@ package config
 
 import (
     "fmt"
+    "strings"
+    "time"
+
     "github.com/ilyakaznacheev/cleanenv"
 )
 
@@ -25,6 +28,62 @@ type Config struct {
     GRPC struct {
         Port string `yaml:"port" env:"GRPC_PORT" env-default:"50051"`
     } `yaml:"grpc"`
+
+    Archive struct {
+        Enabled  bool `yaml:"enabled" env:"ARCHIVE_WORKER_ENABLED" env-default:"true"`
+        Interval int  `yaml:"interval" env:"ARCHIVE_WORKER_INTERVAL" env-default:"3600"`
+    } `yaml:"archive"`
+
+    Reminder struct {
+        Enabled     bool `yaml:"enabled" env:"REMINDER_WORKER_ENABLED" env-default:"true"`
+        Interval    int  `yaml:"interval" env:"REMINDER_WORKER_INTERVAL" env-default:"30"`
+        BatchSize   int  `yaml:"batch_size" env:"REMINDER_BATCH_SIZE" env-default:"100"`
+        MaxAttempts int  `yaml:"max_attempts" env:"REMINDER_MAX_ATTEMPTS" env-default:"5"`
+    } `yaml:"reminder"`
+
+    Digest struct {
+        Enabled   bool `yaml:"enabled" env:"DIGEST_WORKER_ENABLED" env-default:"true"`
+        Interval  int  `yaml:"interval" env:"DIGEST_WORKER_INTERVAL" env-default:"60"`
+        BatchSize int  `yaml:"batch_size" env:"DIGEST_BATCH_SIZE" env-default:"100"`
+    } `yaml:"digest"`
+
+    Kafka struct {
+        Brokers string `yaml:"brokers" env:"KAFKA_BROKERS" env-default:"kafka:9092"`
+        Topic   string `yaml:"topic" env:"KAFKA_TOPIC" env-default:"task-events"`
+    } `yaml:"kafka"`
+
+    // Events.Backend - брокер, в который публикуются события задач: kafka, redis (Redis Streams)
+    // или nats (NATS JetStream). Поток Redis и subject NATS называются как топик Kafka.
+    Events struct {
+        Backend      string `yaml:"backend" env:"EVENT_BACKEND" env-default:"kafka"`
+        StreamMaxLen int64  `yaml:"stream_max_len" env:"REDIS_STREAM_MAX_LEN" env-default:"1000000"` // приблизительная длина потока Redis
+    } `yaml:"events"`
+
+    NATS struct {
+        URL    string `yaml:"url" env:"NATS_URL" env-default:"nats://nats:4222"`
+        Stream string `yaml:"stream" env:"NATS_STREAM" env-default:"TASK_EVENTS"`
+    } `yaml:"nats"`
+
+    // Outbox - публикация событий задач из таблицы outbox в брокер Events.Backend; Retention - в часах
+    Outbox struct {
+        Enabled     bool `yaml:"enabled" env:"OUTBOX_RELAY_ENABLED" env-default:"true"`
+        Interval    int  `yaml:"interval" env:"OUTBOX_RELAY_INTERVAL" env-default:"1"`
+        BatchSize   int  `yaml:"batch_size" env:"OUTBOX_BATCH_SIZE" env-default:"100"`
+        MaxAttempts int  `yaml:"max_attempts" env:"OUTBOX_MAX_ATTEMPTS" env-default:"20"`
+        Retention   int  `yaml:"retention" env:"OUTBOX_RETENTION" env-default:"168"`
+    } `yaml:"outbox"`
+
+    // Notifier.Type - способ доставки уведомлений: log, webhook или email
+    Notifier struct {
+        Type string `yaml:"type" env:"NOTIFIER" env-default:"log"`
+    } `yaml:"notifier"`
+
+    SMTP struct {
+        Host   string `yaml:"host" env:"SMTP_HOST" env-default:"localhost"`
+        Port   string `yaml:"port" env:"SMTP_PORT" env-default:"1025"`
+        From   string `yaml:"from" env:"SMTP_FROM" env-default:"checklist@localhost"`
+        Domain string `yaml:"domain" env:"SMTP_RECIPIENT_DOMAIN" env-default:"checklist.local"`
+    } `yaml:"smtp"`
 }
 
 func Load() (*Config, error) {
@@ -48,4 +107,53 @@ func (c *Config) GetDBURL() string {
 
 func (c *Config) GetRedisAddr() string {
     return fmt.Sprintf("%s:%s", c.Redis.Host, c.Redis.Port)
+}
+
+// GetArchiveInterval возвращает период запуска воркера автоархивации (по умолчанию - час)
+func (c *Config) GetArchiveInterval() time.Duration {
+    if c.Archive.Interval <= 0 {
+        return time.Hour
+    }
+    return time.Duration(c.Archive.Interval) * time.Second
+}
+
+// GetReminderInterval возвращает период проверки наступивших напоминаний (по умолчанию - 30 секунд)
+func (c *Config) GetReminderInterval() time.Duration {
+    if c.Reminder.Interval <= 0 {
+        return 30 * time.Second
+    }
+    return time.Duration(c.Reminder.Interval) * time.Second
+}
+
+// GetDigestInterval возвращает период проверки времени отправки дайджестов (по умолчанию - минута)
+func (c *Config) GetDigestInterval() time.Duration {
+    if c.Digest.Interval <= 0 {
+        return time.Minute
+    }
+    return time.Duration(c.Digest.Interval) * time.Second
+}
+
+// GetKafkaBrokers возвращает список брокеров Kafka
+func (c *Config) GetKafkaBrokers() []string {
+    return strings.Split(c.Kafka.Brokers, ",")
+}
+
+// GetOutboxInterval возвращает период проверки неотправленных событий (по умолчанию - секунда)
+func (c *Config) GetOutboxInterval() time.Duration {
+    if c.Outbox.Interval <= 0 {
+        return time.Second
+    }
+    return time.Duration(c.Outbox.Interval) * time.Second
+}
+
+// GetOutboxRetention возвращает, сколько хранятся отправленные события (по умолчанию - неделя)
+func (c *Config) GetOutboxRetention() time.Duration {
+    if c.Outbox.Retention <= 0 {
+        return 7 * 24 * time.Hour
+    }
+    return time.Duration(c.Outbox.Retention) * time.Hour
+}
+
+func (c *Config) GetSMTPAddr() string {
+    return fmt.Sprintf("%s:%s", c.SMTP.Host, c.SMTP.Port)
 }
\ No newline at end of file
@@ -0,0 +1,141 @@
+// Package digest собирает и отрисовывает ежедневный дайджест задач пользователя:
+// задачи на сегодня, просроченные и выполненные вчера.
+package digest
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/internal/repository/postgres"
+	"github.com/bagdasarian/checklist-app/db_service/internal/usertime"
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Форматы дайджеста
+const (
+	FormatText     = "text"
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+)
+
+var contentTypes = map[string]string{
+	FormatText:     "text/plain; charset=utf-8",
+	FormatMarkdown: "text/markdown; charset=utf-8",
+	FormatHTML:     "text/html; charset=utf-8",
+}
+
+//go:embed templates
+var templatesFS embed.FS
+
+var funcs = template.FuncMap{
+	"md": escapeMarkdown,
+}
+
+var (
+	textTemplate     = template.Must(template.New("digest.txt.tmpl").Funcs(funcs).ParseFS(templatesFS, "templates/digest.txt.tmpl"))
+	markdownTemplate = template.Must(template.New("digest.md.tmpl").Funcs(funcs).ParseFS(templatesFS, "templates/digest.md.tmpl"))
+	htmlTemplate     = htmltemplate.Must(htmltemplate.New("digest.html.tmpl").ParseFS(templatesFS, "templates/digest.html.tmpl"))
+)
+
+// ValidFormat сообщает, поддерживается ли формат
+func ValidFormat(format string) bool {
+	_, ok := contentTypes[format]
+	return ok
+}
+
+// Digest - дайджест за один день в часовом поясе пользователя
+type Digest struct {
+	Date               time.Time // начало дня в часовом поясе пользователя
+	DueToday           []*pb.DbTask
+	Overdue            []*pb.DbTask
+	CompletedYesterday []*pb.DbTask
+}
+
+// Empty сообщает, что в дайджесте нет ни одной задачи
+func (d *Digest) Empty() bool {
+	return len(d.DueToday) == 0 && len(d.Overdue) == 0 && len(d.CompletedYesterday) == 0
+}
+
+// Clock возвращает время в часовом поясе дайджеста, например 14:30
+func (d *Digest) Clock(ts *timestamppb.Timestamp) string {
+	return ts.AsTime().In(d.Date.Location()).Format("15:04")
+}
+
+// Day возвращает дату и время в часовом поясе дайджеста
+func (d *Digest) Day(ts *timestamppb.Timestamp) string {
+	return ts.AsTime().In(d.Date.Location()).Format("2006-01-02 15:04")
+}
+
+// Render отрисовывает дайджест и возвращает текст и его Content-Type
+func Render(format string, d *Digest) (string, string, error) {
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case FormatText:
+		err = textTemplate.Execute(&buf, d)
+	case FormatMarkdown:
+		err = markdownTemplate.Execute(&buf, d)
+	case FormatHTML:
+		err = htmlTemplate.Execute(&buf, d)
+	default:
+		return "", "", fmt.Errorf("unsupported digest format %q", format)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("failed to render digest: %w", err)
+	}
+	return buf.String(), contentTypes[format], nil
+}
+
+// Builder собирает дайджесты из задач пользователя
+type Builder struct {
+	repo postgres.DigestRepositoryInterface
+}
+
+func NewBuilder(repo postgres.DigestRepositoryInterface) *Builder {
+	return &Builder{repo: repo}
+}
+
+// Build собирает дайджест за дату date (YYYY-MM-DD, пустая - сегодня) в часовом поясе timeZone.
+// Границы дня вычисляются в этом поясе, поэтому переход на летнее время учитывается.
+func (b *Builder) Build(ctx context.Context, userID, timeZone, date string) (*Digest, error) {
+	loc, err := usertime.LoadLocation(timeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	dayStart := usertime.StartOfDay(time.Now(), loc)
+	if date != "" {
+		if dayStart, err = usertime.ParseDate(date, loc); err != nil {
+			return nil, err
+		}
+	}
+
+	tasks, err := b.repo.GetDigestTasks(ctx, userID, dayStart.AddDate(0, 0, -1), dayStart, dayStart.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Digest{
+		Date:               dayStart,
+		DueToday:           tasks.DueToday,
+		Overdue:            tasks.Overdue,
+		CompletedYesterday: tasks.CompletedYesterday,
+	}, nil
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`,
+	`<`, `\<`, `>`, `\>`, `#`, `\#`, `|`, `\|`,
+)
+
+// escapeMarkdown экранирует символы разметки в названиях задач
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
@@ -0,0 +1,161 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/internal/repository/postgres"
+)
+
+// Таймаут на отправку одного письма
+const emailTimeout = 30 * time.Second
+
+// EmailNotifier отправляет уведомления письмом через SMTP сервер без авторизации
+// (в docker-compose - MailHog). Адрес получателя - username, если это email,
+// иначе username@domain.
+type EmailNotifier struct {
+	addr   string
+	from   string
+	domain string
+	users  postgres.UserRepositoryInterface
+}
+
+func NewEmailNotifier(addr, from, domain string, users postgres.UserRepositoryInterface) *EmailNotifier {
+	return &EmailNotifier{
+		addr:   addr,
+		from:   from,
+		domain: domain,
+		users:  users,
+	}
+}
+
+func (n *EmailNotifier) Notify(ctx context.Context, notification Notification) error {
+	user, err := n.users.GetUserByID(ctx, notification.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	to := user.Username
+	if !strings.Contains(to, "@") {
+		to = to + "@" + n.domain
+	}
+
+	message, err := n.buildMessage(to, notification)
+	if err != nil {
+		return fmt.Errorf("failed to build message: %w", err)
+	}
+	if err := n.send(ctx, to, message); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
+
+// buildMessage собирает письмо; Message-ID строится из ID уведомления,
+// чтобы почтовый клиент мог отбросить повторную доставку
+func (n *EmailNotifier) buildMessage(to string, notification Notification) ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+	}
+
+	header("From", n.from)
+	header("To", to)
+	header("Subject", mime.QEncoding.Encode("utf-8", notification.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", fmt.Sprintf("<%s@%s>", notification.ID, n.domain))
+	header("MIME-Version", "1.0")
+
+	if notification.HTML == "" {
+		header("Content-Type", "text/plain; charset=utf-8")
+		header("Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		if err := writeQuotedPrintable(&buf, notification.Text); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+	header("Content-Type", "multipart/alternative; boundary="+parts.Boundary())
+	buf.WriteString("\r\n")
+
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", notification.Text},
+		{"text/html; charset=utf-8", notification.HTML},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeQuotedPrintable(w, part.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
+
+func (n *EmailNotifier) send(ctx context.Context, to string, message []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, emailTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", n.addr)
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+
+	host, _, _ := net.SplitHostPort(n.addr)
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Mail(n.from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(message); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func writeQuotedPrintable(w io.Writer, text string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(text)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
@@ -0,0 +1,53 @@
+// Package notifier доставляет уведомления пользователям: в лог, на вебхуки или по почте.
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/bagdasarian/checklist-app/db_service/config"
+	"github.com/bagdasarian/checklist-app/db_service/internal/repository/postgres"
+)
+
+// Notification - уведомление пользователя
+type Notification struct {
+	ID      string // стабильный ID: по нему получатель отличает повторную доставку
+	UserID  string
+	Event   string // тип события, например task.reminder
+	Subject string
+	Text    string
+	HTML    string // необязательная HTML версия текста
+	Payload any    // тело события для вебхуков, сериализуется в JSON
+}
+
+// Notifier доставляет уведомления
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// New создает способ доставки, выбранный в конфигурации
+func New(cfg *config.Config, users postgres.UserRepositoryInterface, webhooks postgres.WebhookRepositoryInterface) (Notifier, error) {
+	switch cfg.Notifier.Type {
+	case "", "log":
+		return NewLogNotifier(), nil
+	case "webhook":
+		return NewWebhookNotifier(webhooks), nil
+	case "email":
+		return NewEmailNotifier(cfg.GetSMTPAddr(), cfg.SMTP.From, cfg.SMTP.Domain, users), nil
+	default:
+		return nil, fmt.Errorf("unknown notifier type: %s", cfg.Notifier.Type)
+	}
+}
+
+// LogNotifier записывает уведомления в лог сервиса
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, notification Notification) error {
+	log.Printf("Notification %s for user %s: %s", notification.ID, notification.UserID, notification.Subject)
+	return nil
+}
@@ -0,0 +1,39 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bagdasarian/checklist-app/db_service/internal/repository/postgres"
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+)
+
+// WebhookNotifier ставит уведомление в очередь доставок вебхуков пользователя,
+// подписанных на его тип события. ID уведомления становится ID события, поэтому
+// повторная постановка того же уведомления не создает дублей.
+type WebhookNotifier struct {
+	webhooks postgres.WebhookRepositoryInterface
+}
+
+func NewWebhookNotifier(webhooks postgres.WebhookRepositoryInterface) *WebhookNotifier {
+	return &WebhookNotifier{webhooks: webhooks}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, notification Notification) error {
+	payload, err := json.Marshal(notification.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	_, err = n.webhooks.EnqueueWebhookDeliveries(ctx, &pb.EnqueueWebhookDeliveriesRequest{
+		UserId:    notification.UserID,
+		EventId:   notification.ID,
+		EventType: notification.Event,
+		Payload:   string(payload),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to enqueue webhook deliveries: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// Package outbox собирает события задач для таблицы outbox. Сведения о запросе
+// (request_id, trace_id, атрибуты клиента) api_service передает в метаданных gRPC,
+// интерсептор сохраняет их в контексте до записи события в транзакции изменения.
+package outbox
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	kafkapb "github.com/bagdasarian/checklist-app/db_service/pkg/pb/kafka"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Метаданные gRPC со сведениями о запросе; атрибут события key передается в AttributePrefix+key
+const (
+	RequestIDKey    = "x-request-id"
+	TraceIDKey      = "x-trace-id"
+	AttributePrefix = "x-event-attr-"
+)
+
+// Формат сообщения совпадает с событиями api_service (см. api_service/internal/producer)
+const (
+	HeaderContentType   = "content-type"
+	HeaderSchemaVersion = "schema-version"
+
+	ContentTypeProtobuf = "application/x-protobuf"
+	SchemaVersion       = "2"
+)
+
+// Поля записи в потоке Redis и заголовок NATS с ключом сообщения (см. api_service/internal/producer)
+const (
+	RedisFieldKey     = "key"
+	RedisFieldValue   = "value"
+	RedisHeaderPrefix = "header:"
+	NATSHeaderKey     = "message-key"
+)
+
+// Source - значение поля source в событиях db_service
+const Source = "db_service"
+
+// Meta - сведения о запросе, в котором изменилась задача
+type Meta struct {
+	RequestID  string
+	TraceID    string
+	Attributes map[string]string
+}
+
+type metaKey struct{}
+
+// WithMeta сохраняет сведения о запросе в контексте
+func WithMeta(ctx context.Context, meta Meta) context.Context {
+	return context.WithValue(ctx, metaKey{}, meta)
+}
+
+// MetaFromContext возвращает сведения о запросе; для фоновых воркеров они пустые
+func MetaFromContext(ctx context.Context) Meta {
+	meta, _ := ctx.Value(metaKey{}).(Meta)
+	return meta
+}
+
+// UnaryServerInterceptor переносит сведения о запросе из метаданных gRPC в контекст
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		meta := Meta{Attributes: make(map[string]string)}
+		for key, values := range md {
+			if len(values) == 0 {
+				continue
+			}
+			switch {
+			case key == RequestIDKey:
+				meta.RequestID = values[0]
+			case key == TraceIDKey:
+				meta.TraceID = values[0]
+			case strings.HasPrefix(key, AttributePrefix):
+				meta.Attributes[strings.TrimPrefix(key, AttributePrefix)] = values[0]
+			}
+		}
+		return handler(WithMeta(ctx, meta), req)
+	}
+}
+
+// NewEvent собирает событие изменения задачи; атрибуты запроса из контекста дополняются attributes
+func NewEvent(ctx context.Context, action kafkapb.ActionType, userID, taskID string, attributes map[string]string) (*kafkapb.TaskEvent, error) {
+	eventID, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	meta := MetaFromContext(ctx)
+	attrs := make(map[string]string, len(meta.Attributes)+len(attributes))
+	for key, value := range meta.Attributes {
+		attrs[key] = value
+	}
+	for key, value := range attributes {
+		attrs[key] = value
+	}
+
+	return &kafkapb.TaskEvent{
+		Timestamp:     timestamppb.New(time.Now()),
+		Action:        action,
+		UserId:        userID,
+		TaskId:        taskID,
+		EventId:       eventID.String(),
+		RequestId:     meta.RequestID,
+		TraceId:       meta.TraceID,
+		Source:        Source,
+		SchemaVersion: 2,
+		Attributes:    attrs,
+	}, nil
+}
+
+// Encode сериализует событие в protobuf
+func Encode(event *kafkapb.TaskEvent) ([]byte, error) {
+	return proto.Marshal(event)
+}
+
+// Headers возвращает заголовки формата события
+func Headers() []kafka.Header {
+	return []kafka.Header{
+		{Key: HeaderContentType, Value: []byte(ContentTypeProtobuf)},
+		{Key: HeaderSchemaVersion, Value: []byte(SchemaVersion)},
+	}
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultArchiveAfterDays - срок автоархивации, если пользователь не задал политику
+const DefaultArchiveAfterDays = 30
+
+type ArchivePolicyRepository struct {
+	db *Postgres
+}
+
+func NewArchivePolicyRepository(db *Postgres) *ArchivePolicyRepository {
+	return &ArchivePolicyRepository{db: db}
+}
+
+// GetArchivePolicy возвращает политику автоархивации пользователя.
+// Если политика не задана, возвращается выключенная политика по умолчанию.
+func (r *ArchivePolicyRepository) GetArchivePolicy(ctx context.Context, userID string) (*pb.ArchivePolicy, error) {
+	query := `
+        SELECT user_id, enabled, archive_after_days, updated_at
+        FROM archive_policies
+        WHERE user_id = $1
+    `
+
+	policy, err := scanArchivePolicy(r.db.Pool.QueryRow(ctx, query, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &pb.ArchivePolicy{
+			UserId:           userID,
+			Enabled:          false,
+			ArchiveAfterDays: DefaultArchiveAfterDays,
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archive policy: %w", err)
+	}
+	return policy, nil
+}
+
+// SetArchivePolicy создает или изменяет политику автоархивации пользователя
+func (r *ArchivePolicyRepository) SetArchivePolicy(ctx context.Context, req *pb.SetArchivePolicyRequest) (*pb.ArchivePolicy, error) {
+	query := `
+        INSERT INTO archive_policies (user_id, enabled, archive_after_days)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (user_id) DO UPDATE
+        SET enabled = EXCLUDED.enabled, archive_after_days = EXCLUDED.archive_after_days, updated_at = NOW()
+        RETURNING user_id, enabled, archive_after_days, updated_at
+    `
+
+	policy, err := scanArchivePolicy(r.db.Pool.QueryRow(ctx, query, req.UserId, req.Enabled, req.ArchiveAfterDays))
+	if err != nil {
+		return nil, fmt.Errorf("failed to set archive policy: %w", err)
+	}
+	return policy, nil
+}
+
+// GetEnabledArchivePolicies возвращает включенные политики для воркера автоархивации
+func (r *ArchivePolicyRepository) GetEnabledArchivePolicies(ctx context.Context) ([]*pb.ArchivePolicy, error) {
+	query := `
+        SELECT user_id, enabled, archive_after_days, updated_at
+        FROM archive_policies
+        WHERE enabled = true
+    `
+
+	rows, err := r.db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archive policies: %w", err)
+	}
+	defer rows.Close()
+
+	var policies []*pb.ArchivePolicy
+	for rows.Next() {
+		policy, err := scanArchivePolicy(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan archive policy: %w", err)
+		}
+		policies = append(policies, policy)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read archive policies: %w", err)
+	}
+
+	return policies, nil
+}
+
+func scanArchivePolicy(row pgx.Row) (*pb.ArchivePolicy, error) {
+	var policy pb.ArchivePolicy
+	var updatedAt time.Time
+	if err := row.Scan(&policy.UserId, &policy.Enabled, &policy.ArchiveAfterDays, &updatedAt); err != nil {
+		return nil, err
+	}
+	policy.UpdatedAt = timestamppb.New(updatedAt)
+	return &policy, nil
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrCalendarTokenNotFound = errors.New("calendar token not found")
+
+// Длина токена календарной ленты в байтах до кодирования в base64
+const calendarTokenBytes = 32
+
+type CalendarTokenRepository struct {
+	db *Postgres
+}
+
+func NewCalendarTokenRepository(db *Postgres) *CalendarTokenRepository {
+	return &CalendarTokenRepository{db: db}
+}
+
+// GetCalendarToken возвращает токен календарной ленты пользователя, создавая его при первом запросе
+func (r *CalendarTokenRepository) GetCalendarToken(ctx context.Context, userID string) (*pb.CalendarToken, error) {
+	insertQuery := `
+        INSERT INTO calendar_tokens (user_id, token)
+        VALUES ($1, $2)
+        ON CONFLICT (user_id) DO NOTHING
+    `
+	selectQuery := `
+        SELECT user_id, token, created_at
+        FROM calendar_tokens
+        WHERE user_id = $1
+    `
+
+	token, err := newCalendarToken()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.db.Pool.Exec(ctx, insertQuery, userID, token); err != nil {
+		return nil, fmt.Errorf("failed to create calendar token: %w", err)
+	}
+
+	calendarToken, err := scanCalendarToken(r.db.Pool.QueryRow(ctx, selectQuery, userID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar token: %w", err)
+	}
+	return calendarToken, nil
+}
+
+// ResetCalendarToken заменяет токен календарной ленты пользователя на новый
+func (r *CalendarTokenRepository) ResetCalendarToken(ctx context.Context, userID string) (*pb.CalendarToken, error) {
+	query := `
+        INSERT INTO calendar_tokens (user_id, token)
+        VALUES ($1, $2)
+        ON CONFLICT (user_id) DO UPDATE
+        SET token = EXCLUDED.token, created_at = NOW()
+        RETURNING user_id, token, created_at
+    `
+
+	token, err := newCalendarToken()
+	if err != nil {
+		return nil, err
+	}
+
+	calendarToken, err := scanCalendarToken(r.db.Pool.QueryRow(ctx, query, userID, token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset calendar token: %w", err)
+	}
+	return calendarToken, nil
+}
+
+// GetCalendarTokenOwner возвращает ID пользователя, которому принадлежит токен
+func (r *CalendarTokenRepository) GetCalendarTokenOwner(ctx context.Context, token string) (string, error) {
+	query := `SELECT user_id FROM calendar_tokens WHERE token = $1`
+
+	var userID string
+	err := r.db.Pool.QueryRow(ctx, query, token).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrCalendarTokenNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get calendar token owner: %w", err)
+	}
+	return userID, nil
+}
+
+// newCalendarToken генерирует случайный токен, пригодный для URL
+func newCalendarToken() (string, error) {
+	buf := make([]byte, calendarTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate calendar token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func scanCalendarToken(row pgx.Row) (*pb.CalendarToken, error) {
+	var token pb.CalendarToken
+	var createdAt time.Time
+	if err := row.Scan(&token.UserId, &token.Token, &createdAt); err != nil {
+		return nil, err
+	}
+	token.CreatedAt = timestamppb.New(createdAt)
+	return &token, nil
+}
@@ -0,0 +1,232 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Настройки дайджеста по умолчанию, если пользователь их не задал
+const (
+	DefaultDigestDeliveryTime = "08:00"
+	DefaultDigestFormat       = "text"
+)
+
+// digestTimeZone - часовой пояс пользователя; в запросах digest_settings имеет псевдоним d, user_settings - us
+const digestTimeZone = `COALESCE(us.time_zone, 'UTC')`
+
+const digestSettingsColumns = `d.user_id, d.enabled, to_char(d.delivery_time, 'HH24:MI'), ` + digestTimeZone + `, d.format,
+        to_char(d.last_sent_on, 'YYYY-MM-DD'), d.updated_at`
+
+// DigestTasks - задачи, из которых собирается дайджест
+type DigestTasks struct {
+	DueToday           []*pb.DbTask
+	Overdue            []*pb.DbTask
+	CompletedYesterday []*pb.DbTask
+}
+
+type DigestRepository struct {
+	db *Postgres
+}
+
+func NewDigestRepository(db *Postgres) *DigestRepository {
+	return &DigestRepository{db: db}
+}
+
+// GetDigestSettings возвращает настройки дайджеста пользователя.
+// Если настройки не заданы, возвращаются выключенные настройки по умолчанию.
+func (r *DigestRepository) GetDigestSettings(ctx context.Context, userID string) (*pb.DigestSettings, error) {
+	query := `
+        SELECT ` + digestSettingsColumns + `
+        FROM digest_settings d
+        LEFT JOIN user_settings us ON us.user_id = d.user_id
+        WHERE d.user_id = $1
+    `
+	timeZoneQuery := `SELECT time_zone FROM user_settings WHERE user_id = $1`
+
+	settings, err := scanDigestSettings(r.db.Pool.QueryRow(ctx, query, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		settings = &pb.DigestSettings{
+			UserId:       userID,
+			Enabled:      false,
+			DeliveryTime: DefaultDigestDeliveryTime,
+			TimeZone:     DefaultTimeZone,
+			Format:       DefaultDigestFormat,
+		}
+		err = r.db.Pool.QueryRow(ctx, timeZoneQuery, userID).Scan(&settings.TimeZone)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return settings, nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get digest settings: %w", err)
+	}
+	return settings, nil
+}
+
+// SetDigestSettings создает или изменяет настройки дайджеста пользователя
+func (r *DigestRepository) SetDigestSettings(ctx context.Context, req *pb.SetDigestSettingsRequest) (*pb.DigestSettings, error) {
+	query := `
+        WITH d AS (
+            INSERT INTO digest_settings (user_id, enabled, delivery_time, format)
+            VALUES ($1, $2, $3::time, $4)
+            ON CONFLICT (user_id) DO UPDATE
+            SET enabled = EXCLUDED.enabled, delivery_time = EXCLUDED.delivery_time,
+                format = EXCLUDED.format, updated_at = NOW()
+            RETURNING *
+        )
+        SELECT ` + digestSettingsColumns + `
+        FROM d
+        LEFT JOIN user_settings us ON us.user_id = d.user_id
+    `
+
+	settings, err := scanDigestSettings(r.db.Pool.QueryRow(ctx, query,
+		req.UserId, req.Enabled, req.DeliveryTime, req.Format))
+	if err != nil {
+		return nil, fmt.Errorf("failed to set digest settings: %w", err)
+	}
+	return settings, nil
+}
+
+// GetDigestTasks возвращает невыполненные задачи со сроком в [dayStart, dayEnd), просроченные
+// к dayStart и выполненные в [yesterdayStart, dayStart). Архивные задачи не учитываются.
+func (r *DigestRepository) GetDigestTasks(ctx context.Context, userID string, yesterdayStart, dayStart, dayEnd time.Time) (*DigestTasks, error) {
+	dueQuery := `
+        SELECT ` + taskColumns + `
+        FROM tasks
+        WHERE user_id = $1 AND completed IS NOT TRUE AND archived_at IS NULL
+            AND due_at >= $2 AND due_at < $3
+        ORDER BY due_at, created_at
+    `
+	overdueQuery := `
+        SELECT ` + taskColumns + `
+        FROM tasks
+        WHERE user_id = $1 AND completed IS NOT TRUE AND archived_at IS NULL
+            AND due_at < $2
+        ORDER BY due_at, created_at
+    `
+	completedQuery := `
+        SELECT ` + taskColumns + `
+        FROM tasks
+        WHERE user_id = $1 AND completed AND archived_at IS NULL
+            AND completed_at >= $2 AND completed_at < $3
+        ORDER BY completed_at
+    `
+
+	var digest DigestTasks
+	var err error
+	if digest.DueToday, err = r.queryTasks(ctx, dueQuery, userID, dayStart, dayEnd); err != nil {
+		return nil, err
+	}
+	if digest.Overdue, err = r.queryTasks(ctx, overdueQuery, userID, dayStart); err != nil {
+		return nil, err
+	}
+	if digest.CompletedYesterday, err = r.queryTasks(ctx, completedQuery, userID, yesterdayStart, dayStart); err != nil {
+		return nil, err
+	}
+	return &digest, nil
+}
+
+func (r *DigestRepository) queryTasks(ctx context.Context, query string, args ...any) ([]*pb.DbTask, error) {
+	rows, err := r.db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get digest tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []*pb.DbTask
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read digest tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// ProcessDueDigests выбирает до limit пользователей, у которых в их часовом поясе (из настроек пользователя) наступило время
+// дайджеста, а сегодня он еще не отправлялся, и вызывает для каждого fn с локальной датой.
+// Настройки заблокированы до конца транзакции (FOR UPDATE SKIP LOCKED), и дата отправки
+// записывается в той же транзакции, поэтому дайджест уходит не больше раза в день даже при
+// нескольких экземплярах db_service. Если fn вернул ошибку, дайджест повторяется при следующем запуске.
+// Возвращает число обработанных пользователей и число ошибок.
+func (r *DigestRepository) ProcessDueDigests(ctx context.Context, limit int, fn func(settings *pb.DigestSettings, date string) error) (int, int, error) {
+	dueQuery := `
+        SELECT ` + digestSettingsColumns + `, to_char((NOW() AT TIME ZONE ` + digestTimeZone + `)::date, 'YYYY-MM-DD')
+        FROM digest_settings d
+        LEFT JOIN user_settings us ON us.user_id = d.user_id
+        WHERE d.enabled
+            AND (NOW() AT TIME ZONE ` + digestTimeZone + `)::time >= d.delivery_time
+            AND (d.last_sent_on IS NULL OR d.last_sent_on < (NOW() AT TIME ZONE ` + digestTimeZone + `)::date)
+        ORDER BY d.user_id
+        LIMIT $1
+        FOR UPDATE OF d SKIP LOCKED
+    `
+	updateQuery := `UPDATE digest_settings SET last_sent_on = $2::date WHERE user_id = $1`
+
+	type dueDigest struct {
+		settings *pb.DigestSettings
+		date     string
+	}
+
+	var sent, failed int
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, dueQuery, limit)
+		if err != nil {
+			return fmt.Errorf("failed to get due digests: %w", err)
+		}
+		due, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (dueDigest, error) {
+			var d dueDigest
+			var err error
+			d.settings, err = scanDigestSettings(row, &d.date)
+			return d, err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to read due digests: %w", err)
+		}
+
+		for _, d := range due {
+			if err := fn(d.settings, d.date); err != nil {
+				failed++
+				continue
+			}
+			if _, err := tx.Exec(ctx, updateQuery, d.settings.UserId, d.date); err != nil {
+				return fmt.Errorf("failed to update digest settings: %w", err)
+			}
+			sent++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return sent, failed, nil
+}
+
+func scanDigestSettings(row pgx.Row, extra ...any) (*pb.DigestSettings, error) {
+	var settings pb.DigestSettings
+	var lastSentOn *string
+	var updatedAt time.Time
+	dest := []any{&settings.UserId, &settings.Enabled, &settings.DeliveryTime, &settings.TimeZone,
+		&settings.Format, &lastSentOn, &updatedAt}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	if lastSentOn != nil {
+		settings.LastSentOn = *lastSentOn
+	}
+	settings.UpdatedAt = timestamppb.New(updatedAt)
+	return &settings, nil
+}
@@ -2,9 +2,16 @@ package postgres
 
 import (
     "time"
+
+    "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+    "github.com/jackc/pgx/v5"
     "google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// taskColumns - список колонок задачи в порядке, ожидаемом scanTask
+const taskColumns = `id, user_id, title, description, completed, created_at, completed_at, version,
+        due_at, tags, parent_id, list_id, archived_at, ical_uid`
+
 func convertToTimestamp(t interface{}) *timestamppb.Timestamp {
     switch v := t.(type) {
     case time.Time:
@@ -15,4 +22,55 @@ func convertToTimestamp(t interface{}) *timestamppb.Timestamp {
         }
     }
     return nil
-}
\ No newline at end of file
+}
+
+// timestampToTime преобразует proto timestamp в значение для nullable колонки
+func timestampToTime(ts *timestamppb.Timestamp) *time.Time {
+    if ts == nil {
+        return nil
+    }
+    t := ts.AsTime()
+    return &t
+}
+
+// nullableString возвращает nil для пустой строки, чтобы записать NULL
+func nullableString(s string) *string {
+    if s == "" {
+        return nil
+    }
+    return &s
+}
+
+// nonNilTags заменяет nil на пустой срез: колонка tags объявлена NOT NULL
+func nonNilTags(tags []string) []string {
+    if tags == nil {
+        return []string{}
+    }
+    return tags
+}
+
+// scanTask читает задачу из строки результата с колонками taskColumns
+func scanTask(row pgx.Row) (*pb.DbTask, error) {
+    var task pb.DbTask
+    var createdAt time.Time
+    var completedAt, dueAt, archivedAt *time.Time
+    var parentID, listID *string
+    err := row.Scan(&task.Id, &task.UserId, &task.Title, &task.Description,
+        &task.Completed, &createdAt, &completedAt, &task.Version,
+        &dueAt, &task.Tags, &parentID, &listID, &archivedAt, &task.IcalUid)
+    if err != nil {
+        return nil, err
+    }
+
+    task.CreatedAt = timestamppb.New(createdAt)
+    task.CompletedAt = convertToTimestamp(completedAt)
+    task.DueAt = convertToTimestamp(dueAt)
+    task.ArchivedAt = convertToTimestamp(archivedAt)
+    if parentID != nil {
+        task.ParentId = *parentID
+    }
+    if listID != nil {
+        task.ListId = *listID
+    }
+    return &task, nil
+}
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrListNotFound = errors.New("list not found or access denied")
+
+type ListRepository struct {
+	db *Postgres
+}
+
+func NewListRepository(db *Postgres) *ListRepository {
+	return &ListRepository{db: db}
+}
+
+// CreateList создает список задач
+func (r *ListRepository) CreateList(ctx context.Context, userID, name string) (*pb.TaskList, error) {
+	var list *pb.TaskList
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		list, err = insertList(ctx, tx, userID, name)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// GetLists возвращает списки задач пользователя
+func (r *ListRepository) GetLists(ctx context.Context, userID string) ([]*pb.TaskList, error) {
+	query := `
+        SELECT id, user_id, name, created_at
+        FROM task_lists
+        WHERE user_id = $1
+        ORDER BY created_at
+    `
+
+	rows, err := r.db.Pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lists: %w", err)
+	}
+	defer rows.Close()
+
+	var lists []*pb.TaskList
+	for rows.Next() {
+		list, err := scanList(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan list: %w", err)
+		}
+		lists = append(lists, list)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read lists: %w", err)
+	}
+
+	return lists, nil
+}
+
+// DeleteList удаляет список. Задачи списка остаются у пользователя без списка,
+// изменение записывается в их историю.
+func (r *ListRepository) DeleteList(ctx context.Context, listID, userID string) (bool, error) {
+	detachQuery := `
+        UPDATE tasks
+        SET list_id = NULL, version = version + 1
+        WHERE list_id = $1 AND user_id = $2
+        RETURNING ` + taskColumns
+	deleteQuery := `
+        DELETE FROM task_lists
+        WHERE id = $1 AND user_id = $2
+    `
+
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		if err := checkListOwner(ctx, tx, listID, userID); err != nil {
+			return err
+		}
+
+		tasks, err := queryTasks(ctx, tx, detachQuery, listID, userID)
+		if err != nil {
+			return fmt.Errorf("failed to detach tasks from list: %w", err)
+		}
+		for _, task := range tasks {
+			oldTask := proto.Clone(task).(*pb.DbTask)
+			oldTask.ListId = listID
+			oldTask.Version = task.Version - 1
+			if err := recordRevision(ctx, tx, revisionActionUpdated, userID, oldTask, task); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.Exec(ctx, deleteQuery, listID, userID); err != nil {
+			return fmt.Errorf("failed to delete list: %w", err)
+		}
+		return nil
+	})
+	if errors.Is(err, ErrListNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// insertList создает список в рамках транзакции
+func insertList(ctx context.Context, tx pgx.Tx, userID, name string) (*pb.TaskList, error) {
+	query := `
+        INSERT INTO task_lists (user_id, name)
+        VALUES ($1, $2)
+        RETURNING id, user_id, name, created_at
+    `
+
+	list, err := scanList(tx.QueryRow(ctx, query, userID, name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create list: %w", err)
+	}
+	return list, nil
+}
+
+// checkListOwner проверяет, что список существует и принадлежит пользователю
+func checkListOwner(ctx context.Context, tx pgx.Tx, listID, userID string) error {
+	var exists bool
+	err := tx.QueryRow(ctx,
+		`SELECT EXISTS(SELECT 1 FROM task_lists WHERE id = $1 AND user_id = $2)`,
+		listID, userID,
+	).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check list: %w", err)
+	}
+	if !exists {
+		return ErrListNotFound
+	}
+	return nil
+}
+
+func scanList(row pgx.Row) (*pb.TaskList, error) {
+	var list pb.TaskList
+	var createdAt time.Time
+	if err := row.Scan(&list.Id, &list.UserId, &list.Name, &createdAt); err != nil {
+		return nil, err
+	}
+	list.CreatedAt = timestamppb.New(createdAt)
+	return &list, nil
+}
@@ -0,0 +1,192 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/internal/outbox"
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	kafkapb "github.com/bagdasarian/checklist-app/db_service/pkg/pb/kafka"
+	"github.com/jackc/pgx/v5"
+)
+
+// Ключ блокировки публикации outbox: события публикует один экземпляр db_service,
+// поэтому события пользователя попадают в брокер в порядке записи
+const outboxLockKey = 7340130
+
+// Задержка перед повторной публикацией события удваивается с каждой попыткой, но не больше 5 минут
+const (
+	outboxRetryDelay    = time.Second
+	outboxMaxRetryDelay = 5 * time.Minute
+)
+
+// eventActions - действие события для действия ревизии задачи
+var eventActions = map[string]kafkapb.ActionType{
+	revisionActionCreated:    kafkapb.ActionType_ACTION_CREATE_TASK,
+	revisionActionUpdated:    kafkapb.ActionType_ACTION_UPDATE_TASK,
+	revisionActionCompleted:  kafkapb.ActionType_ACTION_COMPLETE_TASK,
+	revisionActionDeleted:    kafkapb.ActionType_ACTION_DELETE_TASK,
+	revisionActionReverted:   kafkapb.ActionType_ACTION_REVERT_TASK,
+	revisionActionArchived:   kafkapb.ActionType_ACTION_ARCHIVE_TASK,
+	revisionActionUnarchived: kafkapb.ActionType_ACTION_UNARCHIVE_TASK,
+}
+
+// OutboxMessage - неотправленное событие
+type OutboxMessage struct {
+	ID       int64
+	UserID   string
+	EventID  string
+	Payload  []byte
+	Attempts int
+}
+
+type OutboxRepository struct {
+	db *Postgres
+}
+
+func NewOutboxRepository(db *Postgres) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// enqueueEvent записывает событие изменения задачи в outbox в рамках транзакции изменения
+func enqueueEvent(ctx context.Context, tx pgx.Tx, action kafkapb.ActionType, task *pb.DbTask) error {
+	query := `
+        INSERT INTO outbox (user_id, event_id, action, payload)
+        VALUES ($1, $2, $3, $4)
+    `
+
+	event, err := outbox.NewEvent(ctx, action, task.UserId, task.Id, map[string]string{"title": task.Title})
+	if err != nil {
+		return fmt.Errorf("failed to create event: %w", err)
+	}
+	payload, err := outbox.Encode(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, task.UserId, event.EventId, action.String(), payload); err != nil {
+		return fmt.Errorf("failed to enqueue event: %w", err)
+	}
+	return nil
+}
+
+// ProcessPending выбирает до limit неотправленных событий в порядке записи и передает их fn.
+// fn возвращает для каждого события ошибку публикации или nil и вызывается вне транзакции:
+// публикацию от других экземпляров исключает сессионная advisory-блокировка на отдельном соединении.
+// Опубликованные события отмечаются отправленными. Первое неопубликованное событие пользователя
+// повторяется после паузы, которая растет с каждой попыткой, а после maxAttempts попыток откладывается
+// (failed_at) и больше не публикуется; следующие события пользователя ждут его, и их попытки не считаются.
+// Если события публикует другой экземпляр, fn не вызывается.
+// Возвращает число отправленных, неотправленных и отложенных (входят в неотправленные) событий.
+func (r *OutboxRepository) ProcessPending(ctx context.Context, limit, maxAttempts int, fn func(messages []OutboxMessage) []error) (int, int, int, error) {
+	pendingQuery := `
+        SELECT o.id, o.user_id, o.event_id, o.payload, o.attempts
+        FROM outbox o
+        WHERE o.sent_at IS NULL AND o.failed_at IS NULL
+            AND NOT EXISTS (
+                SELECT 1
+                FROM outbox e
+                WHERE e.user_id = o.user_id AND e.id <= o.id
+                    AND e.sent_at IS NULL AND e.failed_at IS NULL
+                    AND e.retry_at > NOW()
+            )
+        ORDER BY o.id
+        LIMIT $1
+    `
+	sentQuery := `UPDATE outbox SET sent_at = NOW(), attempts = attempts + 1, last_error = NULL, retry_at = NULL WHERE id = ANY($1)`
+	failedQuery := `UPDATE outbox SET attempts = attempts + 1, last_error = $2, retry_at = $3, failed_at = $4 WHERE id = $1`
+
+	conn, err := r.db.Pool.Acquire(ctx)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	var locked bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, outboxLockKey).Scan(&locked); err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to lock outbox: %w", err)
+	}
+	if !locked {
+		return 0, 0, 0, nil
+	}
+	defer func() {
+		// Соединение, на котором осталась блокировка, закрывается, а не возвращается в пул
+		unlockCtx := context.WithoutCancel(ctx)
+		if _, err := conn.Exec(unlockCtx, `SELECT pg_advisory_unlock($1)`, outboxLockKey); err != nil {
+			conn.Conn().Close(unlockCtx)
+		}
+	}()
+
+	rows, err := conn.Query(ctx, pendingQuery, limit)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to get outbox events: %w", err)
+	}
+	messages, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (OutboxMessage, error) {
+		var m OutboxMessage
+		err := row.Scan(&m.ID, &m.UserID, &m.EventID, &m.Payload, &m.Attempts)
+		return m, err
+	})
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to read outbox events: %w", err)
+	}
+	if len(messages) == 0 {
+		return 0, 0, 0, nil
+	}
+
+	errs := fn(messages)
+
+	var sent, failed, parked int
+	// Результат записывается и после отмены контекста, чтобы опубликованные события не повторились
+	ctx = context.WithoutCancel(ctx)
+	err = pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+		failedUsers := make(map[string]bool)
+		var sentIDs []int64
+		for i, m := range messages {
+			if i >= len(errs) || errs[i] == nil {
+				sentIDs = append(sentIDs, m.ID)
+				continue
+			}
+
+			failed++
+			if failedUsers[m.UserID] {
+				continue
+			}
+			failedUsers[m.UserID] = true
+
+			var retryAt, failedAt *time.Time
+			now := time.Now()
+			if attempt := m.Attempts + 1; attempt < maxAttempts {
+				next := now.Add(min(outboxRetryDelay<<min(attempt-1, 20), outboxMaxRetryDelay))
+				retryAt = &next
+			} else {
+				failedAt = &now
+				parked++
+			}
+			if _, err := tx.Exec(ctx, failedQuery, m.ID, errs[i].Error(), retryAt, failedAt); err != nil {
+				return fmt.Errorf("failed to update outbox event: %w", err)
+			}
+		}
+		if _, err := tx.Exec(ctx, sentQuery, sentIDs); err != nil {
+			return fmt.Errorf("failed to mark outbox events sent: %w", err)
+		}
+		sent = len(sentIDs)
+		return nil
+	})
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return sent, failed, parked, nil
+}
+
+// DeleteSent удаляет события, отправленные раньше before
+func (r *OutboxRepository) DeleteSent(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM outbox WHERE sent_at IS NOT NULL AND sent_at < $1`
+
+	tag, err := r.db.Pool.Exec(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sent outbox events: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
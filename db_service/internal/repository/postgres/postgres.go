@@ -5,6 +5,7 @@ import (
     "fmt"
     "log"
 
+    "github.com/jackc/pgx/v5"
     "github.com/jackc/pgx/v5/pgxpool"
 	"github.com/bagdasarian/checklist-app/db_service/config"
 )
@@ -34,6 +35,24 @@ func New(ctx context.Context, cfg *config.Config) (*Postgres, error) {
     return &Postgres{Pool: pool}, nil
 }
 
+// WithTx выполняет fn в транзакции: коммит при успехе, откат при ошибке
+func (p *Postgres) WithTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+    tx, err := p.Pool.Begin(ctx)
+    if err != nil {
+        return fmt.Errorf("failed to begin transaction: %w", err)
+    }
+    defer tx.Rollback(ctx)
+
+    if err := fn(tx); err != nil {
+        return err
+    }
+
+    if err := tx.Commit(ctx); err != nil {
+        return fmt.Errorf("failed to commit transaction: %w", err)
+    }
+    return nil
+}
+
 func (p *Postgres) Close() {
     if p.Pool != nil {
         p.Pool.Close()
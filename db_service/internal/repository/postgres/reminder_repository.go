@@ -0,0 +1,266 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Статусы напоминания
+const (
+	ReminderPending   = "pending"
+	ReminderSent      = "sent"
+	ReminderFailed    = "failed"
+	ReminderCancelled = "cancelled"
+)
+
+// Задержка перед повторной попыткой отправки удваивается с каждой попыткой, но не больше часа
+const (
+	reminderRetryDelay    = time.Minute
+	reminderMaxRetryDelay = time.Hour
+)
+
+// На сколько напоминание занимается на время отправки; больше таймаута любого способа доставки
+const reminderLease = 5 * time.Minute
+
+// reminderFireAt - время срабатывания напоминания; в запросах task_reminders имеет псевдоним r, tasks - t
+const reminderFireAt = `COALESCE(r.remind_at, t.due_at - r.offset_seconds * INTERVAL '1 second')`
+
+const reminderColumns = `r.id, r.task_id, r.user_id, r.remind_at, r.offset_seconds, ` + reminderFireAt + `,
+        r.status, r.attempts, r.last_error, r.sent_at, r.created_at`
+
+type ReminderRepository struct {
+	db *Postgres
+}
+
+func NewReminderRepository(db *Postgres) *ReminderRepository {
+	return &ReminderRepository{db: db}
+}
+
+// CreateReminder создает напоминание о задаче пользователя
+func (r *ReminderRepository) CreateReminder(ctx context.Context, req *pb.CreateReminderRequest) (*pb.Reminder, error) {
+	query := `
+        WITH r AS (
+            INSERT INTO task_reminders (task_id, user_id, remind_at, offset_seconds)
+            SELECT id, user_id, $3::timestamptz, $4::bigint
+            FROM tasks
+            WHERE id = $1 AND user_id = $2
+            RETURNING *
+        )
+        SELECT ` + reminderColumns + `
+        FROM r
+        JOIN tasks t ON t.id = r.task_id
+    `
+
+	reminder, err := scanReminder(r.db.Pool.QueryRow(ctx, query,
+		req.TaskId, req.UserId, timestampToTime(req.RemindAt), req.OffsetSeconds))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reminder: %w", err)
+	}
+	return reminder, nil
+}
+
+// GetReminders возвращает напоминания задачи в порядке срабатывания
+func (r *ReminderRepository) GetReminders(ctx context.Context, taskID, userID string) ([]*pb.Reminder, error) {
+	taskQuery := `SELECT EXISTS(SELECT 1 FROM tasks WHERE id = $1 AND user_id = $2)`
+	query := `
+        SELECT ` + reminderColumns + `
+        FROM task_reminders r
+        JOIN tasks t ON t.id = r.task_id
+        WHERE r.task_id = $1 AND r.user_id = $2
+        ORDER BY ` + reminderFireAt + ` NULLS LAST, r.created_at
+    `
+
+	var exists bool
+	if err := r.db.Pool.QueryRow(ctx, taskQuery, taskID, userID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	if !exists {
+		return nil, ErrTaskNotFound
+	}
+
+	rows, err := r.db.Pool.Query(ctx, query, taskID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var reminders []*pb.Reminder
+	for rows.Next() {
+		reminder, err := scanReminder(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reminder: %w", err)
+		}
+		reminders = append(reminders, reminder)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read reminders: %w", err)
+	}
+
+	return reminders, nil
+}
+
+// DeleteReminder удаляет напоминание задачи
+func (r *ReminderRepository) DeleteReminder(ctx context.Context, reminderID, taskID, userID string) (bool, error) {
+	query := `
+        DELETE FROM task_reminders
+        WHERE id = $1 AND task_id = $2 AND user_id = $3
+    `
+
+	result, err := r.db.Pool.Exec(ctx, query, reminderID, taskID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete reminder: %w", err)
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+// CancelStaleReminders отменяет наступившие напоминания выполненных и архивных задач
+func (r *ReminderRepository) CancelStaleReminders(ctx context.Context) (int, error) {
+	query := `
+        UPDATE task_reminders r
+        SET status = 'cancelled'
+        FROM tasks t
+        WHERE t.id = r.task_id AND r.status = 'pending'
+            AND (t.completed OR t.archived_at IS NOT NULL)
+            AND ` + reminderFireAt + ` <= NOW()
+    `
+
+	result, err := r.db.Pool.Exec(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel reminders: %w", err)
+	}
+	return int(result.RowsAffected()), nil
+}
+
+// ProcessDueReminders отправляет до limit наступивших напоминаний по одному, вызывая для каждого fn.
+// Напоминание сначала занимается в отдельной короткой транзакции (FOR UPDATE SKIP LOCKED): retry_at
+// переносится на reminderLease вперед, поэтому другой экземпляр не выберет его, пока идет отправка.
+// fn вызывается вне транзакции, а его результат сразу записывается, поэтому ошибка на следующем
+// напоминании не отменяет уже отправленные, и после перезапуска отправленные не повторяются.
+// Если сервис остановился между отправкой и записью результата, напоминание будет отправлено
+// повторно после окончания аренды. Если fn вернул ошибку, попытка повторяется позже,
+// после maxAttempts попыток напоминание получает статус failed.
+// Возвращает число отправленных и окончательно не отправленных напоминаний.
+func (r *ReminderRepository) ProcessDueReminders(ctx context.Context, limit, maxAttempts int, fn func(reminder *pb.Reminder, task *pb.DbTask) error) (int, int, error) {
+	updateQuery := `
+        UPDATE task_reminders
+        SET status = $2, attempts = attempts + 1, last_error = $3, retry_at = $4, sent_at = $5
+        WHERE id = $1
+    `
+
+	var sent, failed int
+	for i := 0; i < limit && ctx.Err() == nil; i++ {
+		reminder, task, err := r.claimDueReminder(ctx)
+		if err != nil {
+			return sent, failed, err
+		}
+		if reminder == nil {
+			break
+		}
+
+		status := ReminderSent
+		var lastError *string
+		var retryAt, sentAt *time.Time
+		if err := fn(reminder, task); err != nil {
+			message := err.Error()
+			lastError = &message
+			status = ReminderFailed
+			if attempt := int(reminder.Attempts) + 1; attempt < maxAttempts {
+				status = ReminderPending
+				next := time.Now().Add(min(reminderRetryDelay<<(attempt-1), reminderMaxRetryDelay))
+				retryAt = &next
+			}
+		} else {
+			now := time.Now()
+			sentAt = &now
+		}
+
+		// Результат записывается и после отмены контекста, чтобы отправленное напоминание не повторилось
+		if _, err := r.db.Pool.Exec(context.WithoutCancel(ctx), updateQuery, reminder.Id, status, lastError, retryAt, sentAt); err != nil {
+			return sent, failed, fmt.Errorf("failed to update reminder: %w", err)
+		}
+		switch status {
+		case ReminderSent:
+			sent++
+		case ReminderFailed:
+			failed++
+		}
+	}
+
+	return sent, failed, nil
+}
+
+// claimDueReminder занимает одно наступившее напоминание на reminderLease и возвращает его вместе с задачей.
+// Если наступивших напоминаний нет, возвращает nil.
+func (r *ReminderRepository) claimDueReminder(ctx context.Context) (*pb.Reminder, *pb.DbTask, error) {
+	dueQuery := `
+        SELECT ` + reminderColumns + `
+        FROM task_reminders r
+        JOIN tasks t ON t.id = r.task_id
+        WHERE r.status = 'pending'
+            AND ` + reminderFireAt + ` <= NOW()
+            AND (r.retry_at IS NULL OR r.retry_at <= NOW())
+            AND t.completed IS NOT TRUE AND t.archived_at IS NULL
+        ORDER BY ` + reminderFireAt + `
+        LIMIT 1
+        FOR UPDATE OF r SKIP LOCKED
+    `
+	leaseQuery := `UPDATE task_reminders SET retry_at = $2 WHERE id = $1`
+	taskQuery := `SELECT ` + taskColumns + ` FROM tasks WHERE id = $1`
+
+	var reminder *pb.Reminder
+	var task *pb.DbTask
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		reminder, err = scanReminder(tx.QueryRow(ctx, dueQuery))
+		if errors.Is(err, pgx.ErrNoRows) {
+			reminder = nil
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get due reminder: %w", err)
+		}
+
+		task, err = scanTask(tx.QueryRow(ctx, taskQuery, reminder.TaskId))
+		if err != nil {
+			return fmt.Errorf("failed to get task: %w", err)
+		}
+		if _, err := tx.Exec(ctx, leaseQuery, reminder.Id, time.Now().Add(reminderLease)); err != nil {
+			return fmt.Errorf("failed to claim reminder: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return reminder, task, nil
+}
+
+func scanReminder(row pgx.Row) (*pb.Reminder, error) {
+	var reminder pb.Reminder
+	var remindAt, fireAt, sentAt *time.Time
+	var lastError *string
+	var createdAt time.Time
+	err := row.Scan(&reminder.Id, &reminder.TaskId, &reminder.UserId, &remindAt, &reminder.OffsetSeconds,
+		&fireAt, &reminder.Status, &reminder.Attempts, &lastError, &sentAt, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	reminder.RemindAt = convertToTimestamp(remindAt)
+	reminder.FireAt = convertToTimestamp(fireAt)
+	reminder.SentAt = convertToTimestamp(sentAt)
+	reminder.CreatedAt = timestamppb.New(createdAt)
+	if lastError != nil {
+		reminder.LastError = *lastError
+	}
+	return &reminder, nil
+}
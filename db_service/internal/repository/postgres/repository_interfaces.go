@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
 )
@@ -14,9 +15,88 @@ type UserRepositoryInterface interface {
 
 type TaskRepositoryInterface interface {
 	CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.DbTask, error)
-	GetTasks(ctx context.Context, req *pb.GetTasksRequest) ([]*pb.DbTask, int32, error)
-	DeleteTask(ctx context.Context, taskID, userID string) (bool, error)
-	CompleteTask(ctx context.Context, taskID, userID string) (*pb.DbTask, error)
+	GetTasks(ctx context.Context, req *pb.GetTasksRequest, due DueRange) ([]*pb.DbTask, int32, error)
+	GetTask(ctx context.Context, taskID, userID string) (*pb.DbTask, error)
+	GetTaskByICalUID(ctx context.Context, icalUID, userID string) (*pb.DbTask, error)
+	DeleteTask(ctx context.Context, taskID, userID string, expectedVersion int64) (bool, error)
+	CompleteTask(ctx context.Context, taskID, userID string, expectedVersion int64) (*pb.DbTask, error)
+	UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.DbTask, error)
+	GetTaskHistory(ctx context.Context, req *pb.GetTaskHistoryRequest) ([]*pb.TaskRevision, int32, error)
+	RevertTask(ctx context.Context, taskID, userID string, revision int32, expectedVersion int64) (*pb.DbTask, error)
+	ArchiveTask(ctx context.Context, taskID, userID string, expectedVersion int64) (*pb.DbTask, error)
+	UnarchiveTask(ctx context.Context, taskID, userID string, expectedVersion int64) (*pb.DbTask, error)
+	ExportTasks(ctx context.Context, userID string, fn func(task *pb.DbTask, listName string) error) error
+	ImportTasks(ctx context.Context, req *pb.ImportTasksRequest) (*ImportResult, error)
+	ArchiveExpiredTasks(ctx context.Context, userID string, archiveAfterDays int32) (int, error)
 	InvalidateCache(ctx context.Context, userID string) error
 }
 
+type ListRepositoryInterface interface {
+	CreateList(ctx context.Context, userID, name string) (*pb.TaskList, error)
+	GetLists(ctx context.Context, userID string) ([]*pb.TaskList, error)
+	DeleteList(ctx context.Context, listID, userID string) (bool, error)
+}
+
+type TemplateRepositoryInterface interface {
+	CreateTemplate(ctx context.Context, req *pb.CreateTemplateRequest) (*pb.TaskTemplate, error)
+	GetTemplates(ctx context.Context, userID string) ([]*pb.TaskTemplate, error)
+	GetTemplate(ctx context.Context, templateID, userID string) (*pb.TaskTemplate, error)
+	UpdateTemplate(ctx context.Context, req *pb.UpdateTemplateRequest) (*pb.TaskTemplate, error)
+	DeleteTemplate(ctx context.Context, templateID, userID string) (bool, error)
+	InstantiateTemplate(ctx context.Context, req *pb.InstantiateTemplateRequest) ([]*pb.DbTask, *pb.TaskList, error)
+	SaveListAsTemplate(ctx context.Context, req *pb.SaveListAsTemplateRequest) (*pb.TaskTemplate, error)
+}
+
+type SettingsRepositoryInterface interface {
+	GetSettings(ctx context.Context, userID string) (*pb.UserSettings, error)
+	UpdateSettings(ctx context.Context, req *pb.UpdateSettingsRequest) (*pb.UserSettings, error)
+}
+
+type StatsRepositoryInterface interface {
+	GetStats(ctx context.Context, userID string, loc *time.Location, weekStart time.Weekday, now time.Time) (*pb.TaskStats, error)
+}
+
+type ArchivePolicyRepositoryInterface interface {
+	GetArchivePolicy(ctx context.Context, userID string) (*pb.ArchivePolicy, error)
+	SetArchivePolicy(ctx context.Context, req *pb.SetArchivePolicyRequest) (*pb.ArchivePolicy, error)
+	GetEnabledArchivePolicies(ctx context.Context) ([]*pb.ArchivePolicy, error)
+}
+
+type CalendarTokenRepositoryInterface interface {
+	GetCalendarToken(ctx context.Context, userID string) (*pb.CalendarToken, error)
+	ResetCalendarToken(ctx context.Context, userID string) (*pb.CalendarToken, error)
+	GetCalendarTokenOwner(ctx context.Context, token string) (string, error)
+}
+
+type WebhookRepositoryInterface interface {
+	CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.Webhook, error)
+	GetWebhooks(ctx context.Context, userID string) ([]*pb.Webhook, error)
+	GetWebhook(ctx context.Context, webhookID, userID string) (*pb.Webhook, error)
+	UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.Webhook, error)
+	DeleteWebhook(ctx context.Context, webhookID, userID string) (bool, error)
+	GetWebhookDeliveries(ctx context.Context, req *pb.GetWebhookDeliveriesRequest) ([]*pb.WebhookDelivery, error)
+	RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.WebhookDelivery, error)
+	EnqueueWebhookDeliveries(ctx context.Context, req *pb.EnqueueWebhookDeliveriesRequest) (int, error)
+	ClaimWebhookDeliveries(ctx context.Context, limit, leaseSeconds int32) ([]*pb.ClaimedWebhookDelivery, error)
+	CompleteWebhookDelivery(ctx context.Context, req *pb.CompleteWebhookDeliveryRequest) (*pb.WebhookDelivery, bool, error)
+}
+
+type ReminderRepositoryInterface interface {
+	CreateReminder(ctx context.Context, req *pb.CreateReminderRequest) (*pb.Reminder, error)
+	GetReminders(ctx context.Context, taskID, userID string) ([]*pb.Reminder, error)
+	DeleteReminder(ctx context.Context, reminderID, taskID, userID string) (bool, error)
+	CancelStaleReminders(ctx context.Context) (int, error)
+	ProcessDueReminders(ctx context.Context, limit, maxAttempts int, fn func(reminder *pb.Reminder, task *pb.DbTask) error) (int, int, error)
+}
+
+type DigestRepositoryInterface interface {
+	GetDigestSettings(ctx context.Context, userID string) (*pb.DigestSettings, error)
+	SetDigestSettings(ctx context.Context, req *pb.SetDigestSettingsRequest) (*pb.DigestSettings, error)
+	GetDigestTasks(ctx context.Context, userID string, yesterdayStart, dayStart, dayEnd time.Time) (*DigestTasks, error)
+	ProcessDueDigests(ctx context.Context, limit int, fn func(settings *pb.DigestSettings, date string) error) (int, int, error)
+}
+
+type OutboxRepositoryInterface interface {
+	ProcessPending(ctx context.Context, limit, maxAttempts int, fn func(messages []OutboxMessage) []error) (int, int, int, error)
+	DeleteSent(ctx context.Context, before time.Time) (int64, error)
+}
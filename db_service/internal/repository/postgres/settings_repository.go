@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Настройки пользователя по умолчанию
+const (
+	DefaultTimeZone  = "UTC"
+	DefaultLocale    = "en"
+	DefaultWeekStart = int32(time.Monday)
+	DefaultTaskSort  = pb.TaskSort_TASK_SORT_CREATED_DESC
+)
+
+// taskSortNames - значения колонки default_sort
+var taskSortNames = map[pb.TaskSort]string{
+	pb.TaskSort_TASK_SORT_CREATED_DESC: "created_desc",
+	pb.TaskSort_TASK_SORT_CREATED_ASC:  "created_asc",
+	pb.TaskSort_TASK_SORT_DUE_ASC:      "due_asc",
+	pb.TaskSort_TASK_SORT_TITLE_ASC:    "title_asc",
+}
+
+const settingsColumns = `user_id, time_zone, locale, week_start, default_list_id, default_sort, updated_at`
+
+type SettingsRepository struct {
+	db *Postgres
+}
+
+func NewSettingsRepository(db *Postgres) *SettingsRepository {
+	return &SettingsRepository{db: db}
+}
+
+// GetSettings возвращает настройки пользователя; если они не заданы, возвращаются настройки по умолчанию
+func (r *SettingsRepository) GetSettings(ctx context.Context, userID string) (*pb.UserSettings, error) {
+	query := `
+        SELECT ` + settingsColumns + `
+        FROM user_settings
+        WHERE user_id = $1
+    `
+
+	settings, err := scanSettings(r.db.Pool.QueryRow(ctx, query, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &pb.UserSettings{
+			UserId:      userID,
+			TimeZone:    DefaultTimeZone,
+			Locale:      DefaultLocale,
+			WeekStart:   DefaultWeekStart,
+			DefaultSort: DefaultTaskSort,
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings: %w", err)
+	}
+	return settings, nil
+}
+
+// UpdateSettings изменяет заданные в запросе поля настроек пользователя.
+// Список по умолчанию должен принадлежать пользователю.
+func (r *SettingsRepository) UpdateSettings(ctx context.Context, req *pb.UpdateSettingsRequest) (*pb.UserSettings, error) {
+	query := `
+        INSERT INTO user_settings (user_id, time_zone, locale, week_start, default_list_id, default_sort)
+        VALUES ($1, COALESCE($2::text, 'UTC'), COALESCE($3::text, 'en'), COALESCE($4::smallint, 1),
+            NULLIF($5::text, '')::uuid, COALESCE($6::text, 'created_desc'))
+        ON CONFLICT (user_id) DO UPDATE
+        SET time_zone = COALESCE($2::text, user_settings.time_zone),
+            locale = COALESCE($3::text, user_settings.locale),
+            week_start = COALESCE($4::smallint, user_settings.week_start),
+            default_list_id = CASE WHEN $5::text IS NULL THEN user_settings.default_list_id
+                ELSE NULLIF($5::text, '')::uuid END,
+            default_sort = COALESCE($6::text, user_settings.default_sort),
+            updated_at = NOW()
+        RETURNING ` + settingsColumns
+
+	var defaultSort *string
+	if req.DefaultSort != nil {
+		name, ok := taskSortNames[*req.DefaultSort]
+		if !ok {
+			return nil, fmt.Errorf("unknown task sort: %v", *req.DefaultSort)
+		}
+		defaultSort = &name
+	}
+
+	var settings *pb.UserSettings
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		if req.DefaultListId != nil && *req.DefaultListId != "" {
+			if err := checkListOwner(ctx, tx, *req.DefaultListId, req.UserId); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		settings, err = scanSettings(tx.QueryRow(ctx, query,
+			req.UserId, req.TimeZone, req.Locale, req.WeekStart, req.DefaultListId, defaultSort))
+		if err != nil {
+			return fmt.Errorf("failed to update settings: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func scanSettings(row pgx.Row) (*pb.UserSettings, error) {
+	var settings pb.UserSettings
+	var weekStart int16
+	var defaultListID *string
+	var defaultSort string
+	var updatedAt time.Time
+	err := row.Scan(&settings.UserId, &settings.TimeZone, &settings.Locale, &weekStart,
+		&defaultListID, &defaultSort, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	settings.WeekStart = int32(weekStart)
+	if defaultListID != nil {
+		settings.DefaultListId = *defaultListID
+	}
+	settings.DefaultSort = DefaultTaskSort
+	for sort, name := range taskSortNames {
+		if name == defaultSort {
+			settings.DefaultSort = sort
+		}
+	}
+	settings.UpdatedAt = timestamppb.New(updatedAt)
+	return &settings, nil
+}
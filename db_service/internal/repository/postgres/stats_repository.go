@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/internal/usertime"
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Число периодов в статистике
+const (
+	statsDays   = 30
+	statsWeeks  = 12
+	statsMonths = 12
+)
+
+// localCompletedDay - дата выполнения задачи в часовом поясе $2
+const localCompletedDay = `(completed_at AT TIME ZONE $2)::date`
+
+type StatsRepository struct {
+	db    *Postgres
+	redis *Redis
+}
+
+func NewStatsRepository(db *Postgres, redis *Redis) *StatsRepository {
+	return &StatsRepository{db: db, redis: redis}
+}
+
+// GetStats считает статистику задач пользователя в часовом поясе loc.
+// Результат кэшируется в Redis под ключом задач пользователя, поэтому сбрасывается
+// при любом изменении задач, а локальная дата в ключе не дает отдать вчерашнюю статистику.
+func (r *StatsRepository) GetStats(ctx context.Context, userID string, loc *time.Location, weekStart time.Weekday, now time.Time) (*pb.TaskStats, error) {
+	today := usertime.StartOfDay(now, loc)
+	cacheKey := fmt.Sprintf("tasks:user:%s:stats:%s:%s:%d", userID, today.Format(usertime.DateLayout), loc, weekStart)
+
+	if r.redis != nil && r.redis.Client != nil {
+		cachedData, err := r.redis.Client.Get(ctx, cacheKey).Result()
+		if err == nil {
+			var stats pb.TaskStats
+			if err := json.Unmarshal([]byte(cachedData), &stats); err == nil {
+				fmt.Printf("[REDIS CACHE HIT] UserID: %s | Stats | Key: %s\n", userID, cacheKey)
+				return &stats, nil
+			}
+		} else if err != redis.Nil {
+			fmt.Printf("[REDIS ERROR] %v\n", err)
+		}
+	}
+
+	stats, err := r.queryStats(ctx, userID, loc, weekStart, today)
+	if err != nil {
+		return nil, err
+	}
+	stats.GeneratedAt = timestamppb.New(now)
+
+	if r.redis != nil && r.redis.Client != nil {
+		if data, err := json.Marshal(stats); err == nil {
+			if err := r.redis.Client.Set(ctx, cacheKey, data, r.redis.TTL).Err(); err != nil {
+				fmt.Printf("[REDIS WRITE ERROR] %v\n", err)
+			}
+		}
+	}
+
+	return stats, nil
+}
+
+func (r *StatsRepository) queryStats(ctx context.Context, userID string, loc *time.Location, weekStart time.Weekday, today time.Time) (*pb.TaskStats, error) {
+	summaryQuery := `
+        SELECT
+            COUNT(*) FILTER (WHERE completed IS NOT TRUE AND archived_at IS NULL)::int,
+            COUNT(*) FILTER (WHERE completed IS NOT TRUE AND archived_at IS NULL AND due_at < $2)::int,
+            COUNT(*) FILTER (WHERE completed)::int,
+            COALESCE(EXTRACT(EPOCH FROM AVG(completed_at - created_at) FILTER (WHERE completed)), 0)::float8
+        FROM tasks
+        WHERE user_id = $1
+    `
+	// Серия - дни подряд с выполненными задачами: у дней одной серии разность даты и номера строки одинакова
+	streakQuery := `
+        WITH days AS (
+            SELECT DISTINCT ` + localCompletedDay + ` AS day
+            FROM tasks
+            WHERE user_id = $1 AND completed AND completed_at IS NOT NULL
+        ), streaks AS (
+            SELECT MAX(day) AS last_day, COUNT(*) AS length
+            FROM (SELECT day, day - (ROW_NUMBER() OVER (ORDER BY day))::int AS grp FROM days) d
+            GROUP BY grp
+        )
+        SELECT
+            COALESCE(MAX(length) FILTER (WHERE last_day >= $3::date - 1), 0)::int,
+            COALESCE(MAX(length), 0)::int
+        FROM streaks
+    `
+
+	stats := &pb.TaskStats{TimeZone: loc.String()}
+	err := r.db.Pool.QueryRow(ctx, summaryQuery, userID, today).Scan(
+		&stats.OpenCount, &stats.OverdueCount, &stats.CompletedCount, &stats.AvgCompletionSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task stats: %w", err)
+	}
+
+	err = r.db.Pool.QueryRow(ctx, streakQuery, userID, loc.String(), today.Format(usertime.DateLayout)).Scan(
+		&stats.CurrentStreakDays, &stats.LongestStreakDays)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task streaks: %w", err)
+	}
+
+	weekBucket := fmt.Sprintf(`%s - ((EXTRACT(DOW FROM %s)::int - %d + 7) %% 7)`,
+		localCompletedDay, localCompletedDay, weekStart)
+	monthBucket := `date_trunc('month', completed_at AT TIME ZONE $2)::date`
+
+	firstDay := today.AddDate(0, 0, -(statsDays - 1))
+	if stats.Daily, err = r.completedBuckets(ctx, userID, loc, localCompletedDay, firstDay, today, "1 day"); err != nil {
+		return nil, err
+	}
+	week := usertime.StartOfWeek(today, loc, weekStart)
+	if stats.Weekly, err = r.completedBuckets(ctx, userID, loc, weekBucket, week.AddDate(0, 0, -7*(statsWeeks-1)), week, "1 week"); err != nil {
+		return nil, err
+	}
+	month := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, loc)
+	if stats.Monthly, err = r.completedBuckets(ctx, userID, loc, monthBucket, month.AddDate(0, -(statsMonths-1), 0), month, "1 month"); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// completedBuckets считает выполненные задачи по периодам с first по last включительно.
+// bucket - выражение начала периода для completed_at, периоды без задач возвращаются с нулем.
+func (r *StatsRepository) completedBuckets(ctx context.Context, userID string, loc *time.Location, bucket string, first, last time.Time, step string) ([]*pb.StatsBucket, error) {
+	query := `
+        WITH counts AS (
+            SELECT ` + bucket + ` AS bucket, COUNT(*) AS completed
+            FROM tasks
+            WHERE user_id = $1 AND completed AND completed_at >= $3
+            GROUP BY 1
+        )
+        SELECT to_char(s.bucket, 'YYYY-MM-DD'), COALESCE(c.completed, 0)::int
+        FROM generate_series($4::date::timestamp, $5::date::timestamp, $6::interval) AS s(bucket)
+        LEFT JOIN counts c ON c.bucket = s.bucket::date
+        ORDER BY s.bucket
+    `
+
+	rows, err := r.db.Pool.Query(ctx, query, userID, loc.String(), first,
+		first.Format(usertime.DateLayout), last.Format(usertime.DateLayout), step)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get completed tasks by period: %w", err)
+	}
+	defer rows.Close()
+
+	var buckets []*pb.StatsBucket
+	for rows.Next() {
+		var b pb.StatsBucket
+		if err := rows.Scan(&b.Start, &b.Completed); err != nil {
+			return nil, fmt.Errorf("failed to scan stats bucket: %w", err)
+		}
+		buckets = append(buckets, &b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read stats buckets: %w", err)
+	}
+
+	return buckets, nil
+}
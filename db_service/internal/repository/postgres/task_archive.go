@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrTaskArchived    = errors.New("task is already archived")
+	ErrTaskNotArchived = errors.New("task is not archived")
+	ErrSubtaskArchive  = errors.New("subtasks are archived together with their parent task")
+)
+
+// ArchiveTask переносит задачу в архив вместе с подзадачами.
+// Если expectedVersion не 0, версия задачи должна совпадать.
+func (r *TaskRepository) ArchiveTask(ctx context.Context, taskID, userID string, expectedVersion int64) (*pb.DbTask, error) {
+	task, err := r.setArchived(ctx, taskID, userID, expectedVersion, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.InvalidateCache(ctx, userID); err == nil {
+		fmt.Printf("[CACHE INVALIDATED] UserID: %s | Reason: Task archived\n", userID)
+	}
+
+	return task, nil
+}
+
+// UnarchiveTask возвращает задачу из архива вместе с подзадачами.
+// Если expectedVersion не 0, версия задачи должна совпадать.
+func (r *TaskRepository) UnarchiveTask(ctx context.Context, taskID, userID string, expectedVersion int64) (*pb.DbTask, error) {
+	task, err := r.setArchived(ctx, taskID, userID, expectedVersion, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.InvalidateCache(ctx, userID); err == nil {
+		fmt.Printf("[CACHE INVALIDATED] UserID: %s | Reason: Task unarchived\n", userID)
+	}
+
+	return task, nil
+}
+
+// ArchiveExpiredTasks переносит в архив задачи верхнего уровня, выполненные больше
+// archiveAfterDays дней назад, вместе с их подзадачами. Возвращает число архивированных задач.
+// Задачи, заблокированные другими транзакциями, пропускаются до следующего запуска; подзадачи
+// блокируются только после родителя и ждут блокировки, чтобы не попасть в архив без него.
+func (r *TaskRepository) ArchiveExpiredTasks(ctx context.Context, userID string, archiveAfterDays int32) (int, error) {
+	expiredQuery := `
+        SELECT ` + taskColumns + `
+        FROM tasks
+        WHERE user_id = $1 AND parent_id IS NULL AND completed = true AND archived_at IS NULL
+          AND completed_at < NOW() - make_interval(days => $2)
+        FOR UPDATE SKIP LOCKED
+    `
+	subtasksQuery := `
+        SELECT ` + taskColumns + `
+        FROM tasks
+        WHERE user_id = $1 AND parent_id = ANY($2::uuid[]) AND archived_at IS NULL
+        FOR UPDATE
+    `
+
+	var archived []*pb.DbTask
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		tasks, err := queryTasks(ctx, tx, expiredQuery, userID, archiveAfterDays)
+		if err != nil {
+			return fmt.Errorf("failed to get expired tasks: %w", err)
+		}
+		if len(tasks) == 0 {
+			return nil
+		}
+
+		parentIDs := make([]string, len(tasks))
+		for i, task := range tasks {
+			parentIDs[i] = task.Id
+		}
+		subtasks, err := queryTasks(ctx, tx, subtasksQuery, userID, parentIDs)
+		if err != nil {
+			return fmt.Errorf("failed to get subtasks: %w", err)
+		}
+
+		archived, err = setTasksArchived(ctx, tx, "", userID, append(tasks, subtasks...), true)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(archived) > 0 {
+		if err := r.InvalidateCache(ctx, userID); err == nil {
+			fmt.Printf("[CACHE INVALIDATED] UserID: %s | Reason: %d tasks auto-archived\n", userID, len(archived))
+		}
+	}
+
+	return len(archived), nil
+}
+
+// setArchived архивирует или разархивирует задачу верхнего уровня вместе с подзадачами
+func (r *TaskRepository) setArchived(ctx context.Context, taskID, userID string, expectedVersion int64, archive bool) (*pb.DbTask, error) {
+	subtasksQuery := `
+        SELECT ` + taskColumns + `
+        FROM tasks
+        WHERE parent_id = $1 AND user_id = $2 AND (archived_at IS NULL) = $3
+        FOR UPDATE
+    `
+
+	var task *pb.DbTask
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		oldTask, err := lockTaskVersion(ctx, tx, taskID, userID, expectedVersion)
+		if err != nil {
+			return err
+		}
+		if oldTask.ParentId != "" {
+			return ErrSubtaskArchive
+		}
+		if archive && oldTask.ArchivedAt != nil {
+			return ErrTaskArchived
+		}
+		if !archive && oldTask.ArchivedAt == nil {
+			return ErrTaskNotArchived
+		}
+
+		subtasks, err := queryTasks(ctx, tx, subtasksQuery, taskID, userID, archive)
+		if err != nil {
+			return fmt.Errorf("failed to get subtasks: %w", err)
+		}
+
+		tasks, err := setTasksArchived(ctx, tx, userID, userID, append([]*pb.DbTask{oldTask}, subtasks...), archive)
+		if err != nil {
+			return err
+		}
+		for _, t := range tasks {
+			if t.Id == taskID {
+				task = t
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// setTasksArchived меняет archived_at у заблокированных задач и записывает их ревизии.
+// Пустой actorID означает изменение, сделанное сервисом (автоархивация).
+func setTasksArchived(ctx context.Context, tx pgx.Tx, actorID, userID string, oldTasks []*pb.DbTask, archive bool) ([]*pb.DbTask, error) {
+	query := `
+        UPDATE tasks
+        SET archived_at = CASE WHEN $3 THEN NOW() END, version = version + 1
+        WHERE id = ANY($1::uuid[]) AND user_id = $2
+        RETURNING ` + taskColumns
+
+	action := revisionActionUnarchived
+	if archive {
+		action = revisionActionArchived
+	}
+
+	ids := make([]string, len(oldTasks))
+	byID := make(map[string]*pb.DbTask, len(oldTasks))
+	for i, task := range oldTasks {
+		ids[i] = task.Id
+		byID[task.Id] = task
+	}
+
+	tasks, err := queryTasks(ctx, tx, query, ids, userID, archive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update archived state: %w", err)
+	}
+	for _, task := range tasks {
+		if err := recordRevision(ctx, tx, action, actorID, byID[task.Id], task); err != nil {
+			return nil, err
+		}
+	}
+	return tasks, nil
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/jackc/pgx/v5"
+)
+
+// Сколько строк читается из курсора за один FETCH
+const exportFetchSize = 100
+
+// ExportTasks читает все задачи пользователя (включая выполненные и архивные) через
+// серверный курсор и передает их в fn по одной, не загружая выборку в память целиком.
+// Задачи сгруппированы по спискам (сначала задачи без списка), подзадачи идут сразу
+// после родительской задачи. Выгрузка выполняется в read-only транзакции
+// REPEATABLE READ, поэтому видит согласованный снимок данных.
+func (r *TaskRepository) ExportTasks(ctx context.Context, userID string, fn func(task *pb.DbTask, listName string) error) error {
+	declareQuery := `
+        DECLARE export_tasks NO SCROLL CURSOR FOR
+        SELECT t.id, t.user_id, t.title, t.description, t.completed, t.created_at, t.completed_at, t.version,
+            t.due_at, t.tags, t.parent_id, t.list_id, t.archived_at, t.ical_uid, COALESCE(l.name, '')
+        FROM tasks t
+        LEFT JOIN tasks p ON p.id = t.parent_id
+        LEFT JOIN task_lists l ON l.id = t.list_id
+        LEFT JOIN task_lists g ON g.id = CASE WHEN t.parent_id IS NULL THEN t.list_id ELSE p.list_id END
+        WHERE t.user_id = $1
+        ORDER BY g.created_at NULLS FIRST, g.id, COALESCE(p.created_at, t.created_at),
+            COALESCE(t.parent_id, t.id), t.parent_id IS NOT NULL, t.created_at
+    `
+	fetchQuery := fmt.Sprintf(`FETCH %d FROM export_tasks`, exportFetchSize)
+
+	tx, err := r.db.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, declareQuery, userID); err != nil {
+		return fmt.Errorf("failed to declare export cursor: %w", err)
+	}
+
+	for {
+		rows, err := tx.Query(ctx, fetchQuery)
+		if err != nil {
+			return fmt.Errorf("failed to fetch tasks: %w", err)
+		}
+
+		fetched := 0
+		for rows.Next() {
+			fetched++
+			task, listName, err := scanExportedTask(rows)
+			if err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan task: %w", err)
+			}
+			if err := fn(task, listName); err != nil {
+				rows.Close()
+				return err
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to read tasks: %w", err)
+		}
+
+		if fetched < exportFetchSize {
+			return nil
+		}
+	}
+}
+
+// scanExportedTask читает задачу и название ее списка
+func scanExportedTask(rows pgx.Rows) (*pb.DbTask, string, error) {
+	var listName string
+	task, err := scanTask(rowWithExtra{rows: rows, extra: []any{&listName}})
+	if err != nil {
+		return nil, "", err
+	}
+	return task, listName, nil
+}
+
+// rowWithExtra дописывает к Scan дополнительные колонки после taskColumns
+type rowWithExtra struct {
+	rows  pgx.Rows
+	extra []any
+}
+
+func (r rowWithExtra) Scan(dest ...any) error {
+	return r.rows.Scan(append(dest, r.extra...)...)
+}
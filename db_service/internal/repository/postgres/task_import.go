@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ImportResult - результат импорта задач
+type ImportResult struct {
+	Created      []*pb.DbTask
+	Updated      []*pb.DbTask
+	CreatedLists []*pb.TaskList
+}
+
+// ImportTasks создает задачи из импортированного файла в одной транзакции:
+// при любой ошибке не создается ни одной задачи. Списки из list_name ищутся
+// по имени среди списков пользователя и создаются, если их нет.
+// Задача с ical_uid, который уже есть у пользователя, обновляется вместо создания
+// новой; если ее поля не изменились, она остается как есть. Если у элемента задана
+// expected_version, версия задачи проверяется под блокировкой (ErrVersionMismatch).
+func (r *TaskRepository) ImportTasks(ctx context.Context, req *pb.ImportTasksRequest) (*ImportResult, error) {
+	insertQuery := `
+        INSERT INTO tasks (user_id, title, description, completed, completed_at, due_at, tags, parent_id, list_id, ical_uid)
+        VALUES ($1, $2, $3, $4, CASE WHEN $4 THEN COALESCE($5, NOW()) END, $6, $7, $8, $9,
+            COALESCE(NULLIF($10, ''), gen_random_uuid()::text))
+        RETURNING ` + taskColumns
+	updateQuery := `
+        UPDATE tasks
+        SET title = $3, description = $4, completed = $5,
+            completed_at = CASE WHEN $5 THEN COALESCE($6, completed_at, NOW()) END,
+            due_at = $7, tags = $8, parent_id = $9, version = version + 1
+        WHERE id = $1 AND user_id = $2
+        RETURNING ` + taskColumns
+	findTaskQuery := `
+        SELECT ` + taskColumns + `
+        FROM tasks
+        WHERE user_id = $1 AND ical_uid = $2
+        FOR UPDATE
+    `
+	findListQuery := `
+        SELECT id FROM task_lists
+        WHERE user_id = $1 AND name = $2
+        ORDER BY created_at
+        LIMIT 1
+    `
+
+	result := &ImportResult{}
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		if req.ListId != "" {
+			if err := checkListOwner(ctx, tx, req.ListId, req.UserId); err != nil {
+				return err
+			}
+		}
+
+		listIDs := make(map[string]string)
+		resolveList := func(name string) (string, error) {
+			if name == "" {
+				return req.ListId, nil
+			}
+			if id, ok := listIDs[name]; ok {
+				return id, nil
+			}
+
+			var id string
+			err := tx.QueryRow(ctx, findListQuery, req.UserId, name).Scan(&id)
+			if errors.Is(err, pgx.ErrNoRows) {
+				list, err := insertList(ctx, tx, req.UserId, name)
+				if err != nil {
+					return "", err
+				}
+				result.CreatedLists = append(result.CreatedLists, list)
+				id = list.Id
+			} else if err != nil {
+				return "", fmt.Errorf("failed to find list: %w", err)
+			}
+			listIDs[name] = id
+			return id, nil
+		}
+
+		update := func(oldTask *pb.DbTask, item *pb.ImportTaskItem, parentID string) (*pb.DbTask, error) {
+			if parentID != "" && oldTask.ParentId == "" {
+				var hasSubtasks bool
+				err := tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM tasks WHERE parent_id = $1)`, oldTask.Id).Scan(&hasSubtasks)
+				if err != nil {
+					return nil, fmt.Errorf("failed to check subtasks: %w", err)
+				}
+				if hasSubtasks {
+					return nil, ErrNestedSubtask
+				}
+			}
+
+			task, err := scanTask(tx.QueryRow(ctx, updateQuery,
+				oldTask.Id, req.UserId, item.Title, item.Description, item.Completed, timestampToTime(item.CompletedAt),
+				timestampToTime(item.DueAt), nonNilTags(item.Tags), nullableString(parentID),
+			))
+			if err != nil {
+				return nil, fmt.Errorf("failed to update task %q: %w", item.Title, err)
+			}
+			if err := recordRevision(ctx, tx, revisionActionUpdated, req.UserId, oldTask, task); err != nil {
+				return nil, err
+			}
+			result.Updated = append(result.Updated, task)
+			return task, nil
+		}
+
+		importItem := func(item *pb.ImportTaskItem, parent *pb.DbTask) (*pb.DbTask, error) {
+			var parentID string
+			if parent != nil {
+				parentID = parent.Id
+			}
+
+			if item.IcalUid != "" {
+				oldTask, err := scanTask(tx.QueryRow(ctx, findTaskQuery, req.UserId, item.IcalUid))
+				if err == nil {
+					if item.ExpectedVersion != 0 && oldTask.Version != item.ExpectedVersion {
+						return nil, ErrVersionMismatch
+					}
+					if !importChangesTask(oldTask, item, parentID) {
+						return oldTask, nil
+					}
+					return update(oldTask, item, parentID)
+				}
+				if !errors.Is(err, pgx.ErrNoRows) {
+					return nil, fmt.Errorf("failed to find task by UID: %w", err)
+				}
+			}
+			// Задачу, которую ожидали изменить, успели удалить
+			if item.ExpectedVersion != 0 {
+				return nil, ErrVersionMismatch
+			}
+
+			// Подзадачи попадают в список родительской задачи
+			var listID string
+			if parent != nil {
+				listID = parent.ListId
+			} else {
+				var err error
+				if listID, err = resolveList(item.ListName); err != nil {
+					return nil, err
+				}
+			}
+
+			task, err := scanTask(tx.QueryRow(ctx, insertQuery,
+				req.UserId, item.Title, item.Description, item.Completed, timestampToTime(item.CompletedAt),
+				timestampToTime(item.DueAt), nonNilTags(item.Tags),
+				nullableString(parentID), nullableString(listID), item.IcalUid,
+			))
+			if err != nil {
+				return nil, fmt.Errorf("failed to import task %q: %w", item.Title, err)
+			}
+			if err := recordRevision(ctx, tx, revisionActionCreated, req.UserId, nil, task); err != nil {
+				return nil, err
+			}
+			result.Created = append(result.Created, task)
+			return task, nil
+		}
+
+		for _, item := range req.Items {
+			task, err := importItem(item, nil)
+			if err != nil {
+				return err
+			}
+			for _, subtask := range item.Subtasks {
+				if len(subtask.Subtasks) > 0 {
+					return ErrNestedSubtask
+				}
+				if _, err := importItem(subtask, task); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Created) > 0 || len(result.Updated) > 0 {
+		if err := r.InvalidateCache(ctx, req.UserId); err == nil {
+			fmt.Printf("[CACHE INVALIDATED] UserID: %s | Reason: %d tasks imported, %d updated\n",
+				req.UserId, len(result.Created), len(result.Updated))
+		}
+	}
+
+	return result, nil
+}
+
+// importChangesTask проверяет, отличается ли импортируемая задача от сохраненной
+func importChangesTask(task *pb.DbTask, item *pb.ImportTaskItem, parentID string) bool {
+	return task.Title != item.Title ||
+		task.Description != item.Description ||
+		task.Completed != item.Completed ||
+		(item.Completed && item.CompletedAt != nil && !sameTime(task.CompletedAt, item.CompletedAt)) ||
+		!sameTime(task.DueAt, item.DueAt) ||
+		!sameTags(task.Tags, item.Tags) ||
+		task.ParentId != parentID
+}
+
+// sameTags сравнивает теги без учета порядка: в .ics приоритет хранится отдельно от остальных тегов
+func sameTags(a, b []string) bool {
+	return slices.Equal(slices.Sorted(slices.Values(a)), slices.Sorted(slices.Values(b)))
+}
+
+// sameTime сравнивает время с точностью до секунды: в .ics доли секунды не передаются
+func sameTime(a, b *timestamppb.Timestamp) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.AsTime().Truncate(time.Second).Equal(b.AsTime().Truncate(time.Second))
+}
@@ -10,7 +10,13 @@ import (
 	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
 	"github.com/jackc/pgx/v5"
 	"github.com/redis/go-redis/v9"
-	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrTaskNotFound    = errors.New("task not found or access denied")
+	ErrVersionMismatch = errors.New("task version mismatch: the task was modified concurrently")
+	ErrParentNotFound  = errors.New("parent task not found or access denied")
+	ErrNestedSubtask   = errors.New("subtasks cannot have their own subtasks")
 )
 
 type TaskRepository struct {
@@ -27,39 +33,33 @@ func NewTaskRepository(db *Postgres, redis *Redis) *TaskRepository {
 
 // CreateTask создает новую задачу
 func (r *TaskRepository) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.DbTask, error) {
-	query := `
-        INSERT INTO tasks (user_id, title, description) 
-        VALUES ($1, $2, $3) 
-        RETURNING id, user_id, title, description, completed, created_at, completed_at
-    `
-
-	var task pb.DbTask
-	var createdAt time.Time
-	var completedAt *time.Time
-	err := r.db.Pool.QueryRow(ctx, query,
-		req.UserId, req.Title, req.Description,
-	).Scan(&task.Id, &task.UserId, &task.Title, &task.Description,
-		&task.Completed, &createdAt, &completedAt)
+	var task *pb.DbTask
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		if err := resolveTaskRefs(ctx, tx, req); err != nil {
+			return err
+		}
 
+		var err error
+		task, err = insertTask(ctx, tx, req)
+		if err != nil {
+			return err
+		}
+		return recordRevision(ctx, tx, revisionActionCreated, req.UserId, nil, task)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create task: %w", err)
-	}
-
-	task.CreatedAt = timestamppb.New(createdAt)
-	if completedAt != nil {
-		task.CompletedAt = timestamppb.New(*completedAt)
+		return nil, err
 	}
 
 	if err := r.InvalidateCache(ctx, req.UserId); err == nil {
 		fmt.Printf("[CACHE INVALIDATED] UserID: %s | Reason: Task created\n", req.UserId)
 	}
 
-	return &task, nil
+	return task, nil
 }
 
 // GetTasks возвращает список задач пользователя
-func (r *TaskRepository) GetTasks(ctx context.Context, req *pb.GetTasksRequest) ([]*pb.DbTask, int32, error) {
-	cacheKey := r.getCacheKey(req)
+func (r *TaskRepository) GetTasks(ctx context.Context, req *pb.GetTasksRequest, due DueRange) ([]*pb.DbTask, int32, error) {
+	cacheKey := r.getCacheKey(req, due)
 
 	if r.redis != nil && r.redis.Client != nil {
 		cachedData, err := r.redis.Client.Get(ctx, cacheKey).Result()
@@ -81,26 +81,38 @@ func (r *TaskRepository) GetTasks(ctx context.Context, req *pb.GetTasksRequest)
 		}
 	}
 
+	viewFilter := taskViewFilter(req.View, req.IncludeCompleted)
 	countQuery := `
         SELECT COUNT(*) FROM tasks 
-        WHERE user_id = $1 AND ($2 OR completed = false)
+        WHERE user_id = $1 AND ` + viewFilter + `
+          AND ($2::uuid IS NULL OR list_id = $2)
+          AND ($3::timestamptz IS NULL OR due_at >= $3)
+          AND ($4::timestamptz IS NULL OR due_at < $4)
+          AND (NOT $5::boolean OR due_at IS NULL)
     `
 	var totalCount int32
-	err := r.db.Pool.QueryRow(ctx, countQuery, req.UserId, req.IncludeCompleted).Scan(&totalCount)
+	listID := nullableString(req.ListId)
+	err := r.db.Pool.QueryRow(ctx, countQuery, req.UserId, listID,
+		due.From, due.To, due.WithoutDueDate).Scan(&totalCount)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count tasks: %w", err)
 	}
 
 	query := `
-        SELECT id, user_id, title, description, completed, created_at, completed_at 
+        SELECT ` + taskColumns + ` 
         FROM tasks 
-        WHERE user_id = $1 AND ($2 OR completed = false)
-        ORDER BY created_at DESC 
-        LIMIT $3 OFFSET $4
+        WHERE user_id = $1 AND ` + viewFilter + `
+          AND ($4::uuid IS NULL OR list_id = $4)
+          AND ($5::timestamptz IS NULL OR due_at >= $5)
+          AND ($6::timestamptz IS NULL OR due_at < $6)
+          AND (NOT $7::boolean OR due_at IS NULL)
+        ORDER BY ` + taskSortOrder(req.Sort) + ` 
+        LIMIT $2 OFFSET $3
     `
 
 	rows, err := r.db.Pool.Query(ctx, query,
-		req.UserId, req.IncludeCompleted, req.Limit, req.Offset,
+		req.UserId, req.Limit, req.Offset, listID,
+		due.From, due.To, due.WithoutDueDate,
 	)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get tasks: %w", err)
@@ -109,19 +121,11 @@ func (r *TaskRepository) GetTasks(ctx context.Context, req *pb.GetTasksRequest)
 
 	var tasks []*pb.DbTask
 	for rows.Next() {
-		var task pb.DbTask
-		var createdAt time.Time
-		var completedAt *time.Time
-		err := rows.Scan(&task.Id, &task.UserId, &task.Title, &task.Description,
-			&task.Completed, &createdAt, &completedAt)
+		task, err := scanTask(rows)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan task: %w", err)
 		}
-		task.CreatedAt = timestamppb.New(createdAt)
-		if completedAt != nil {
-			task.CompletedAt = timestamppb.New(*completedAt)
-		}
-		tasks = append(tasks, &task)
+		tasks = append(tasks, task)
 	}
 
 	if r.redis != nil && r.redis.Client != nil {
@@ -149,67 +153,325 @@ func (r *TaskRepository) GetTasks(ctx context.Context, req *pb.GetTasksRequest)
 	return tasks, totalCount, nil
 }
 
-func (r *TaskRepository) getCacheKey(req *pb.GetTasksRequest) string {
-	return fmt.Sprintf("tasks:user:%s:completed:%v:limit:%d:offset:%d",
-		req.UserId, req.IncludeCompleted, req.Limit, req.Offset)
+// Границы срока входят в ключ уже вычисленными, поэтому фильтр "сегодня" не берет из кэша вчерашний ответ
+func (r *TaskRepository) getCacheKey(req *pb.GetTasksRequest, due DueRange) string {
+	return fmt.Sprintf("tasks:user:%s:completed:%v:limit:%d:offset:%d:list:%s:view:%d:due:%s:sort:%d",
+		req.UserId, req.IncludeCompleted, req.Limit, req.Offset, req.ListId, req.View, due.cacheKey(), req.Sort)
+}
+
+// DueRange - фильтр задач по сроку: From <= due_at < To, пустая граница не ограничивает.
+// WithoutDueDate выбирает задачи без срока.
+type DueRange struct {
+	From           *time.Time
+	To             *time.Time
+	WithoutDueDate bool
+}
+
+func (d DueRange) cacheKey() string {
+	unix := func(t *time.Time) int64 {
+		if t == nil {
+			return 0
+		}
+		return t.Unix()
+	}
+	return fmt.Sprintf("%d-%d-%v", unix(d.From), unix(d.To), d.WithoutDueDate)
+}
+
+// taskSortOrder возвращает выражение ORDER BY для порядка задач
+func taskSortOrder(sort pb.TaskSort) string {
+	switch sort {
+	case pb.TaskSort_TASK_SORT_CREATED_ASC:
+		return `created_at ASC`
+	case pb.TaskSort_TASK_SORT_DUE_ASC:
+		return `due_at ASC NULLS LAST, created_at DESC`
+	case pb.TaskSort_TASK_SORT_TITLE_ASC:
+		return `title ASC, created_at DESC`
+	default:
+		return `created_at DESC`
+	}
+}
+
+// taskViewFilter возвращает условие выборки задач для представления.
+// includeCompleted учитывается только без явного представления.
+func taskViewFilter(view pb.TaskView, includeCompleted bool) string {
+	switch view {
+	case pb.TaskView_TASK_VIEW_ACTIVE:
+		return `completed = false AND archived_at IS NULL`
+	case pb.TaskView_TASK_VIEW_COMPLETED:
+		return `completed = true AND archived_at IS NULL`
+	case pb.TaskView_TASK_VIEW_ARCHIVED:
+		return `archived_at IS NOT NULL`
+	case pb.TaskView_TASK_VIEW_ALL:
+		return `true`
+	default:
+		if includeCompleted {
+			return `archived_at IS NULL`
+		}
+		return `completed = false AND archived_at IS NULL`
+	}
+}
+
+// GetTask возвращает задачу пользователя по ID, включая выполненные и архивные
+func (r *TaskRepository) GetTask(ctx context.Context, taskID, userID string) (*pb.DbTask, error) {
+	query := `
+        SELECT ` + taskColumns + `
+        FROM tasks
+        WHERE id = $1 AND user_id = $2
+    `
+
+	task, err := scanTask(r.db.Pool.QueryRow(ctx, query, taskID, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	return task, nil
+}
+
+// GetTaskByICalUID возвращает задачу пользователя по UID календаря, включая выполненные и архивные
+func (r *TaskRepository) GetTaskByICalUID(ctx context.Context, icalUID, userID string) (*pb.DbTask, error) {
+	query := `
+        SELECT ` + taskColumns + `
+        FROM tasks
+        WHERE user_id = $1 AND ical_uid = $2
+    `
+
+	task, err := scanTask(r.db.Pool.QueryRow(ctx, query, userID, icalUID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	return task, nil
 }
 
-// DeleteTask удаляет задачу
-func (r *TaskRepository) DeleteTask(ctx context.Context, taskID, userID string) (bool, error) {
+// DeleteTask удаляет задачу вместе с подзадачами. Если expectedVersion не 0, версия задачи должна совпадать.
+func (r *TaskRepository) DeleteTask(ctx context.Context, taskID, userID string, expectedVersion int64) (bool, error) {
+	subtasksQuery := `
+        DELETE FROM tasks 
+        WHERE parent_id = $1 AND user_id = $2
+        RETURNING ` + taskColumns
 	query := `
         DELETE FROM tasks 
         WHERE id = $1 AND user_id = $2
     `
 
-	result, err := r.db.Pool.Exec(ctx, query, taskID, userID)
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		task, err := lockTaskVersion(ctx, tx, taskID, userID, expectedVersion)
+		if err != nil {
+			return err
+		}
+
+		subtasks, err := queryTasks(ctx, tx, subtasksQuery, taskID, userID)
+		if err != nil {
+			return fmt.Errorf("failed to delete subtasks: %w", err)
+		}
+		for _, subtask := range subtasks {
+			if err := recordRevision(ctx, tx, revisionActionDeleted, userID, subtask, nil); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.Exec(ctx, query, taskID, userID); err != nil {
+			return fmt.Errorf("failed to delete task: %w", err)
+		}
+		return recordRevision(ctx, tx, revisionActionDeleted, userID, task, nil)
+	})
+	if errors.Is(err, ErrTaskNotFound) {
+		return false, nil
+	}
 	if err != nil {
-		return false, fmt.Errorf("failed to delete task: %w", err)
+		return false, err
+	}
+
+	if err := r.InvalidateCache(ctx, userID); err == nil {
+		fmt.Printf("[CACHE INVALIDATED] UserID: %s | Reason: Task deleted\n", userID)
 	}
 
-	deleted := result.RowsAffected() > 0
-	if deleted {
-		if err := r.InvalidateCache(ctx, userID); err == nil {
-			fmt.Printf("[CACHE INVALIDATED] UserID: %s | Reason: Task deleted\n", userID)
+	return true, nil
+}
+
+// CompleteTask отмечает задачу как выполненную. Если expectedVersion не 0, версия задачи должна совпадать.
+func (r *TaskRepository) CompleteTask(ctx context.Context, taskID, userID string, expectedVersion int64) (*pb.DbTask, error) {
+	query := `
+        UPDATE tasks 
+        SET completed = true, completed_at = NOW(), version = version + 1
+        WHERE id = $1 AND user_id = $2
+        RETURNING ` + taskColumns
+
+	var task *pb.DbTask
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		oldTask, err := lockTaskVersion(ctx, tx, taskID, userID, expectedVersion)
+		if err != nil {
+			return err
+		}
+
+		task, err = scanTask(tx.QueryRow(ctx, query, taskID, userID))
+		if err != nil {
+			return fmt.Errorf("failed to complete task: %w", err)
 		}
+		return recordRevision(ctx, tx, revisionActionCompleted, userID, oldTask, task)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.InvalidateCache(ctx, userID); err == nil {
+		fmt.Printf("userId: %s | reason: task completed\n", userID)
 	}
 
-	return deleted, nil
+	return task, nil
 }
 
-// CompleteTask отмечает задачу как выполненную
-func (r *TaskRepository) CompleteTask(ctx context.Context, taskID, userID string) (*pb.DbTask, error) {
+// UpdateTask изменяет переданные поля задачи. Если ExpectedVersion не 0, версия задачи должна совпадать.
+func (r *TaskRepository) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.DbTask, error) {
 	query := `
         UPDATE tasks 
-        SET completed = true, completed_at = NOW()
+        SET title = COALESCE($3, title),
+            description = COALESCE($4, description),
+            due_at = CASE WHEN $5 THEN NULL ELSE COALESCE($6, due_at) END,
+            tags = COALESCE($7, tags),
+            version = version + 1
         WHERE id = $1 AND user_id = $2
-        RETURNING id, user_id, title, description, completed, created_at, completed_at
-    `
+        RETURNING ` + taskColumns
 
-	var task pb.DbTask
-	var createdAt time.Time
-	var completedAt *time.Time
-	err := r.db.Pool.QueryRow(ctx, query, taskID, userID).Scan(
-		&task.Id, &task.UserId, &task.Title, &task.Description,
-		&task.Completed, &createdAt, &completedAt,
-	)
+	var tags []string
+	if req.Tags != nil {
+		tags = nonNilTags(req.Tags.Values)
+	}
 
-	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, fmt.Errorf("task not found or access denied")
+	var task *pb.DbTask
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		oldTask, err := lockTaskVersion(ctx, tx, req.Id, req.UserId, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		task, err = scanTask(tx.QueryRow(ctx, query, req.Id, req.UserId, req.Title, req.Description,
+			req.ClearDueAt, timestampToTime(req.DueAt), tags))
+		if err != nil {
+			return fmt.Errorf("failed to update task: %w", err)
+		}
+		return recordRevision(ctx, tx, revisionActionUpdated, req.UserId, oldTask, task)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.InvalidateCache(ctx, req.UserId); err == nil {
+		fmt.Printf("[CACHE INVALIDATED] UserID: %s | Reason: Task updated\n", req.UserId)
 	}
+
+	return task, nil
+}
+
+// insertTask создает задачу в рамках транзакции. Ссылки на родителя и список
+// должны быть проверены заранее (resolveTaskRefs).
+func insertTask(ctx context.Context, tx pgx.Tx, req *pb.CreateTaskRequest) (*pb.DbTask, error) {
+	query := `
+        INSERT INTO tasks (user_id, title, description, due_at, tags, parent_id, list_id) 
+        VALUES ($1, $2, $3, $4, $5, $6, $7) 
+        RETURNING ` + taskColumns
+
+	task, err := scanTask(tx.QueryRow(ctx, query,
+		req.UserId, req.Title, req.Description, timestampToTime(req.DueAt), nonNilTags(req.Tags),
+		nullableString(req.ParentId), nullableString(req.ListId),
+	))
 	if err != nil {
-		return nil, fmt.Errorf("failed to complete task: %w", err)
+		return nil, fmt.Errorf("failed to create task: %w", err)
 	}
+	return task, nil
+}
 
-	task.CreatedAt = timestamppb.New(createdAt)
-	if completedAt != nil {
-		task.CompletedAt = timestamppb.New(*completedAt)
+// resolveTaskRefs проверяет, что родительская задача и список принадлежат пользователю.
+// Подзадача без явного списка попадает в список родителя, задача - в список по умолчанию из настроек.
+func resolveTaskRefs(ctx context.Context, tx pgx.Tx, req *pb.CreateTaskRequest) error {
+	if req.ParentId != "" {
+		var grandParentID, parentListID *string
+		err := tx.QueryRow(ctx,
+			`SELECT parent_id, list_id FROM tasks WHERE id = $1 AND user_id = $2`,
+			req.ParentId, req.UserId,
+		).Scan(&grandParentID, &parentListID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrParentNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get parent task: %w", err)
+		}
+		if grandParentID != nil {
+			return ErrNestedSubtask
+		}
+		if req.ListId == "" && parentListID != nil {
+			req.ListId = *parentListID
+		}
+	} else if req.ListId == "" {
+		err := tx.QueryRow(ctx,
+			`SELECT COALESCE(default_list_id::text, '') FROM user_settings WHERE user_id = $1`,
+			req.UserId,
+		).Scan(&req.ListId)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to get default list: %w", err)
+		}
 	}
 
-	if err := r.InvalidateCache(ctx, userID); err == nil {
-		fmt.Printf("userId: %s | reason: task completed\n", userID)
+	if req.ListId != "" {
+		if err := checkListOwner(ctx, tx, req.ListId, req.UserId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryTasks выполняет запрос, возвращающий колонки taskColumns
+func queryTasks(ctx context.Context, tx pgx.Tx, query string, args ...any) ([]*pb.DbTask, error) {
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
 	}
+	defer rows.Close()
 
-	return &task, nil
+	var tasks []*pb.DbTask
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+// lockTask читает задачу пользователя и блокирует строку до конца транзакции
+func lockTask(ctx context.Context, tx pgx.Tx, taskID, userID string) (*pb.DbTask, error) {
+	query := `
+        SELECT ` + taskColumns + `
+        FROM tasks
+        WHERE id = $1 AND user_id = $2
+        FOR UPDATE
+    `
+
+	task, err := scanTask(tx.QueryRow(ctx, query, taskID, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	return task, nil
+}
+
+// lockTaskVersion блокирует задачу и проверяет ее версию, если expectedVersion не 0
+func lockTaskVersion(ctx context.Context, tx pgx.Tx, taskID, userID string, expectedVersion int64) (*pb.DbTask, error) {
+	task, err := lockTask(ctx, tx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if expectedVersion != 0 && task.Version != expectedVersion {
+		return nil, ErrVersionMismatch
+	}
+	return task, nil
 }
 
 // InvalidateCache удаляет кэш для пользователя
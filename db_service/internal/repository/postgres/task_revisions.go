@@ -0,0 +1,243 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Действия, которые записываются в историю изменений задачи
+const (
+	revisionActionCreated    = "created"
+	revisionActionUpdated    = "updated"
+	revisionActionCompleted  = "completed"
+	revisionActionDeleted    = "deleted"
+	revisionActionReverted   = "reverted"
+	revisionActionArchived   = "archived"
+	revisionActionUnarchived = "unarchived"
+)
+
+var (
+	ErrRevisionNotFound = errors.New("revision not found")
+	ErrRevertToDeleted  = errors.New("cannot revert to a revision where the task was deleted")
+)
+
+// taskSnapshot - состояние задачи, которое хранится в old_values/new_values
+type taskSnapshot struct {
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Completed   bool       `json:"completed"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	Version     int64      `json:"version"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	ParentID    string     `json:"parent_id,omitempty"`
+	ListID      string     `json:"list_id,omitempty"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	ICalUID     string     `json:"ical_uid,omitempty"`
+}
+
+func snapshotOf(task *pb.DbTask) *taskSnapshot {
+	if task == nil {
+		return nil
+	}
+
+	snapshot := &taskSnapshot{
+		Title:       task.Title,
+		Description: task.Description,
+		Completed:   task.Completed,
+		CreatedAt:   task.CreatedAt.AsTime(),
+		CompletedAt: timestampToTime(task.CompletedAt),
+		Version:     task.Version,
+		DueAt:       timestampToTime(task.DueAt),
+		Tags:        task.Tags,
+		ParentID:    task.ParentId,
+		ListID:      task.ListId,
+		ArchivedAt:  timestampToTime(task.ArchivedAt),
+		ICalUID:     task.IcalUid,
+	}
+	return snapshot
+}
+
+func (s *taskSnapshot) toTask(taskID, userID string) *pb.DbTask {
+	if s == nil {
+		return nil
+	}
+
+	return &pb.DbTask{
+		Id:          taskID,
+		UserId:      userID,
+		Title:       s.Title,
+		Description: s.Description,
+		Completed:   s.Completed,
+		CreatedAt:   timestamppb.New(s.CreatedAt),
+		CompletedAt: convertToTimestamp(s.CompletedAt),
+		Version:     s.Version,
+		DueAt:       convertToTimestamp(s.DueAt),
+		Tags:        s.Tags,
+		ParentId:    s.ParentID,
+		ListId:      s.ListID,
+		ArchivedAt:  convertToTimestamp(s.ArchivedAt),
+		IcalUid:     s.ICalUID,
+	}
+}
+
+// recordRevision записывает ревизию задачи и событие изменения для outbox в рамках транзакции изменения.
+// oldTask равен nil при создании, newTask - при удалении.
+func recordRevision(ctx context.Context, tx pgx.Tx, action, actorID string, oldTask, newTask *pb.DbTask) error {
+	task := newTask
+	if task == nil {
+		task = oldTask
+	}
+
+	query := `
+        INSERT INTO task_revisions (task_id, user_id, revision, action, old_values, new_values, actor_id)
+        VALUES ($1, $2,
+            (SELECT COALESCE(MAX(revision), 0) + 1 FROM task_revisions WHERE task_id = $1),
+            $3, $4, $5, $6)
+    `
+
+	var actor *string
+	if actorID != "" {
+		actor = &actorID
+	}
+
+	_, err := tx.Exec(ctx, query, task.Id, task.UserId, action,
+		snapshotOf(oldTask), snapshotOf(newTask), actor)
+	if err != nil {
+		return fmt.Errorf("failed to record task revision: %w", err)
+	}
+	return enqueueEvent(ctx, tx, eventActions[action], task)
+}
+
+// GetTaskHistory возвращает ревизии задачи, начиная с последней
+func (r *TaskRepository) GetTaskHistory(ctx context.Context, req *pb.GetTaskHistoryRequest) ([]*pb.TaskRevision, int32, error) {
+	countQuery := `
+        SELECT COUNT(*) FROM task_revisions
+        WHERE task_id = $1 AND user_id = $2
+    `
+	var totalCount int32
+	err := r.db.Pool.QueryRow(ctx, countQuery, req.TaskId, req.UserId).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count task revisions: %w", err)
+	}
+
+	query := `
+        SELECT id, task_id, user_id, revision, action, old_values, new_values, actor_id, created_at
+        FROM task_revisions
+        WHERE task_id = $1 AND user_id = $2
+        ORDER BY revision DESC
+        LIMIT $3 OFFSET $4
+    `
+
+	rows, err := r.db.Pool.Query(ctx, query, req.TaskId, req.UserId, req.Limit, req.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get task history: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*pb.TaskRevision
+	for rows.Next() {
+		var revision pb.TaskRevision
+		var userID string
+		var oldValues, newValues *taskSnapshot
+		var actorID *string
+		var createdAt time.Time
+		err := rows.Scan(&revision.Id, &revision.TaskId, &userID, &revision.Revision, &revision.Action,
+			&oldValues, &newValues, &actorID, &createdAt)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan task revision: %w", err)
+		}
+
+		revision.OldTask = oldValues.toTask(revision.TaskId, userID)
+		revision.NewTask = newValues.toTask(revision.TaskId, userID)
+		if actorID != nil {
+			revision.ActorId = *actorID
+		}
+		revision.CreatedAt = timestamppb.New(createdAt)
+		revisions = append(revisions, &revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read task history: %w", err)
+	}
+
+	return revisions, totalCount, nil
+}
+
+// RevertTask возвращает задачу к состоянию после указанной ревизии.
+// Удаленная задача восстанавливается с прежним ID, версия продолжает расти.
+// Если родительская задача или список уже удалены, ссылка на них сбрасывается.
+func (r *TaskRepository) RevertTask(ctx context.Context, taskID, userID string, revision int32, expectedVersion int64) (*pb.DbTask, error) {
+	revisionQuery := `
+        SELECT new_values FROM task_revisions
+        WHERE task_id = $1 AND user_id = $2 AND revision = $3
+    `
+	updateQuery := `
+        UPDATE tasks
+        SET title = $3, description = $4, completed = $5, completed_at = $6, version = version + 1,
+            due_at = $7, tags = $8,
+            parent_id = (SELECT id FROM tasks WHERE id = $9 AND user_id = $2),
+            list_id = (SELECT id FROM task_lists WHERE id = $10 AND user_id = $2),
+            archived_at = $11
+        WHERE id = $1 AND user_id = $2
+        RETURNING ` + taskColumns
+	restoreQuery := `
+        INSERT INTO tasks (id, user_id, title, description, completed, completed_at, due_at, tags,
+            parent_id, list_id, archived_at, created_at, ical_uid, version)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8,
+            (SELECT id FROM tasks WHERE id = $9 AND user_id = $2),
+            (SELECT id FROM task_lists WHERE id = $10 AND user_id = $2),
+            $11, $12, COALESCE(NULLIF($13, ''), gen_random_uuid()::text),
+            (SELECT COALESCE(MAX((new_values->>'version')::BIGINT), 0) + 1
+             FROM task_revisions WHERE task_id = $1))
+        RETURNING ` + taskColumns
+
+	var task *pb.DbTask
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var target *taskSnapshot
+		err := tx.QueryRow(ctx, revisionQuery, taskID, userID, revision).Scan(&target)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrRevisionNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get task revision: %w", err)
+		}
+		if target == nil {
+			return ErrRevertToDeleted
+		}
+
+		args := []any{taskID, userID, target.Title, target.Description, target.Completed, target.CompletedAt,
+			target.DueAt, nonNilTags(target.Tags), nullableString(target.ParentID), nullableString(target.ListID),
+			target.ArchivedAt}
+
+		oldTask, err := lockTaskVersion(ctx, tx, taskID, userID, expectedVersion)
+		switch {
+		case errors.Is(err, ErrTaskNotFound) && expectedVersion == 0:
+			task, err = scanTask(tx.QueryRow(ctx, restoreQuery, append(args, target.CreatedAt, target.ICalUID)...))
+		case err != nil:
+			return err
+		default:
+			task, err = scanTask(tx.QueryRow(ctx, updateQuery, args...))
+		}
+		if err != nil {
+			return fmt.Errorf("failed to revert task: %w", err)
+		}
+
+		return recordRevision(ctx, tx, revisionActionReverted, userID, oldTask, task)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.InvalidateCache(ctx, userID); err == nil {
+		fmt.Printf("[CACHE INVALIDATED] UserID: %s | Reason: Task reverted\n", userID)
+	}
+
+	return task, nil
+}
@@ -0,0 +1,333 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrTemplateNotFound = errors.New("template not found or access denied")
+
+const templateColumns = `id, user_id, name, description, items, created_at, updated_at`
+
+// blueprint - заготовка задачи в колонке task_templates.items
+type blueprint struct {
+	Title            string      `json:"title"`
+	Description      string      `json:"description,omitempty"`
+	DueOffsetSeconds *int64      `json:"due_offset_seconds,omitempty"`
+	Tags             []string    `json:"tags,omitempty"`
+	Subtasks         []blueprint `json:"subtasks,omitempty"`
+}
+
+func blueprintsFromProto(items []*pb.TaskBlueprint) []blueprint {
+	result := make([]blueprint, len(items))
+	for i, item := range items {
+		result[i] = blueprint{
+			Title:            item.Title,
+			Description:      item.Description,
+			DueOffsetSeconds: item.DueOffsetSeconds,
+			Tags:             item.Tags,
+			Subtasks:         blueprintsFromProto(item.Subtasks),
+		}
+	}
+	return result
+}
+
+func blueprintsToProto(items []blueprint) []*pb.TaskBlueprint {
+	result := make([]*pb.TaskBlueprint, len(items))
+	for i, item := range items {
+		result[i] = &pb.TaskBlueprint{
+			Title:            item.Title,
+			Description:      item.Description,
+			DueOffsetSeconds: item.DueOffsetSeconds,
+			Tags:             item.Tags,
+			Subtasks:         blueprintsToProto(item.Subtasks),
+		}
+	}
+	return result
+}
+
+type TemplateRepository struct {
+	db *Postgres
+}
+
+func NewTemplateRepository(db *Postgres) *TemplateRepository {
+	return &TemplateRepository{db: db}
+}
+
+// CreateTemplate создает шаблон чек-листа
+func (r *TemplateRepository) CreateTemplate(ctx context.Context, req *pb.CreateTemplateRequest) (*pb.TaskTemplate, error) {
+	query := `
+        INSERT INTO task_templates (user_id, name, description, items)
+        VALUES ($1, $2, $3, $4)
+        RETURNING ` + templateColumns
+
+	template, err := scanTemplate(r.db.Pool.QueryRow(ctx, query,
+		req.UserId, req.Name, req.Description, blueprintsFromProto(req.Items)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create template: %w", err)
+	}
+	return template, nil
+}
+
+// GetTemplates возвращает шаблоны пользователя
+func (r *TemplateRepository) GetTemplates(ctx context.Context, userID string) ([]*pb.TaskTemplate, error) {
+	query := `
+        SELECT ` + templateColumns + `
+        FROM task_templates
+        WHERE user_id = $1
+        ORDER BY name
+    `
+
+	rows, err := r.db.Pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []*pb.TaskTemplate
+	for rows.Next() {
+		template, err := scanTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan template: %w", err)
+		}
+		templates = append(templates, template)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// GetTemplate возвращает шаблон по ID
+func (r *TemplateRepository) GetTemplate(ctx context.Context, templateID, userID string) (*pb.TaskTemplate, error) {
+	query := `
+        SELECT ` + templateColumns + `
+        FROM task_templates
+        WHERE id = $1 AND user_id = $2
+    `
+
+	template, err := scanTemplate(r.db.Pool.QueryRow(ctx, query, templateID, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTemplateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+	return template, nil
+}
+
+// UpdateTemplate полностью заменяет название, описание и задачи шаблона
+func (r *TemplateRepository) UpdateTemplate(ctx context.Context, req *pb.UpdateTemplateRequest) (*pb.TaskTemplate, error) {
+	query := `
+        UPDATE task_templates
+        SET name = $3, description = $4, items = $5, updated_at = NOW()
+        WHERE id = $1 AND user_id = $2
+        RETURNING ` + templateColumns
+
+	template, err := scanTemplate(r.db.Pool.QueryRow(ctx, query,
+		req.Id, req.UserId, req.Name, req.Description, blueprintsFromProto(req.Items)))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTemplateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update template: %w", err)
+	}
+	return template, nil
+}
+
+// DeleteTemplate удаляет шаблон
+func (r *TemplateRepository) DeleteTemplate(ctx context.Context, templateID, userID string) (bool, error) {
+	query := `
+        DELETE FROM task_templates
+        WHERE id = $1 AND user_id = $2
+    `
+
+	result, err := r.db.Pool.Exec(ctx, query, templateID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete template: %w", err)
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+// InstantiateTemplate создает все задачи шаблона в одной транзакции.
+// Сроки считаются от StartAt (по умолчанию - текущий момент).
+func (r *TemplateRepository) InstantiateTemplate(ctx context.Context, req *pb.InstantiateTemplateRequest) ([]*pb.DbTask, *pb.TaskList, error) {
+	template, err := r.GetTemplate(ctx, req.TemplateId, req.UserId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startAt := time.Now()
+	if req.StartAt != nil {
+		startAt = req.StartAt.AsTime()
+	}
+
+	var tasks []*pb.DbTask
+	var list *pb.TaskList
+	err = r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		listID := req.ListId
+		switch {
+		case req.NewListName != "":
+			list, err = insertList(ctx, tx, req.UserId, req.NewListName)
+			if err != nil {
+				return err
+			}
+			listID = list.Id
+		case listID != "":
+			if err := checkListOwner(ctx, tx, listID, req.UserId); err != nil {
+				return err
+			}
+		}
+
+		create := func(item *pb.TaskBlueprint, parentID string) (*pb.DbTask, error) {
+			createReq := &pb.CreateTaskRequest{
+				UserId:      req.UserId,
+				Title:       item.Title,
+				Description: item.Description,
+				Tags:        item.Tags,
+				ParentId:    parentID,
+				ListId:      listID,
+			}
+			if item.DueOffsetSeconds != nil {
+				createReq.DueAt = timestamppb.New(startAt.Add(time.Duration(*item.DueOffsetSeconds) * time.Second))
+			}
+
+			task, err := insertTask(ctx, tx, createReq)
+			if err != nil {
+				return nil, err
+			}
+			if err := recordRevision(ctx, tx, revisionActionCreated, req.UserId, nil, task); err != nil {
+				return nil, err
+			}
+			tasks = append(tasks, task)
+			return task, nil
+		}
+
+		for _, item := range template.Items {
+			task, err := create(item, "")
+			if err != nil {
+				return err
+			}
+			for _, subtask := range item.Subtasks {
+				if _, err := create(subtask, task.Id); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tasks, list, nil
+}
+
+// SaveListAsTemplate сохраняет задачи списка (с подзадачами) как шаблон.
+// Сроки превращаются в смещения от BaseTime (по умолчанию - момент создания списка).
+func (r *TemplateRepository) SaveListAsTemplate(ctx context.Context, req *pb.SaveListAsTemplateRequest) (*pb.TaskTemplate, error) {
+	tasksQuery := `
+        SELECT ` + taskColumns + `
+        FROM tasks
+        WHERE list_id = $1 AND user_id = $2
+        ORDER BY created_at
+    `
+	insertQuery := `
+        INSERT INTO task_templates (user_id, name, description, items)
+        VALUES ($1, $2, $3, $4)
+        RETURNING ` + templateColumns
+
+	var template *pb.TaskTemplate
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var listCreatedAt time.Time
+		err := tx.QueryRow(ctx,
+			`SELECT created_at FROM task_lists WHERE id = $1 AND user_id = $2`,
+			req.ListId, req.UserId,
+		).Scan(&listCreatedAt)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrListNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get list: %w", err)
+		}
+
+		baseTime := listCreatedAt
+		if req.BaseTime != nil {
+			baseTime = req.BaseTime.AsTime()
+		}
+
+		tasks, err := queryTasks(ctx, tx, tasksQuery, req.ListId, req.UserId)
+		if err != nil {
+			return fmt.Errorf("failed to get list tasks: %w", err)
+		}
+
+		items := blueprintsFromTasks(tasks, baseTime)
+		template, err = scanTemplate(tx.QueryRow(ctx, insertQuery, req.UserId, req.Name, req.Description, items))
+		if err != nil {
+			return fmt.Errorf("failed to create template: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// blueprintsFromTasks строит заготовки из задач списка, сохраняя порядок и вложенность
+func blueprintsFromTasks(tasks []*pb.DbTask, baseTime time.Time) []blueprint {
+	toBlueprint := func(task *pb.DbTask) blueprint {
+		item := blueprint{
+			Title:       task.Title,
+			Description: task.Description,
+			Tags:        task.Tags,
+		}
+		if task.DueAt != nil {
+			offset := int64(task.DueAt.AsTime().Sub(baseTime) / time.Second)
+			item.DueOffsetSeconds = &offset
+		}
+		return item
+	}
+
+	index := make(map[string]int)
+	var items []blueprint
+	for _, task := range tasks {
+		if task.ParentId == "" {
+			index[task.Id] = len(items)
+			items = append(items, toBlueprint(task))
+		}
+	}
+	for _, task := range tasks {
+		if i, ok := index[task.ParentId]; ok {
+			items[i].Subtasks = append(items[i].Subtasks, toBlueprint(task))
+		}
+	}
+	if items == nil {
+		items = []blueprint{}
+	}
+	return items
+}
+
+func scanTemplate(row pgx.Row) (*pb.TaskTemplate, error) {
+	var template pb.TaskTemplate
+	var items []blueprint
+	var createdAt, updatedAt time.Time
+	err := row.Scan(&template.Id, &template.UserId, &template.Name, &template.Description,
+		&items, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	template.Items = blueprintsToProto(items)
+	template.CreatedAt = timestamppb.New(createdAt)
+	template.UpdatedAt = timestamppb.New(updatedAt)
+	return &template, nil
+}
@@ -0,0 +1,403 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrWebhookNotFound         = errors.New("webhook not found or access denied")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrWebhookDisabled         = errors.New("webhook is disabled")
+	ErrWebhookDeliveryPending  = errors.New("webhook delivery is still pending")
+)
+
+// Статусы доставки вебхука
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+const webhookColumns = `id, user_id, url, secret, event_types, enabled, failure_count, disabled_reason,
+        created_at, updated_at`
+
+// webhookDeliveryColumns - колонки доставки; в запросах таблица webhook_deliveries имеет псевдоним d
+const webhookDeliveryColumns = `d.id, d.webhook_id, d.event_id, d.event_type, d.payload, d.status, d.attempts,
+        d.last_status_code, d.last_error, d.next_attempt_at, d.redelivery_of, d.created_at, d.updated_at`
+
+type WebhookRepository struct {
+	db *Postgres
+}
+
+func NewWebhookRepository(db *Postgres) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+// CreateWebhook создает вебхук
+func (r *WebhookRepository) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.Webhook, error) {
+	query := `
+        INSERT INTO webhooks (user_id, url, secret, event_types)
+        VALUES ($1, $2, $3, $4)
+        RETURNING ` + webhookColumns
+
+	webhook, err := scanWebhook(r.db.Pool.QueryRow(ctx, query,
+		req.UserId, req.Url, req.Secret, nonNilTags(req.EventTypes)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+	return webhook, nil
+}
+
+// GetWebhooks возвращает вебхуки пользователя
+func (r *WebhookRepository) GetWebhooks(ctx context.Context, userID string) ([]*pb.Webhook, error) {
+	query := `
+        SELECT ` + webhookColumns + `
+        FROM webhooks
+        WHERE user_id = $1
+        ORDER BY created_at
+    `
+
+	rows, err := r.db.Pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	var webhooks []*pb.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// GetWebhook возвращает вебхук по ID
+func (r *WebhookRepository) GetWebhook(ctx context.Context, webhookID, userID string) (*pb.Webhook, error) {
+	query := `
+        SELECT ` + webhookColumns + `
+        FROM webhooks
+        WHERE id = $1 AND user_id = $2
+    `
+
+	webhook, err := scanWebhook(r.db.Pool.QueryRow(ctx, query, webhookID, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	return webhook, nil
+}
+
+// UpdateWebhook заменяет адрес и типы событий вебхука. Пустой секрет не меняется;
+// включение вебхука сбрасывает счетчик неудачных доставок.
+func (r *WebhookRepository) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.Webhook, error) {
+	query := `
+        UPDATE webhooks
+        SET url = $3,
+            secret = COALESCE(NULLIF($4, ''), secret),
+            event_types = $5,
+            enabled = COALESCE($6::boolean, enabled),
+            failure_count = CASE WHEN $6::boolean THEN 0 ELSE failure_count END,
+            disabled_reason = CASE WHEN $6::boolean IS NULL THEN disabled_reason END,
+            updated_at = NOW()
+        WHERE id = $1 AND user_id = $2
+        RETURNING ` + webhookColumns
+
+	webhook, err := scanWebhook(r.db.Pool.QueryRow(ctx, query,
+		req.Id, req.UserId, req.Url, req.Secret, nonNilTags(req.EventTypes), req.Enabled))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+	return webhook, nil
+}
+
+// DeleteWebhook удаляет вебхук вместе с журналом доставок
+func (r *WebhookRepository) DeleteWebhook(ctx context.Context, webhookID, userID string) (bool, error) {
+	query := `
+        DELETE FROM webhooks
+        WHERE id = $1 AND user_id = $2
+    `
+
+	result, err := r.db.Pool.Exec(ctx, query, webhookID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+// GetWebhookDeliveries возвращает журнал доставок вебхука, новые первыми
+func (r *WebhookRepository) GetWebhookDeliveries(ctx context.Context, req *pb.GetWebhookDeliveriesRequest) ([]*pb.WebhookDelivery, error) {
+	if _, err := r.GetWebhook(ctx, req.WebhookId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	query := `
+        SELECT ` + webhookDeliveryColumns + `
+        FROM webhook_deliveries d
+        WHERE d.webhook_id = $1
+        ORDER BY d.created_at DESC
+        LIMIT $2 OFFSET $3
+    `
+
+	rows, err := r.db.Pool.Query(ctx, query, req.WebhookId, req.Limit, req.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*pb.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// RedeliverWebhook ставит в очередь копию доставки с тем же событием и телом
+func (r *WebhookRepository) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.WebhookDelivery, error) {
+	checkQuery := `
+        SELECT d.status, w.enabled
+        FROM webhook_deliveries d
+        JOIN webhooks w ON w.id = d.webhook_id
+        WHERE d.id = $1 AND d.webhook_id = $2 AND w.user_id = $3
+    `
+	insertQuery := `
+        INSERT INTO webhook_deliveries AS d (webhook_id, event_id, event_type, payload, redelivery_of)
+        SELECT webhook_id, event_id, event_type, payload, id
+        FROM webhook_deliveries
+        WHERE id = $1
+        RETURNING ` + webhookDeliveryColumns
+
+	var deliveryStatus string
+	var enabled bool
+	err := r.db.Pool.QueryRow(ctx, checkQuery, req.DeliveryId, req.WebhookId, req.UserId).Scan(&deliveryStatus, &enabled)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+	if !enabled {
+		return nil, ErrWebhookDisabled
+	}
+	if deliveryStatus == WebhookDeliveryPending {
+		return nil, ErrWebhookDeliveryPending
+	}
+
+	delivery, err := scanWebhookDelivery(r.db.Pool.QueryRow(ctx, insertQuery, req.DeliveryId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to redeliver webhook: %w", err)
+	}
+	return delivery, nil
+}
+
+// EnqueueWebhookDeliveries создает доставки события для включенных вебхуков пользователя,
+// подписанных на его тип. Возвращает число созданных доставок.
+func (r *WebhookRepository) EnqueueWebhookDeliveries(ctx context.Context, req *pb.EnqueueWebhookDeliveriesRequest) (int, error) {
+	query := `
+        INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+        SELECT id, $2, $3, $4
+        FROM webhooks
+        WHERE user_id = $1 AND enabled AND (cardinality(event_types) = 0 OR $3 = ANY(event_types))
+        ON CONFLICT (webhook_id, event_id) WHERE redelivery_of IS NULL DO NOTHING
+    `
+
+	result, err := r.db.Pool.Exec(ctx, query, req.UserId, req.EventId, req.EventType, req.Payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enqueue webhook deliveries: %w", err)
+	}
+	return int(result.RowsAffected()), nil
+}
+
+// ClaimWebhookDeliveries выдает доставки включенных вебхуков, время попытки которых наступило,
+// и откладывает их на leaseSeconds: если отправитель не сообщит результат, доставка вернется в очередь
+func (r *WebhookRepository) ClaimWebhookDeliveries(ctx context.Context, limit, leaseSeconds int32) ([]*pb.ClaimedWebhookDelivery, error) {
+	query := `
+        UPDATE webhook_deliveries d
+        SET next_attempt_at = NOW() + $2::int * INTERVAL '1 second'
+        FROM webhooks w
+        WHERE w.id = d.webhook_id AND d.id IN (
+            SELECT pd.id
+            FROM webhook_deliveries pd
+            JOIN webhooks pw ON pw.id = pd.webhook_id
+            WHERE pd.status = 'pending' AND pd.next_attempt_at <= NOW() AND pw.enabled
+            ORDER BY pd.next_attempt_at
+            LIMIT $1
+            FOR UPDATE OF pd SKIP LOCKED
+        )
+        RETURNING ` + webhookDeliveryColumns + `, w.url, w.secret`
+
+	rows, err := r.db.Pool.Query(ctx, query, limit, leaseSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var claimed []*pb.ClaimedWebhookDelivery
+	for rows.Next() {
+		var item pb.ClaimedWebhookDelivery
+		item.Delivery, err = scanWebhookDelivery(rows, &item.Url, &item.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		claimed = append(claimed, &item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read webhook deliveries: %w", err)
+	}
+
+	return claimed, nil
+}
+
+// CompleteWebhookDelivery записывает результат попытки. Неудачная доставка без RetryAt
+// считается окончательной и увеличивает счетчик неудач вебхука; после DisableAfter неудач
+// подряд вебхук отключается. Успешная доставка сбрасывает счетчик.
+// Возвращает доставку и признак того, что вебхук отключен этой неудачей.
+func (r *WebhookRepository) CompleteWebhookDelivery(ctx context.Context, req *pb.CompleteWebhookDeliveryRequest) (*pb.WebhookDelivery, bool, error) {
+	deliveryQuery := `
+        UPDATE webhook_deliveries d
+        SET status = $2,
+            attempts = attempts + 1,
+            last_status_code = NULLIF($3, 0),
+            last_error = NULLIF($4, ''),
+            next_attempt_at = $5,
+            updated_at = NOW()
+        WHERE id = $1 AND status = 'pending'
+        RETURNING ` + webhookDeliveryColumns
+	resetQuery := `
+        UPDATE webhooks
+        SET failure_count = 0
+        WHERE id = $1 AND failure_count > 0
+    `
+	lockQuery := `SELECT enabled, failure_count FROM webhooks WHERE id = $1 FOR UPDATE`
+	failureQuery := `
+        UPDATE webhooks
+        SET failure_count = $2,
+            enabled = enabled AND NOT $3,
+            disabled_reason = CASE WHEN $3 THEN $4 ELSE disabled_reason END,
+            updated_at = CASE WHEN $3 THEN NOW() ELSE updated_at END
+        WHERE id = $1
+    `
+
+	deliveryStatus := WebhookDeliveryDelivered
+	var retryAt *time.Time
+	if !req.Success {
+		retryAt = timestampToTime(req.RetryAt)
+		deliveryStatus = WebhookDeliveryFailed
+		if retryAt != nil {
+			deliveryStatus = WebhookDeliveryPending
+		}
+	}
+
+	var delivery *pb.WebhookDelivery
+	var disabled bool
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		delivery, err = scanWebhookDelivery(tx.QueryRow(ctx, deliveryQuery,
+			req.Id, deliveryStatus, req.StatusCode, req.Error, retryAt))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrWebhookDeliveryNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update webhook delivery: %w", err)
+		}
+
+		switch deliveryStatus {
+		case WebhookDeliveryDelivered:
+			if _, err := tx.Exec(ctx, resetQuery, delivery.WebhookId); err != nil {
+				return fmt.Errorf("failed to reset webhook failures: %w", err)
+			}
+		case WebhookDeliveryFailed:
+			var enabled bool
+			var failures int32
+			if err := tx.QueryRow(ctx, lockQuery, delivery.WebhookId).Scan(&enabled, &failures); err != nil {
+				return fmt.Errorf("failed to get webhook: %w", err)
+			}
+			failures++
+			disabled = enabled && req.DisableAfter > 0 && failures >= req.DisableAfter
+			reason := fmt.Sprintf("disabled after %d failed deliveries in a row", failures)
+			if _, err := tx.Exec(ctx, failureQuery, delivery.WebhookId, failures, disabled, reason); err != nil {
+				return fmt.Errorf("failed to record webhook failure: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return delivery, disabled, nil
+}
+
+func scanWebhook(row pgx.Row) (*pb.Webhook, error) {
+	var webhook pb.Webhook
+	var disabledReason *string
+	var createdAt, updatedAt time.Time
+	err := row.Scan(&webhook.Id, &webhook.UserId, &webhook.Url, &webhook.Secret, &webhook.EventTypes,
+		&webhook.Enabled, &webhook.FailureCount, &disabledReason, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if disabledReason != nil {
+		webhook.DisabledReason = *disabledReason
+	}
+	webhook.CreatedAt = timestamppb.New(createdAt)
+	webhook.UpdatedAt = timestamppb.New(updatedAt)
+	return &webhook, nil
+}
+
+// scanWebhookDelivery читает доставку с колонками webhookDeliveryColumns; extra - дополнительные
+// колонки после них
+func scanWebhookDelivery(row pgx.Row, extra ...any) (*pb.WebhookDelivery, error) {
+	var delivery pb.WebhookDelivery
+	var statusCode *int32
+	var lastError, redeliveryOf *string
+	var nextAttemptAt *time.Time
+	var createdAt, updatedAt time.Time
+	dest := []any{&delivery.Id, &delivery.WebhookId, &delivery.EventId, &delivery.EventType, &delivery.Payload,
+		&delivery.Status, &delivery.Attempts, &statusCode, &lastError, &nextAttemptAt, &redeliveryOf,
+		&createdAt, &updatedAt}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	if statusCode != nil {
+		delivery.LastStatusCode = *statusCode
+	}
+	if lastError != nil {
+		delivery.LastError = *lastError
+	}
+	if redeliveryOf != nil {
+		delivery.RedeliveryOf = *redeliveryOf
+	}
+	delivery.NextAttemptAt = convertToTimestamp(nextAttemptAt)
+	delivery.CreatedAt = timestamppb.New(createdAt)
+	delivery.UpdatedAt = timestamppb.New(updatedAt)
+	return &delivery, nil
+}
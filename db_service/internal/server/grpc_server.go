@@ -2,23 +2,62 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/bagdasarian/checklist-app/db_service/internal/digest"
 	"github.com/bagdasarian/checklist-app/db_service/internal/repository/postgres"
+	"github.com/bagdasarian/checklist-app/db_service/internal/usertime"
 	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
 	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type TaskService struct {
 	pb.UnimplementedDatabaseServiceServer
-	userRepo postgres.UserRepositoryInterface
-	taskRepo postgres.TaskRepositoryInterface
+	userRepo     postgres.UserRepositoryInterface
+	taskRepo     postgres.TaskRepositoryInterface
+	listRepo     postgres.ListRepositoryInterface
+	templateRepo postgres.TemplateRepositoryInterface
+	archiveRepo  postgres.ArchivePolicyRepositoryInterface
+	calendarRepo postgres.CalendarTokenRepositoryInterface
+	webhookRepo  postgres.WebhookRepositoryInterface
+	reminderRepo postgres.ReminderRepositoryInterface
+	digestRepo   postgres.DigestRepositoryInterface
+	settingsRepo postgres.SettingsRepositoryInterface
+	statsRepo    postgres.StatsRepositoryInterface
+	digests      *digest.Builder
 }
 
-func NewTaskService(userRepo postgres.UserRepositoryInterface, taskRepo postgres.TaskRepositoryInterface) *TaskService {
+func NewTaskService(
+	userRepo postgres.UserRepositoryInterface,
+	taskRepo postgres.TaskRepositoryInterface,
+	listRepo postgres.ListRepositoryInterface,
+	templateRepo postgres.TemplateRepositoryInterface,
+	archiveRepo postgres.ArchivePolicyRepositoryInterface,
+	calendarRepo postgres.CalendarTokenRepositoryInterface,
+	webhookRepo postgres.WebhookRepositoryInterface,
+	reminderRepo postgres.ReminderRepositoryInterface,
+	digestRepo postgres.DigestRepositoryInterface,
+	settingsRepo postgres.SettingsRepositoryInterface,
+	statsRepo postgres.StatsRepositoryInterface,
+) *TaskService {
 	return &TaskService{
-		userRepo: userRepo,
-		taskRepo: taskRepo,
+		userRepo:     userRepo,
+		taskRepo:     taskRepo,
+		listRepo:     listRepo,
+		templateRepo: templateRepo,
+		archiveRepo:  archiveRepo,
+		calendarRepo: calendarRepo,
+		webhookRepo:  webhookRepo,
+		reminderRepo: reminderRepo,
+		digestRepo:   digestRepo,
+		settingsRepo: settingsRepo,
+		statsRepo:    statsRepo,
+		digests:      digest.NewBuilder(digestRepo),
 	}
 }
 
@@ -87,7 +126,7 @@ func (s *TaskService) AuthenticateUser(ctx context.Context, req *pb.Authenticate
 func (s *TaskService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error) {
 	task, err := s.taskRepo.CreateTask(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	return &pb.CreateTaskResponse{
@@ -98,15 +137,35 @@ func (s *TaskService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest)
 		Completed:   task.Completed,
 		CreatedAt:   task.CreatedAt,
 		CompletedAt: task.CompletedAt,
+		Version:     task.Version,
+		DueAt:       task.DueAt,
+		Tags:        task.Tags,
+		ParentId:    task.ParentId,
+		ListId:      task.ListId,
 	}, nil
 }
 
-// GetTasks возвращает список задач пользователя
+// GetTasks возвращает список задач пользователя.
+// Фильтры по сроку считаются в часовом поясе пользователя, без явной сортировки
+// используется сортировка из его настроек.
 func (s *TaskService) GetTasks(ctx context.Context, req *pb.GetTasksRequest) (*pb.GetTasksResponse, error) {
-	tasks, totalCount, err := s.taskRepo.GetTasks(ctx, req)
+	settings, err := s.settingsRepo.GetSettings(ctx, req.UserId)
 	if err != nil {
 		return nil, err
 	}
+	if req.Sort == pb.TaskSort_TASK_SORT_UNSPECIFIED {
+		req.Sort = settings.DefaultSort
+	}
+
+	due, err := dueRange(req, settings, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tasks, totalCount, err := s.taskRepo.GetTasks(ctx, req, due)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
 
 	return &pb.GetTasksResponse{
 		Tasks:      tasks,
@@ -114,11 +173,27 @@ func (s *TaskService) GetTasks(ctx context.Context, req *pb.GetTasksRequest) (*p
 	}, nil
 }
 
+// GetTask возвращает задачу по ID или по UID календаря
+func (s *TaskService) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error) {
+	var task *pb.DbTask
+	var err error
+	if req.IcalUid != "" {
+		task, err = s.taskRepo.GetTaskByICalUID(ctx, req.IcalUid, req.UserId)
+	} else {
+		task, err = s.taskRepo.GetTask(ctx, req.Id, req.UserId)
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.GetTaskResponse{Task: task}, nil
+}
+
 // DeleteTask удаляет задачу
 func (s *TaskService) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
-	success, err := s.taskRepo.DeleteTask(ctx, req.Id, req.UserId)
+	success, err := s.taskRepo.DeleteTask(ctx, req.Id, req.UserId, req.ExpectedVersion)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	if !success {
@@ -136,14 +211,629 @@ func (s *TaskService) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest)
 
 // CompleteTask отмечает задачу как выполненную
 func (s *TaskService) CompleteTask(ctx context.Context, req *pb.CompleteTaskRequest) (*pb.CompleteTaskResponse, error) {
-	task, err := s.taskRepo.CompleteTask(ctx, req.Id, req.UserId)
+	task, err := s.taskRepo.CompleteTask(ctx, req.Id, req.UserId, req.ExpectedVersion)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	return &pb.CompleteTaskResponse{
 		Id:          task.Id,
 		Completed:   task.Completed,
 		CompletedAt: task.CompletedAt,
+		Version:     task.Version,
+	}, nil
+}
+
+// UpdateTask изменяет название и описание задачи
+func (s *TaskService) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
+	task, err := s.taskRepo.UpdateTask(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.UpdateTaskResponse{Task: task}, nil
+}
+
+// GetTaskHistory возвращает историю изменений задачи
+func (s *TaskService) GetTaskHistory(ctx context.Context, req *pb.GetTaskHistoryRequest) (*pb.GetTaskHistoryResponse, error) {
+	revisions, totalCount, err := s.taskRepo.GetTaskHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTaskHistoryResponse{
+		Revisions:  revisions,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// RevertTask откатывает задачу к состоянию указанной ревизии
+func (s *TaskService) RevertTask(ctx context.Context, req *pb.RevertTaskRequest) (*pb.RevertTaskResponse, error) {
+	task, err := s.taskRepo.RevertTask(ctx, req.Id, req.UserId, req.Revision, req.ExpectedVersion)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.RevertTaskResponse{Task: task}, nil
+}
+
+// ArchiveTask переносит задачу в архив
+func (s *TaskService) ArchiveTask(ctx context.Context, req *pb.ArchiveTaskRequest) (*pb.ArchiveTaskResponse, error) {
+	task, err := s.taskRepo.ArchiveTask(ctx, req.Id, req.UserId, req.ExpectedVersion)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.ArchiveTaskResponse{Task: task}, nil
+}
+
+// UnarchiveTask возвращает задачу из архива
+func (s *TaskService) UnarchiveTask(ctx context.Context, req *pb.UnarchiveTaskRequest) (*pb.UnarchiveTaskResponse, error) {
+	task, err := s.taskRepo.UnarchiveTask(ctx, req.Id, req.UserId, req.ExpectedVersion)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.UnarchiveTaskResponse{Task: task}, nil
+}
+
+// ExportTasks передает клиенту все задачи пользователя по мере чтения из курсора
+func (s *TaskService) ExportTasks(req *pb.ExportTasksRequest, stream pb.DatabaseService_ExportTasksServer) error {
+	return s.taskRepo.ExportTasks(stream.Context(), req.UserId, func(task *pb.DbTask, listName string) error {
+		return stream.Send(&pb.ExportTasksResponse{
+			Task:     task,
+			ListName: listName,
+		})
+	})
+}
+
+// ImportTasks создает импортированные задачи в одной транзакции
+func (s *TaskService) ImportTasks(ctx context.Context, req *pb.ImportTasksRequest) (*pb.ImportTasksResponse, error) {
+	result, err := s.taskRepo.ImportTasks(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.ImportTasksResponse{
+		Tasks:        result.Created,
+		CreatedLists: result.CreatedLists,
+		UpdatedTasks: result.Updated,
+	}, nil
+}
+
+// GetArchivePolicy возвращает политику автоархивации пользователя
+func (s *TaskService) GetArchivePolicy(ctx context.Context, req *pb.GetArchivePolicyRequest) (*pb.GetArchivePolicyResponse, error) {
+	policy, err := s.archiveRepo.GetArchivePolicy(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetArchivePolicyResponse{Policy: policy}, nil
+}
+
+// SetArchivePolicy изменяет политику автоархивации пользователя
+func (s *TaskService) SetArchivePolicy(ctx context.Context, req *pb.SetArchivePolicyRequest) (*pb.SetArchivePolicyResponse, error) {
+	if req.ArchiveAfterDays <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "archive_after_days must be positive")
+	}
+
+	policy, err := s.archiveRepo.SetArchivePolicy(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetArchivePolicyResponse{Policy: policy}, nil
+}
+
+// GetCalendarToken возвращает токен календарной ленты пользователя
+func (s *TaskService) GetCalendarToken(ctx context.Context, req *pb.GetCalendarTokenRequest) (*pb.GetCalendarTokenResponse, error) {
+	token, err := s.calendarRepo.GetCalendarToken(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCalendarTokenResponse{Token: token}, nil
+}
+
+// ResetCalendarToken заменяет токен календарной ленты пользователя
+func (s *TaskService) ResetCalendarToken(ctx context.Context, req *pb.ResetCalendarTokenRequest) (*pb.ResetCalendarTokenResponse, error) {
+	token, err := s.calendarRepo.ResetCalendarToken(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResetCalendarTokenResponse{Token: token}, nil
+}
+
+// GetCalendarTokenOwner возвращает пользователя, которому принадлежит токен календарной ленты
+func (s *TaskService) GetCalendarTokenOwner(ctx context.Context, req *pb.GetCalendarTokenOwnerRequest) (*pb.GetCalendarTokenOwnerResponse, error) {
+	userID, err := s.calendarRepo.GetCalendarTokenOwner(ctx, req.Token)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.GetCalendarTokenOwnerResponse{UserId: userID}, nil
+}
+
+// CreateList создает список задач
+func (s *TaskService) CreateList(ctx context.Context, req *pb.CreateListRequest) (*pb.CreateListResponse, error) {
+	list, err := s.listRepo.CreateList(ctx, req.UserId, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateListResponse{List: list}, nil
+}
+
+// GetLists возвращает списки задач пользователя
+func (s *TaskService) GetLists(ctx context.Context, req *pb.GetListsRequest) (*pb.GetListsResponse, error) {
+	lists, err := s.listRepo.GetLists(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetListsResponse{Lists: lists}, nil
+}
+
+// DeleteList удаляет список задач
+func (s *TaskService) DeleteList(ctx context.Context, req *pb.DeleteListRequest) (*pb.DeleteListResponse, error) {
+	success, err := s.listRepo.DeleteList(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !success {
+		return &pb.DeleteListResponse{
+			Success: false,
+			Message: "list not found or access denied",
+		}, nil
+	}
+
+	if err := s.taskRepo.InvalidateCache(ctx, req.UserId); err != nil {
+		fmt.Printf("[CACHE ERROR] UserID: %s | %v\n", req.UserId, err)
+	}
+
+	return &pb.DeleteListResponse{
+		Success: true,
+		Message: "list deleted successfully",
+	}, nil
+}
+
+// CreateTemplate создает шаблон чек-листа
+func (s *TaskService) CreateTemplate(ctx context.Context, req *pb.CreateTemplateRequest) (*pb.CreateTemplateResponse, error) {
+	template, err := s.templateRepo.CreateTemplate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateTemplateResponse{Template: template}, nil
+}
+
+// GetTemplates возвращает шаблоны пользователя
+func (s *TaskService) GetTemplates(ctx context.Context, req *pb.GetTemplatesRequest) (*pb.GetTemplatesResponse, error) {
+	templates, err := s.templateRepo.GetTemplates(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTemplatesResponse{Templates: templates}, nil
+}
+
+// GetTemplate возвращает шаблон по ID
+func (s *TaskService) GetTemplate(ctx context.Context, req *pb.GetTemplateRequest) (*pb.GetTemplateResponse, error) {
+	template, err := s.templateRepo.GetTemplate(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.GetTemplateResponse{Template: template}, nil
+}
+
+// UpdateTemplate изменяет шаблон
+func (s *TaskService) UpdateTemplate(ctx context.Context, req *pb.UpdateTemplateRequest) (*pb.UpdateTemplateResponse, error) {
+	template, err := s.templateRepo.UpdateTemplate(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.UpdateTemplateResponse{Template: template}, nil
+}
+
+// DeleteTemplate удаляет шаблон
+func (s *TaskService) DeleteTemplate(ctx context.Context, req *pb.DeleteTemplateRequest) (*pb.DeleteTemplateResponse, error) {
+	success, err := s.templateRepo.DeleteTemplate(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !success {
+		return &pb.DeleteTemplateResponse{
+			Success: false,
+			Message: "template not found or access denied",
+		}, nil
+	}
+
+	return &pb.DeleteTemplateResponse{
+		Success: true,
+		Message: "template deleted successfully",
+	}, nil
+}
+
+// InstantiateTemplate создает задачи по шаблону
+func (s *TaskService) InstantiateTemplate(ctx context.Context, req *pb.InstantiateTemplateRequest) (*pb.InstantiateTemplateResponse, error) {
+	tasks, list, err := s.templateRepo.InstantiateTemplate(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	if err := s.taskRepo.InvalidateCache(ctx, req.UserId); err == nil {
+		fmt.Printf("[CACHE INVALIDATED] UserID: %s | Reason: Template instantiated\n", req.UserId)
+	}
+
+	return &pb.InstantiateTemplateResponse{
+		Tasks: tasks,
+		List:  list,
+	}, nil
+}
+
+// SaveListAsTemplate сохраняет список задач как шаблон
+func (s *TaskService) SaveListAsTemplate(ctx context.Context, req *pb.SaveListAsTemplateRequest) (*pb.SaveListAsTemplateResponse, error) {
+	template, err := s.templateRepo.SaveListAsTemplate(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.SaveListAsTemplateResponse{Template: template}, nil
+}
+
+// CreateWebhook создает вебхук
+func (s *TaskService) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	webhook, err := s.webhookRepo.CreateWebhook(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateWebhookResponse{Webhook: webhook}, nil
+}
+
+// GetWebhooks возвращает вебхуки пользователя
+func (s *TaskService) GetWebhooks(ctx context.Context, req *pb.GetWebhooksRequest) (*pb.GetWebhooksResponse, error) {
+	webhooks, err := s.webhookRepo.GetWebhooks(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetWebhooksResponse{Webhooks: webhooks}, nil
+}
+
+// GetWebhook возвращает вебхук по ID
+func (s *TaskService) GetWebhook(ctx context.Context, req *pb.GetWebhookRequest) (*pb.GetWebhookResponse, error) {
+	webhook, err := s.webhookRepo.GetWebhook(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.GetWebhookResponse{Webhook: webhook}, nil
+}
+
+// UpdateWebhook изменяет вебхук
+func (s *TaskService) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	webhook, err := s.webhookRepo.UpdateWebhook(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.UpdateWebhookResponse{Webhook: webhook}, nil
+}
+
+// DeleteWebhook удаляет вебхук
+func (s *TaskService) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	success, err := s.webhookRepo.DeleteWebhook(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteWebhookResponse{Success: success}, nil
+}
+
+// GetWebhookDeliveries возвращает журнал доставок вебхука
+func (s *TaskService) GetWebhookDeliveries(ctx context.Context, req *pb.GetWebhookDeliveriesRequest) (*pb.GetWebhookDeliveriesResponse, error) {
+	deliveries, err := s.webhookRepo.GetWebhookDeliveries(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.GetWebhookDeliveriesResponse{Deliveries: deliveries}, nil
+}
+
+// RedeliverWebhook ставит доставку в очередь повторно
+func (s *TaskService) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.RedeliverWebhookResponse, error) {
+	delivery, err := s.webhookRepo.RedeliverWebhook(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.RedeliverWebhookResponse{Delivery: delivery}, nil
+}
+
+// EnqueueWebhookDeliveries ставит событие в очередь доставки вебхукам пользователя
+func (s *TaskService) EnqueueWebhookDeliveries(ctx context.Context, req *pb.EnqueueWebhookDeliveriesRequest) (*pb.EnqueueWebhookDeliveriesResponse, error) {
+	count, err := s.webhookRepo.EnqueueWebhookDeliveries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EnqueueWebhookDeliveriesResponse{Count: int32(count)}, nil
+}
+
+// ClaimWebhookDeliveries выдает доставки, готовые к отправке
+func (s *TaskService) ClaimWebhookDeliveries(ctx context.Context, req *pb.ClaimWebhookDeliveriesRequest) (*pb.ClaimWebhookDeliveriesResponse, error) {
+	if req.Limit <= 0 || req.LeaseSeconds <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and lease_seconds must be positive")
+	}
+
+	deliveries, err := s.webhookRepo.ClaimWebhookDeliveries(ctx, req.Limit, req.LeaseSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ClaimWebhookDeliveriesResponse{Deliveries: deliveries}, nil
+}
+
+// CompleteWebhookDelivery записывает результат попытки доставки
+func (s *TaskService) CompleteWebhookDelivery(ctx context.Context, req *pb.CompleteWebhookDeliveryRequest) (*pb.CompleteWebhookDeliveryResponse, error) {
+	delivery, disabled, err := s.webhookRepo.CompleteWebhookDelivery(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CompleteWebhookDeliveryResponse{Delivery: delivery, WebhookDisabled: disabled}, nil
+}
+
+// CreateReminder создает напоминание о задаче
+func (s *TaskService) CreateReminder(ctx context.Context, req *pb.CreateReminderRequest) (*pb.CreateReminderResponse, error) {
+	if (req.RemindAt == nil) == (req.OffsetSeconds == nil) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of remind_at and offset_seconds must be set")
+	}
+	if req.OffsetSeconds != nil && *req.OffsetSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset_seconds must not be negative")
+	}
+
+	reminder, err := s.reminderRepo.CreateReminder(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CreateReminderResponse{Reminder: reminder}, nil
+}
+
+// GetReminders возвращает напоминания задачи
+func (s *TaskService) GetReminders(ctx context.Context, req *pb.GetRemindersRequest) (*pb.GetRemindersResponse, error) {
+	reminders, err := s.reminderRepo.GetReminders(ctx, req.TaskId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.GetRemindersResponse{Reminders: reminders}, nil
+}
+
+// DeleteReminder удаляет напоминание
+func (s *TaskService) DeleteReminder(ctx context.Context, req *pb.DeleteReminderRequest) (*pb.DeleteReminderResponse, error) {
+	success, err := s.reminderRepo.DeleteReminder(ctx, req.Id, req.TaskId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteReminderResponse{Success: success}, nil
+}
+
+// GetDigestSettings возвращает настройки дайджеста пользователя
+func (s *TaskService) GetDigestSettings(ctx context.Context, req *pb.GetDigestSettingsRequest) (*pb.GetDigestSettingsResponse, error) {
+	settings, err := s.digestRepo.GetDigestSettings(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetDigestSettingsResponse{Settings: settings}, nil
+}
+
+// SetDigestSettings изменяет настройки дайджеста; пустые поля получают значения по умолчанию
+func (s *TaskService) SetDigestSettings(ctx context.Context, req *pb.SetDigestSettingsRequest) (*pb.SetDigestSettingsResponse, error) {
+	if req.DeliveryTime == "" {
+		req.DeliveryTime = postgres.DefaultDigestDeliveryTime
+	}
+	if req.Format == "" {
+		req.Format = postgres.DefaultDigestFormat
+	}
+
+	if _, err := time.Parse("15:04", req.DeliveryTime); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "delivery_time must be in HH:MM format")
+	}
+	if !digest.ValidFormat(req.Format) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported digest format %q", req.Format)
+	}
+
+	settings, err := s.digestRepo.SetDigestSettings(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetDigestSettingsResponse{Settings: settings}, nil
+}
+
+// GetDigest собирает дайджест за день в часовом поясе пользователя
+func (s *TaskService) GetDigest(ctx context.Context, req *pb.GetDigestRequest) (*pb.GetDigestResponse, error) {
+	if req.Date != "" {
+		if _, err := time.Parse(usertime.DateLayout, req.Date); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "date must be in YYYY-MM-DD format")
+		}
+	}
+
+	settings, err := s.digestRepo.GetDigestSettings(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	format := req.Format
+	if format == "" {
+		format = settings.Format
+	}
+	if !digest.ValidFormat(format) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported digest format %q", format)
+	}
+
+	d, err := s.digests.Build(ctx, req.UserId, settings.TimeZone, req.Date)
+	if err != nil {
+		return nil, err
+	}
+	content, contentType, err := digest.Render(format, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetDigestResponse{
+		Digest: &pb.Digest{
+			Date:               d.Date.Format(usertime.DateLayout),
+			TimeZone:           settings.TimeZone,
+			DueToday:           d.DueToday,
+			Overdue:            d.Overdue,
+			CompletedYesterday: d.CompletedYesterday,
+			Format:             format,
+			ContentType:        contentType,
+			Content:            content,
+		},
 	}, nil
 }
+
+// GetStats возвращает статистику задач в часовом поясе пользователя
+func (s *TaskService) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*pb.GetStatsResponse, error) {
+	settings, err := s.settingsRepo.GetSettings(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := usertime.LoadLocation(settings.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsRepo.GetStats(ctx, req.UserId, loc, time.Weekday(settings.WeekStart), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetStatsResponse{Stats: stats}, nil
+}
+
+// GetSettings возвращает настройки пользователя
+func (s *TaskService) GetSettings(ctx context.Context, req *pb.GetSettingsRequest) (*pb.GetSettingsResponse, error) {
+	settings, err := s.settingsRepo.GetSettings(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetSettingsResponse{Settings: settings}, nil
+}
+
+// UpdateSettings изменяет заданные поля настроек пользователя
+func (s *TaskService) UpdateSettings(ctx context.Context, req *pb.UpdateSettingsRequest) (*pb.UpdateSettingsResponse, error) {
+	if req.TimeZone != nil {
+		if _, err := usertime.LoadLocation(*req.TimeZone); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown time zone %q", *req.TimeZone)
+		}
+	}
+	if req.Locale != nil {
+		tag, err := language.Parse(*req.Locale)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid locale %q", *req.Locale)
+		}
+		locale := tag.String()
+		req.Locale = &locale
+	}
+	if req.WeekStart != nil && (*req.WeekStart < int32(time.Sunday) || *req.WeekStart > int32(time.Saturday)) {
+		return nil, status.Error(codes.InvalidArgument, "week_start must be between 0 (Sunday) and 6 (Saturday)")
+	}
+	if req.DefaultSort != nil && *req.DefaultSort == pb.TaskSort_TASK_SORT_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "default_sort must be specified")
+	}
+
+	settings, err := s.settingsRepo.UpdateSettings(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.UpdateSettingsResponse{Settings: settings}, nil
+}
+
+// dueRange переводит фильтр по сроку из запроса в границы времени в часовом поясе пользователя.
+// Дата due_to включается в диапазон целиком.
+func dueRange(req *pb.GetTasksRequest, settings *pb.UserSettings, now time.Time) (postgres.DueRange, error) {
+	var due postgres.DueRange
+	if req.Due != pb.DueFilter_DUE_FILTER_UNSPECIFIED && (req.DueFrom != "" || req.DueTo != "") {
+		return due, errors.New("due cannot be combined with due_from and due_to")
+	}
+
+	loc, err := usertime.LoadLocation(settings.TimeZone)
+	if err != nil {
+		return due, err
+	}
+	today := usertime.StartOfDay(now, loc)
+
+	switch req.Due {
+	case pb.DueFilter_DUE_FILTER_UNSPECIFIED:
+	case pb.DueFilter_DUE_FILTER_TODAY:
+		to := today.AddDate(0, 0, 1)
+		due.From, due.To = &today, &to
+	case pb.DueFilter_DUE_FILTER_OVERDUE:
+		due.To = &today
+	case pb.DueFilter_DUE_FILTER_THIS_WEEK:
+		from := usertime.StartOfWeek(now, loc, time.Weekday(settings.WeekStart))
+		to := from.AddDate(0, 0, 7)
+		due.From, due.To = &from, &to
+	case pb.DueFilter_DUE_FILTER_NO_DUE_DATE:
+		due.WithoutDueDate = true
+	default:
+		return due, fmt.Errorf("unknown due filter %v", req.Due)
+	}
+
+	if req.DueFrom != "" {
+		from, err := usertime.ParseDate(req.DueFrom, loc)
+		if err != nil {
+			return due, err
+		}
+		due.From = &from
+	}
+	if req.DueTo != "" {
+		to, err := usertime.ParseDate(req.DueTo, loc)
+		if err != nil {
+			return due, err
+		}
+		to = to.AddDate(0, 0, 1)
+		due.To = &to
+	}
+	if due.From != nil && due.To != nil && !due.From.Before(*due.To) {
+		return due, errors.New("due_from must not be after due_to")
+	}
+
+	return due, nil
+}
+
+// toStatusError преобразует ошибки репозитория в gRPC статусы
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, postgres.ErrTaskNotFound), errors.Is(err, postgres.ErrRevisionNotFound),
+		errors.Is(err, postgres.ErrParentNotFound), errors.Is(err, postgres.ErrListNotFound),
+		errors.Is(err, postgres.ErrTemplateNotFound), errors.Is(err, postgres.ErrCalendarTokenNotFound),
+		errors.Is(err, postgres.ErrWebhookNotFound), errors.Is(err, postgres.ErrWebhookDeliveryNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, postgres.ErrNestedSubtask):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, postgres.ErrRevertToDeleted), errors.Is(err, postgres.ErrTaskArchived),
+		errors.Is(err, postgres.ErrTaskNotArchived), errors.Is(err, postgres.ErrSubtaskArchive),
+		errors.Is(err, postgres.ErrWebhookDisabled), errors.Is(err, postgres.ErrWebhookDeliveryPending):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, postgres.ErrVersionMismatch):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return err
+	}
+}
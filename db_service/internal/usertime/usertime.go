@@ -0,0 +1,42 @@
+// Package usertime вычисляет границы дней и недель в часовом поясе пользователя.
+package usertime
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // часовые пояса пользователей не зависят от tzdata в образе
+)
+
+// DateLayout - формат даты в API: YYYY-MM-DD
+const DateLayout = "2006-01-02"
+
+// LoadLocation загружает часовой пояс по имени IANA. В отличие от time.LoadLocation
+// не принимает пустое имя и "Local": пояс должен быть понятен и PostgreSQL.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	return time.LoadLocation(name)
+}
+
+// StartOfDay возвращает начало дня, в который попадает t, в часовом поясе loc
+func StartOfDay(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// StartOfWeek возвращает начало недели, в которую попадает t, если неделя начинается с weekStart
+func StartOfWeek(t time.Time, loc *time.Location, weekStart time.Weekday) time.Time {
+	day := StartOfDay(t, loc)
+	offset := (int(day.Weekday()) - int(weekStart) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// ParseDate разбирает дату YYYY-MM-DD и возвращает начало этого дня в часовом поясе loc
+func ParseDate(date string, loc *time.Location) (time.Time, error) {
+	day, err := time.ParseInLocation(DateLayout, date, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
+	}
+	return day, nil
+}
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/internal/repository/postgres"
+)
+
+// Archiver периодически применяет политики автоархивации пользователей
+type Archiver struct {
+	policyRepo postgres.ArchivePolicyRepositoryInterface
+	taskRepo   postgres.TaskRepositoryInterface
+	interval   time.Duration
+}
+
+func NewArchiver(policyRepo postgres.ArchivePolicyRepositoryInterface, taskRepo postgres.TaskRepositoryInterface, interval time.Duration) *Archiver {
+	return &Archiver{
+		policyRepo: policyRepo,
+		taskRepo:   taskRepo,
+		interval:   interval,
+	}
+}
+
+// Start запускает архивацию сразу и затем с заданным интервалом до отмены контекста
+func (a *Archiver) Start(ctx context.Context) {
+	log.Printf("Starting archive worker with interval %v", a.interval)
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		a.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping archive worker...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOnce архивирует просроченные задачи всех пользователей с включенной политикой.
+// Ошибка по одному пользователю не мешает обработать остальных.
+func (a *Archiver) runOnce(ctx context.Context) {
+	policies, err := a.policyRepo.GetEnabledArchivePolicies(ctx)
+	if err != nil {
+		log.Printf("Archive worker: failed to get policies: %v", err)
+		return
+	}
+
+	var total int
+	for _, policy := range policies {
+		archived, err := a.taskRepo.ArchiveExpiredTasks(ctx, policy.UserId, policy.ArchiveAfterDays)
+		if err != nil {
+			log.Printf("Archive worker: failed to archive tasks of user %s: %v", policy.UserId, err)
+			continue
+		}
+		total += archived
+	}
+
+	if total > 0 {
+		log.Printf("Archive worker: archived %d tasks", total)
+	}
+}
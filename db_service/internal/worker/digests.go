@@ -0,0 +1,143 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/internal/digest"
+	"github.com/bagdasarian/checklist-app/db_service/internal/notifier"
+	"github.com/bagdasarian/checklist-app/db_service/internal/repository/postgres"
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+)
+
+// Тип события дайджеста для вебхуков
+const DigestEvent = "digest.daily"
+
+// DigestScheduler раз в интервал отправляет дайджесты пользователям, у которых
+// в их часовом поясе наступило время отправки. Дайджест без задач не отправляется.
+type DigestScheduler struct {
+	digestRepo postgres.DigestRepositoryInterface
+	builder    *digest.Builder
+	notifier   notifier.Notifier
+	interval   time.Duration
+	batchSize  int
+}
+
+func NewDigestScheduler(digestRepo postgres.DigestRepositoryInterface, n notifier.Notifier, interval time.Duration, batchSize int) *DigestScheduler {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	return &DigestScheduler{
+		digestRepo: digestRepo,
+		builder:    digest.NewBuilder(digestRepo),
+		notifier:   n,
+		interval:   interval,
+		batchSize:  batchSize,
+	}
+}
+
+// Start проверяет дайджесты сразу и затем с заданным интервалом до отмены контекста
+func (s *DigestScheduler) Start(ctx context.Context) {
+	log.Printf("Starting digest worker with interval %v", s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping digest worker...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOnce отправляет дайджесты пачками. Неотправленные остаются в очереди
+// и повторяются при следующем запуске, поэтому цикл продолжается только после полной пачки отправленных.
+func (s *DigestScheduler) runOnce(ctx context.Context) {
+	for ctx.Err() == nil {
+		sent, failed, err := s.digestRepo.ProcessDueDigests(ctx, s.batchSize, func(settings *pb.DigestSettings, date string) error {
+			if err := s.send(ctx, settings, date); err != nil {
+				log.Printf("Digest worker: failed to send digest to user %s: %v", settings.UserId, err)
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("Digest worker: failed to process digests: %v", err)
+			return
+		}
+		if sent > 0 || failed > 0 {
+			log.Printf("Digest worker: processed %d digests, %d failed", sent, failed)
+		}
+		if sent < s.batchSize {
+			return
+		}
+	}
+}
+
+// digestPayload - тело вебхука digest.daily
+type digestPayload struct {
+	ID                 string    `json:"id"`
+	Type               string    `json:"type"`
+	OccurredAt         time.Time `json:"occurred_at"`
+	UserID             string    `json:"user_id"`
+	Date               string    `json:"date"`
+	TimeZone           string    `json:"time_zone"`
+	DueToday           int       `json:"due_today"`
+	Overdue            int       `json:"overdue"`
+	CompletedYesterday int       `json:"completed_yesterday"`
+	Format             string    `json:"format"`
+	Content            string    `json:"content"`
+}
+
+func (s *DigestScheduler) send(ctx context.Context, settings *pb.DigestSettings, date string) error {
+	d, err := s.builder.Build(ctx, settings.UserId, settings.TimeZone, date)
+	if err != nil {
+		return err
+	}
+	if d.Empty() {
+		return nil
+	}
+
+	content, _, err := digest.Render(settings.Format, d)
+	if err != nil {
+		return err
+	}
+
+	// Письмо всегда содержит текстовую версию, HTML добавляется для формата html
+	text, html := content, ""
+	if settings.Format == digest.FormatHTML {
+		html = content
+		if text, _, err = digest.Render(digest.FormatText, d); err != nil {
+			return err
+		}
+	}
+
+	id := "digest-" + settings.UserId + "-" + date
+	return s.notifier.Notify(ctx, notifier.Notification{
+		ID:      id,
+		UserID:  settings.UserId,
+		Event:   DigestEvent,
+		Subject: "Daily digest for " + date,
+		Text:    text,
+		HTML:    html,
+		Payload: digestPayload{
+			ID:                 id,
+			Type:               DigestEvent,
+			OccurredAt:         time.Now().UTC(),
+			UserID:             settings.UserId,
+			Date:               date,
+			TimeZone:           settings.TimeZone,
+			DueToday:           len(d.DueToday),
+			Overdue:            len(d.Overdue),
+			CompletedYesterday: len(d.CompletedYesterday),
+			Format:             settings.Format,
+			Content:            content,
+		},
+	})
+}
@@ -0,0 +1,128 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/internal/repository/postgres"
+)
+
+// Как часто удаляются старые отправленные события
+const outboxCleanupInterval = time.Hour
+
+// Сколько ждать публикации одной пачки; пока она идет, другие экземпляры не публикуют события
+const outboxPublishTimeout = 30 * time.Second
+
+// OutboxRelay публикует события из таблицы outbox в брокер. События публикует один экземпляр
+// db_service (блокировка в базе), пачка отправляется синхронно в порядке записи, поэтому события
+// пользователя (ключ сообщения) попадают в брокер в том порядке, в котором менялись задачи.
+// Событие, которое не удалось опубликовать, повторяется с растущей паузой, и следующие события того же
+// пользователя ждут его, поэтому возможны повторы, но не пропуски. После maxAttempts попыток событие
+// откладывается (failed_at в outbox), чтобы не задерживать остальные события пользователя навсегда.
+type OutboxRelay struct {
+	outboxRepo  postgres.OutboxRepositoryInterface
+	publisher   OutboxPublisher
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int
+	retention   time.Duration
+	lastCleanup time.Time
+}
+
+func NewOutboxRelay(outboxRepo postgres.OutboxRepositoryInterface, publisher OutboxPublisher, interval time.Duration, batchSize, maxAttempts int, retention time.Duration) *OutboxRelay {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = 20
+	}
+	return &OutboxRelay{
+		outboxRepo:  outboxRepo,
+		publisher:   publisher,
+		interval:    interval,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		retention:   retention,
+	}
+}
+
+// Start публикует события сразу и затем с заданным интервалом до отмены контекста
+func (r *OutboxRelay) Start(ctx context.Context) {
+	log.Printf("Starting outbox relay with interval %v", r.interval)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping outbox relay...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *OutboxRelay) Close() error {
+	return r.publisher.Close()
+}
+
+// runOnce публикует события пачками, пока очередные пачки полные и опубликованы без ошибок,
+// и раз в outboxCleanupInterval удаляет старые отправленные события
+func (r *OutboxRelay) runOnce(ctx context.Context) {
+	for ctx.Err() == nil {
+		sent, failed, parked, err := r.outboxRepo.ProcessPending(ctx, r.batchSize, r.maxAttempts, func(messages []postgres.OutboxMessage) []error {
+			return r.publish(ctx, messages)
+		})
+		if err != nil {
+			log.Printf("Outbox relay: failed to process events: %v", err)
+			return
+		}
+		if sent > 0 || failed > 0 {
+			log.Printf("Outbox relay: published %d events, %d failed", sent, failed)
+		}
+		if parked > 0 {
+			log.Printf("Outbox relay: %d events were not published after %d attempts and are parked", parked, r.maxAttempts)
+		}
+		if failed > 0 || sent < r.batchSize {
+			break
+		}
+	}
+
+	if time.Since(r.lastCleanup) >= outboxCleanupInterval {
+		r.lastCleanup = time.Now()
+		deleted, err := r.outboxRepo.DeleteSent(ctx, time.Now().Add(-r.retention))
+		if err != nil {
+			log.Printf("Outbox relay: failed to delete sent events: %v", err)
+		} else if deleted > 0 {
+			log.Printf("Outbox relay: deleted %d sent events", deleted)
+		}
+	}
+}
+
+// publish отправляет пачку событий и возвращает ошибку для каждого неопубликованного события.
+// После первой ошибки события того же пользователя тоже считаются неопубликованными,
+// чтобы при повторе они снова шли после нее.
+func (r *OutboxRelay) publish(ctx context.Context, messages []postgres.OutboxMessage) []error {
+	ctx, cancel := context.WithTimeout(ctx, outboxPublishTimeout)
+	defer cancel()
+	errs := r.publisher.Publish(ctx, messages)
+
+	failedUsers := make(map[string]error)
+	for i, m := range messages {
+		if errs[i] != nil {
+			if _, ok := failedUsers[m.UserID]; !ok {
+				failedUsers[m.UserID] = errs[i]
+			}
+			continue
+		}
+		if cause, ok := failedUsers[m.UserID]; ok {
+			errs[i] = fmt.Errorf("earlier event of the user was not published: %w", cause)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,196 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bagdasarian/checklist-app/db_service/config"
+	"github.com/bagdasarian/checklist-app/db_service/internal/outbox"
+	"github.com/bagdasarian/checklist-app/db_service/internal/repository/postgres"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
+)
+
+// OutboxPublisher отправляет пачку событий в брокер в порядке следования
+// и возвращает для каждого события ошибку публикации или nil
+type OutboxPublisher interface {
+	Publish(ctx context.Context, messages []postgres.OutboxMessage) []error
+	Close() error
+}
+
+// NewOutboxPublisher создает отправителя для брокера, выбранного в конфигурации
+func NewOutboxPublisher(ctx context.Context, cfg *config.Config, redisClient *redis.Client) (OutboxPublisher, error) {
+	switch cfg.Events.Backend {
+	case "", "kafka":
+		return NewKafkaOutboxPublisher(cfg.GetKafkaBrokers(), cfg.Kafka.Topic, cfg.Outbox.BatchSize), nil
+	case "redis":
+		return NewRedisOutboxPublisher(redisClient, cfg.Kafka.Topic, cfg.Events.StreamMaxLen), nil
+	case "nats":
+		return NewNATSOutboxPublisher(ctx, cfg.NATS.URL, cfg.NATS.Stream, cfg.Kafka.Topic)
+	default:
+		return nil, fmt.Errorf("unknown event backend: %s", cfg.Events.Backend)
+	}
+}
+
+// KafkaOutboxPublisher отправляет пачку одним запросом с подтверждением всеми репликами;
+// ключ сообщения - ID пользователя, поэтому его события попадают в одну партицию
+type KafkaOutboxPublisher struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaOutboxPublisher(brokers []string, topic string, batchSize int) *KafkaOutboxPublisher {
+	return &KafkaOutboxPublisher{
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Topic:                  topic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+			BatchSize:              batchSize,
+		},
+	}
+}
+
+func (p *KafkaOutboxPublisher) Publish(ctx context.Context, messages []postgres.OutboxMessage) []error {
+	batch := make([]kafka.Message, len(messages))
+	for i, m := range messages {
+		batch[i] = kafka.Message{
+			Key:     []byte(m.UserID),
+			Value:   m.Payload,
+			Headers: outbox.Headers(),
+		}
+	}
+
+	errs := make([]error, len(messages))
+	err := p.writer.WriteMessages(ctx, batch...)
+	if err == nil {
+		return errs
+	}
+
+	var writeErrs kafka.WriteErrors
+	if !errors.As(err, &writeErrs) {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+	copy(errs, writeErrs)
+	return errs
+}
+
+func (p *KafkaOutboxPublisher) Close() error {
+	return p.writer.Close()
+}
+
+// RedisOutboxPublisher добавляет события в поток Redis Streams по одному
+type RedisOutboxPublisher struct {
+	client *redis.Client
+	stream string
+	maxLen int64
+}
+
+func NewRedisOutboxPublisher(client *redis.Client, stream string, maxLen int64) *RedisOutboxPublisher {
+	return &RedisOutboxPublisher{
+		client: client,
+		stream: stream,
+		maxLen: maxLen,
+	}
+}
+
+func (p *RedisOutboxPublisher) Publish(ctx context.Context, messages []postgres.OutboxMessage) []error {
+	return publishInOrder(messages, func(m postgres.OutboxMessage) error {
+		values := map[string]interface{}{
+			outbox.RedisFieldKey:   m.UserID,
+			outbox.RedisFieldValue: m.Payload,
+		}
+		for _, h := range outbox.Headers() {
+			values[outbox.RedisHeaderPrefix+h.Key] = h.Value
+		}
+		return p.client.XAdd(ctx, &redis.XAddArgs{
+			Stream: p.stream,
+			MaxLen: p.maxLen,
+			Approx: true,
+			Values: values,
+		}).Err()
+	})
+}
+
+// Close ничего не делает: клиент Redis общий и закрывается в main
+func (p *RedisOutboxPublisher) Close() error {
+	return nil
+}
+
+// NATSOutboxPublisher публикует события в поток NATS JetStream по одному. Nats-Msg-Id - ID
+// события, поэтому повтор уже опубликованного события в окне дедупликации потока не создает дубль.
+type NATSOutboxPublisher struct {
+	conn    *nats.Conn
+	js      jetstream.JetStream
+	subject string
+}
+
+// NewNATSOutboxPublisher подключается к NATS и создает поток stream для subject, если его еще нет
+func NewNATSOutboxPublisher(ctx context.Context, url, stream, subject string) (*NATSOutboxPublisher, error) {
+	conn, err := nats.Connect(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     stream,
+		Subjects: []string{subject},
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create NATS stream %s: %w", stream, err)
+	}
+
+	return &NATSOutboxPublisher{
+		conn:    conn,
+		js:      js,
+		subject: subject,
+	}, nil
+}
+
+func (p *NATSOutboxPublisher) Publish(ctx context.Context, messages []postgres.OutboxMessage) []error {
+	return publishInOrder(messages, func(m postgres.OutboxMessage) error {
+		msg := nats.NewMsg(p.subject)
+		msg.Data = m.Payload
+		msg.Header.Set(outbox.NATSHeaderKey, m.UserID)
+		msg.Header.Set(jetstream.MsgIDHeader, m.EventID)
+		for _, h := range outbox.Headers() {
+			msg.Header.Set(h.Key, string(h.Value))
+		}
+		_, err := p.js.PublishMsg(ctx, msg)
+		return err
+	})
+}
+
+func (p *NATSOutboxPublisher) Close() error {
+	return p.conn.Drain()
+}
+
+// publishInOrder отправляет события по одному; после ошибки события того же пользователя
+// не отправляются, чтобы не обогнать неопубликованное событие
+func publishInOrder(messages []postgres.OutboxMessage, send func(m postgres.OutboxMessage) error) []error {
+	errs := make([]error, len(messages))
+	failedUsers := make(map[string]bool)
+	for i, m := range messages {
+		if failedUsers[m.UserID] {
+			continue
+		}
+		if err := send(m); err != nil {
+			errs[i] = err
+			failedUsers[m.UserID] = true
+		}
+	}
+	return errs
+}
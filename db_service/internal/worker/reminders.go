@@ -0,0 +1,164 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/db_service/internal/notifier"
+	"github.com/bagdasarian/checklist-app/db_service/internal/repository/postgres"
+	"github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Тип события напоминания для вебхуков
+const ReminderEvent = "task.reminder"
+
+// ReminderScheduler периодически отправляет наступившие напоминания.
+// Несколько экземпляров db_service могут работать одновременно: напоминание
+// занимается в базе на время отправки, поэтому отправит его только один из них.
+type ReminderScheduler struct {
+	reminderRepo postgres.ReminderRepositoryInterface
+	notifier     notifier.Notifier
+	interval     time.Duration
+	batchSize    int
+	maxAttempts  int
+}
+
+func NewReminderScheduler(reminderRepo postgres.ReminderRepositoryInterface, n notifier.Notifier, interval time.Duration, batchSize, maxAttempts int) *ReminderScheduler {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = 5
+	}
+	return &ReminderScheduler{
+		reminderRepo: reminderRepo,
+		notifier:     n,
+		interval:     interval,
+		batchSize:    batchSize,
+		maxAttempts:  maxAttempts,
+	}
+}
+
+// Start проверяет напоминания сразу и затем с заданным интервалом до отмены контекста
+func (s *ReminderScheduler) Start(ctx context.Context) {
+	log.Printf("Starting reminder worker with interval %v", s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping reminder worker...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOnce отправляет наступившие напоминания пачками, пока они не закончатся
+func (s *ReminderScheduler) runOnce(ctx context.Context) {
+	if _, err := s.reminderRepo.CancelStaleReminders(ctx); err != nil {
+		log.Printf("Reminder worker: failed to cancel stale reminders: %v", err)
+	}
+
+	for ctx.Err() == nil {
+		sent, failed, err := s.reminderRepo.ProcessDueReminders(ctx, s.batchSize, s.maxAttempts, func(reminder *pb.Reminder, task *pb.DbTask) error {
+			return s.notifier.Notify(ctx, reminderNotification(reminder, task))
+		})
+		if err != nil {
+			log.Printf("Reminder worker: failed to process reminders: %v", err)
+			return
+		}
+		if sent > 0 || failed > 0 {
+			log.Printf("Reminder worker: sent %d reminders, %d failed", sent, failed)
+		}
+		// Отложенные повторы в следующую пачку не попадут, поэтому пустой результат значит, что очередь разобрана
+		if sent+failed == 0 {
+			return
+		}
+	}
+}
+
+// reminderPayload - тело вебхука task.reminder
+type reminderPayload struct {
+	ID         string       `json:"id"`
+	Type       string       `json:"type"`
+	OccurredAt time.Time    `json:"occurred_at"`
+	UserID     string       `json:"user_id"`
+	TaskID     string       `json:"task_id"`
+	ReminderID string       `json:"reminder_id"`
+	FireAt     time.Time    `json:"fire_at"`
+	Task       reminderTask `json:"task"`
+}
+
+type reminderTask struct {
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Completed   bool       `json:"completed"`
+	Version     int64      `json:"version"`
+	Tags        []string   `json:"tags"`
+	ListID      string     `json:"list_id,omitempty"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+}
+
+// reminderNotification собирает уведомление; его ID зависит только от напоминания,
+// поэтому повторная попытка отправки распознается получателем как то же уведомление
+func reminderNotification(reminder *pb.Reminder, task *pb.DbTask) notifier.Notification {
+	id := "reminder-" + reminder.Id
+
+	text := fmt.Sprintf("Reminder: %s", task.Title)
+	dueAt := optionalTime(task.DueAt)
+	if dueAt != nil {
+		text = fmt.Sprintf("Reminder: %s is due at %s", task.Title, dueAt.UTC().Format("2006-01-02 15:04 MST"))
+	}
+	if task.Description != "" {
+		text += "\n\n" + task.Description
+	}
+
+	tags := task.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return notifier.Notification{
+		ID:      id,
+		UserID:  reminder.UserId,
+		Event:   ReminderEvent,
+		Subject: "Reminder: " + task.Title,
+		Text:    text,
+		Payload: reminderPayload{
+			ID:         id,
+			Type:       ReminderEvent,
+			OccurredAt: time.Now().UTC(),
+			UserID:     reminder.UserId,
+			TaskID:     task.Id,
+			ReminderID: reminder.Id,
+			FireAt:     reminder.FireAt.AsTime(),
+			Task: reminderTask{
+				ID:          task.Id,
+				Title:       task.Title,
+				Description: task.Description,
+				Completed:   task.Completed,
+				Version:     task.Version,
+				Tags:        tags,
+				ListID:      task.ListId,
+				DueAt:       dueAt,
+			},
+		},
+	}
+}
+
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
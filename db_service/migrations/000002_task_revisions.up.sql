@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS task_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    task_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    old_values JSONB,
+    new_values JSONB,
+    actor_id UUID,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (task_id, revision)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_revisions_user_id ON task_revisions(user_id, id);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS task_templates;
+ALTER TABLE tasks DROP COLUMN IF EXISTS list_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS parent_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS tags;
+ALTER TABLE tasks DROP COLUMN IF EXISTS due_at;
+DROP TABLE IF EXISTS task_lists;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_tasks_completed_at;
+DROP INDEX IF EXISTS idx_tasks_archived_at;
+DROP TABLE IF EXISTS archive_policies;
+ALTER TABLE tasks DROP COLUMN IF EXISTS archived_at;
@@ -0,0 +1,12 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITH TIME ZONE;
+
+-- Политика автоархивации: выполненные задачи переносятся в архив через archive_after_days дней
+CREATE TABLE IF NOT EXISTS archive_policies (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    enabled BOOLEAN NOT NULL DEFAULT false,
+    archive_after_days INTEGER NOT NULL DEFAULT 30 CHECK (archive_after_days > 0),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_tasks_archived_at ON tasks(archived_at);
+CREATE INDEX IF NOT EXISTS idx_tasks_completed_at ON tasks(completed_at) WHERE completed AND archived_at IS NULL;
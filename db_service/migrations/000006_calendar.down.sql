@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_tasks_user_ical_uid;
+DROP TABLE IF EXISTS calendar_tokens;
+ALTER TABLE tasks DROP COLUMN IF EXISTS ical_uid;
@@ -0,0 +1,14 @@
+-- UID задачи в iCalendar: по нему повторный импорт .ics обновляет задачу, а не создает копию
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS ical_uid TEXT;
+UPDATE tasks SET ical_uid = id::text WHERE ical_uid IS NULL;
+ALTER TABLE tasks ALTER COLUMN ical_uid SET DEFAULT gen_random_uuid()::text;
+ALTER TABLE tasks ALTER COLUMN ical_uid SET NOT NULL;
+
+-- Секретные токены календарной ленты /v1/calendar/{token}.ics, по одному на пользователя
+CREATE TABLE IF NOT EXISTS calendar_tokens (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    token VARCHAR(64) UNIQUE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tasks_user_ical_uid ON tasks(user_id, ical_uid);
@@ -0,0 +1,20 @@
+-- Напоминания о задачах: на время remind_at или за offset_seconds до срока задачи.
+-- Воркер занимает наступившее напоминание через FOR UPDATE SKIP LOCKED, перенося retry_at на время
+-- отправки, поэтому несколько экземпляров db_service не отправят одно напоминание дважды
+CREATE TABLE IF NOT EXISTS task_reminders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    remind_at TIMESTAMP WITH TIME ZONE,
+    offset_seconds BIGINT CHECK (offset_seconds >= 0),
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'failed', 'cancelled')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    retry_at TIMESTAMP WITH TIME ZONE,
+    sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CHECK ((remind_at IS NULL) <> (offset_seconds IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_reminders_task_id ON task_reminders(task_id);
+CREATE INDEX IF NOT EXISTS idx_task_reminders_pending ON task_reminders(task_id) WHERE status = 'pending';
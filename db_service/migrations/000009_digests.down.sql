@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_tasks_user_due_at;
+DROP TABLE IF EXISTS digest_settings;
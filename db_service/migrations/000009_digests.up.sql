@@ -0,0 +1,14 @@
+-- Настройки ежедневного дайджеста: время отправки delivery_time задается в часовом поясе time_zone.
+-- last_sent_on - локальная дата последней отправки, по ней дайджест отправляется не больше раза в день
+CREATE TABLE IF NOT EXISTS digest_settings (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    enabled BOOLEAN NOT NULL DEFAULT false,
+    delivery_time TIME NOT NULL DEFAULT '08:00',
+    time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    format VARCHAR(16) NOT NULL DEFAULT 'text' CHECK (format IN ('text', 'markdown', 'html')),
+    last_sent_on DATE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_digest_settings_enabled ON digest_settings(user_id) WHERE enabled;
+CREATE INDEX IF NOT EXISTS idx_tasks_user_due_at ON tasks(user_id, due_at) WHERE completed IS NOT TRUE AND archived_at IS NULL;
@@ -0,0 +1,8 @@
+ALTER TABLE digest_settings ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+DO $$
+BEGIN
+    IF to_regclass('user_settings') IS NOT NULL THEN
+        UPDATE digest_settings d SET time_zone = s.time_zone FROM user_settings s WHERE s.user_id = d.user_id;
+    END IF;
+END $$;
+DROP TABLE IF EXISTS user_settings;
@@ -0,0 +1,18 @@
+-- Настройки пользователя: часовой пояс для дат "сегодня" и "просрочено", локаль, первый день недели
+-- (0 - воскресенье, как time.Weekday), список для новых задач и порядок задач по умолчанию
+CREATE TABLE IF NOT EXISTS user_settings (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    locale VARCHAR(35) NOT NULL DEFAULT 'en',
+    week_start SMALLINT NOT NULL DEFAULT 1 CHECK (week_start BETWEEN 0 AND 6),
+    default_list_id UUID REFERENCES task_lists(id) ON DELETE SET NULL,
+    default_sort VARCHAR(16) NOT NULL DEFAULT 'created_desc' CHECK (default_sort IN ('created_desc', 'created_asc', 'due_asc', 'title_asc')),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Часовой пояс дайджеста переносится в настройки пользователя
+INSERT INTO user_settings (user_id, time_zone)
+SELECT user_id, time_zone FROM digest_settings
+ON CONFLICT (user_id) DO NOTHING;
+
+ALTER TABLE digest_settings DROP COLUMN IF EXISTS time_zone;
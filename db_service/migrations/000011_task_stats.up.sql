@@ -0,0 +1,2 @@
+-- Индекс для статистики выполнения задач пользователя, включая архивные
+CREATE INDEX IF NOT EXISTS idx_tasks_user_completed_at ON tasks(user_id, completed_at) WHERE completed;
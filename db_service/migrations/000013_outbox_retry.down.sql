@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_outbox_pending_user;
+DROP INDEX IF EXISTS idx_outbox_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(id) WHERE sent_at IS NULL;
+
+ALTER TABLE outbox DROP COLUMN IF EXISTS failed_at;
+ALTER TABLE outbox DROP COLUMN IF EXISTS retry_at;
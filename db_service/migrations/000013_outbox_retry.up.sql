@@ -0,0 +1,9 @@
+-- Повторы публикации outbox: событие, которое не удалось опубликовать, повторяется после retry_at
+-- (события того же пользователя ждут его), а после OUTBOX_MAX_ATTEMPTS попыток откладывается
+-- (failed_at) и больше не публикуется, чтобы не задерживать следующие события пользователя
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS retry_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS failed_at TIMESTAMP WITH TIME ZONE;
+
+DROP INDEX IF EXISTS idx_outbox_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(id) WHERE sent_at IS NULL AND failed_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_pending_user ON outbox(user_id, id) WHERE sent_at IS NULL AND failed_at IS NULL;
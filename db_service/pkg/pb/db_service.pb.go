@@ -22,6 +22,174 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Набор задач, возвращаемых GetTasks
+type TaskView int32
+
+const (
+	TaskView_TASK_VIEW_UNSPECIFIED TaskView = 0
+	TaskView_TASK_VIEW_ACTIVE      TaskView = 1 // невыполненные и неархивные
+	TaskView_TASK_VIEW_COMPLETED   TaskView = 2 // выполненные и неархивные
+	TaskView_TASK_VIEW_ARCHIVED    TaskView = 3 // архивные
+	TaskView_TASK_VIEW_ALL         TaskView = 4 // все задачи
+)
+
+// Enum value maps for TaskView.
+var (
+	TaskView_name = map[int32]string{
+		0: "TASK_VIEW_UNSPECIFIED",
+		1: "TASK_VIEW_ACTIVE",
+		2: "TASK_VIEW_COMPLETED",
+		3: "TASK_VIEW_ARCHIVED",
+		4: "TASK_VIEW_ALL",
+	}
+	TaskView_value = map[string]int32{
+		"TASK_VIEW_UNSPECIFIED": 0,
+		"TASK_VIEW_ACTIVE":      1,
+		"TASK_VIEW_COMPLETED":   2,
+		"TASK_VIEW_ARCHIVED":    3,
+		"TASK_VIEW_ALL":         4,
+	}
+)
+
+func (x TaskView) Enum() *TaskView {
+	p := new(TaskView)
+	*p = x
+	return p
+}
+
+func (x TaskView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskView) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[0].Descriptor()
+}
+
+func (TaskView) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[0]
+}
+
+func (x TaskView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskView.Descriptor instead.
+func (TaskView) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Фильтр задач по сроку; границы дня и недели считаются в часовом поясе пользователя
+type DueFilter int32
+
+const (
+	DueFilter_DUE_FILTER_UNSPECIFIED DueFilter = 0
+	DueFilter_DUE_FILTER_TODAY       DueFilter = 1 // срок сегодня
+	DueFilter_DUE_FILTER_OVERDUE     DueFilter = 2 // срок раньше начала сегодняшнего дня
+	DueFilter_DUE_FILTER_THIS_WEEK   DueFilter = 3 // срок на этой неделе, неделя начинается с week_start
+	DueFilter_DUE_FILTER_NO_DUE_DATE DueFilter = 4 // без срока
+)
+
+// Enum value maps for DueFilter.
+var (
+	DueFilter_name = map[int32]string{
+		0: "DUE_FILTER_UNSPECIFIED",
+		1: "DUE_FILTER_TODAY",
+		2: "DUE_FILTER_OVERDUE",
+		3: "DUE_FILTER_THIS_WEEK",
+		4: "DUE_FILTER_NO_DUE_DATE",
+	}
+	DueFilter_value = map[string]int32{
+		"DUE_FILTER_UNSPECIFIED": 0,
+		"DUE_FILTER_TODAY":       1,
+		"DUE_FILTER_OVERDUE":     2,
+		"DUE_FILTER_THIS_WEEK":   3,
+		"DUE_FILTER_NO_DUE_DATE": 4,
+	}
+)
+
+func (x DueFilter) Enum() *DueFilter {
+	p := new(DueFilter)
+	*p = x
+	return p
+}
+
+func (x DueFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DueFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[1].Descriptor()
+}
+
+func (DueFilter) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[1]
+}
+
+func (x DueFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DueFilter.Descriptor instead.
+func (DueFilter) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{1}
+}
+
+// Порядок задач в GetTasks
+type TaskSort int32
+
+const (
+	TaskSort_TASK_SORT_UNSPECIFIED  TaskSort = 0
+	TaskSort_TASK_SORT_CREATED_DESC TaskSort = 1 // сначала новые
+	TaskSort_TASK_SORT_CREATED_ASC  TaskSort = 2 // сначала старые
+	TaskSort_TASK_SORT_DUE_ASC      TaskSort = 3 // по сроку, задачи без срока в конце
+	TaskSort_TASK_SORT_TITLE_ASC    TaskSort = 4 // по названию
+)
+
+// Enum value maps for TaskSort.
+var (
+	TaskSort_name = map[int32]string{
+		0: "TASK_SORT_UNSPECIFIED",
+		1: "TASK_SORT_CREATED_DESC",
+		2: "TASK_SORT_CREATED_ASC",
+		3: "TASK_SORT_DUE_ASC",
+		4: "TASK_SORT_TITLE_ASC",
+	}
+	TaskSort_value = map[string]int32{
+		"TASK_SORT_UNSPECIFIED":  0,
+		"TASK_SORT_CREATED_DESC": 1,
+		"TASK_SORT_CREATED_ASC":  2,
+		"TASK_SORT_DUE_ASC":      3,
+		"TASK_SORT_TITLE_ASC":    4,
+	}
+)
+
+func (x TaskSort) Enum() *TaskSort {
+	p := new(TaskSort)
+	*p = x
+	return p
+}
+
+func (x TaskSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[2].Descriptor()
+}
+
+func (TaskSort) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[2]
+}
+
+func (x TaskSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskSort.Descriptor instead.
+func (TaskSort) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{2}
+}
+
 // Сообщения для пользователей
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -381,6 +549,10 @@ type CreateTaskRequest struct {
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	ParentId      string                 `protobuf:"bytes,6,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // ID родительской задачи для подзадачи
+	ListId        string                 `protobuf:"bytes,7,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -436,12 +608,46 @@ func (x *CreateTaskRequest) GetUserId() string {
 	return ""
 }
 
+func (x *CreateTaskRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
 type GetTasksRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	IncludeCompleted bool                   `protobuf:"varint,2,opt,name=include_completed,json=includeCompleted,proto3" json:"include_completed,omitempty"`
 	Limit            int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset           int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	ListId           string                 `protobuf:"bytes,5,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`           // фильтр по списку, пусто - все задачи
+	View             TaskView               `protobuf:"varint,6,opt,name=view,proto3,enum=checklist.db.TaskView" json:"view,omitempty"` // если не задан, используется include_completed без архивных задач
+	Due              DueFilter              `protobuf:"varint,7,opt,name=due,proto3,enum=checklist.db.DueFilter" json:"due,omitempty"`
+	DueFrom          string                 `protobuf:"bytes,8,opt,name=due_from,json=dueFrom,proto3" json:"due_from,omitempty"`         // YYYY-MM-DD, срок не раньше этого дня в часовом поясе пользователя
+	DueTo            string                 `protobuf:"bytes,9,opt,name=due_to,json=dueTo,proto3" json:"due_to,omitempty"`               // YYYY-MM-DD, срок не позже этого дня
+	Sort             TaskSort               `protobuf:"varint,10,opt,name=sort,proto3,enum=checklist.db.TaskSort" json:"sort,omitempty"` // если не задан, используется default_sort из настроек
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -504,12 +710,55 @@ func (x *GetTasksRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *GetTasksRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *GetTasksRequest) GetView() TaskView {
+	if x != nil {
+		return x.View
+	}
+	return TaskView_TASK_VIEW_UNSPECIFIED
+}
+
+func (x *GetTasksRequest) GetDue() DueFilter {
+	if x != nil {
+		return x.Due
+	}
+	return DueFilter_DUE_FILTER_UNSPECIFIED
+}
+
+func (x *GetTasksRequest) GetDueFrom() string {
+	if x != nil {
+		return x.DueFrom
+	}
+	return ""
+}
+
+func (x *GetTasksRequest) GetDueTo() string {
+	if x != nil {
+		return x.DueTo
+	}
+	return ""
+}
+
+func (x *GetTasksRequest) GetSort() TaskSort {
+	if x != nil {
+		return x.Sort
+	}
+	return TaskSort_TASK_SORT_UNSPECIFIED
+}
+
 type DeleteTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 - без проверки версии
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DeleteTaskRequest) Reset() {
@@ -556,12 +805,20 @@ func (x *DeleteTaskRequest) GetUserId() string {
 	return ""
 }
 
+func (x *DeleteTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type CompleteTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 - без проверки версии
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CompleteTaskRequest) Reset() {
@@ -608,33 +865,41 @@ func (x *CompleteTaskRequest) GetUserId() string {
 	return ""
 }
 
-type CreateTaskResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Completed     bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CompleteTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
 }
 
-func (x *CreateTaskResponse) Reset() {
-	*x = CreateTaskResponse{}
+type UpdateTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title           *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description     *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 - без проверки версии
+	DueAt           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	ClearDueAt      bool                   `protobuf:"varint,7,opt,name=clear_due_at,json=clearDueAt,proto3" json:"clear_due_at,omitempty"`
+	Tags            *TaskTags              `protobuf:"bytes,8,opt,name=tags,proto3" json:"tags,omitempty"` // если задано, теги заменяются целиком
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
 	mi := &file_db_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskResponse) String() string {
+func (x *UpdateTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskResponse) ProtoMessage() {}
+func (*UpdateTaskRequest) ProtoMessage() {}
 
-func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_db_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -646,82 +911,88 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
 	return file_db_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateTaskResponse) GetId() string {
+func (x *UpdateTaskRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateTaskResponse) GetUserId() string {
+func (x *UpdateTaskRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateTaskResponse) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x *UpdateTaskRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
 	}
 	return ""
 }
 
-func (x *CreateTaskResponse) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *UpdateTaskRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
 	return ""
 }
 
-func (x *CreateTaskResponse) GetCompleted() bool {
+func (x *UpdateTaskRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.Completed
+		return x.ExpectedVersion
 	}
-	return false
+	return 0
 }
 
-func (x *CreateTaskResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *UpdateTaskRequest) GetDueAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.DueAt
 	}
 	return nil
 }
 
-func (x *CreateTaskResponse) GetCompletedAt() *timestamppb.Timestamp {
+func (x *UpdateTaskRequest) GetClearDueAt() bool {
 	if x != nil {
-		return x.CompletedAt
+		return x.ClearDueAt
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetTags() *TaskTags {
+	if x != nil {
+		return x.Tags
 	}
 	return nil
 }
 
-type GetTasksResponse struct {
+type TaskTags struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*DbTask              `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTasksResponse) Reset() {
-	*x = GetTasksResponse{}
+func (x *TaskTags) Reset() {
+	*x = TaskTags{}
 	mi := &file_db_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTasksResponse) String() string {
+func (x *TaskTags) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTasksResponse) ProtoMessage() {}
+func (*TaskTags) ProtoMessage() {}
 
-func (x *GetTasksResponse) ProtoReflect() protoreflect.Message {
+func (x *TaskTags) ProtoReflect() protoreflect.Message {
 	mi := &file_db_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -733,47 +1004,50 @@ func (x *GetTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTasksResponse.ProtoReflect.Descriptor instead.
-func (*GetTasksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskTags.ProtoReflect.Descriptor instead.
+func (*TaskTags) Descriptor() ([]byte, []int) {
 	return file_db_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetTasksResponse) GetTasks() []*DbTask {
+func (x *TaskTags) GetValues() []string {
 	if x != nil {
-		return x.Tasks
+		return x.Values
 	}
 	return nil
 }
 
-func (x *GetTasksResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-type DeleteTaskResponse struct {
+type CreateTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Completed     bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Version       int64                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Tags          []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	ParentId      string                 `protobuf:"bytes,11,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	ListId        string                 `protobuf:"bytes,12,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskResponse) Reset() {
-	*x = DeleteTaskResponse{}
+func (x *CreateTaskResponse) Reset() {
+	*x = CreateTaskResponse{}
 	mi := &file_db_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskResponse) String() string {
+func (x *CreateTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskResponse) ProtoMessage() {}
+func (*CreateTaskResponse) ProtoMessage() {}
 
-func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_db_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -785,113 +1059,6061 @@ func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
 	return file_db_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DeleteTaskResponse) GetSuccess() bool {
+func (x *CreateTaskResponse) GetId() string {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-func (x *DeleteTaskResponse) GetMessage() string {
+func (x *CreateTaskResponse) GetUserId() string {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
 	return ""
 }
 
-type CompleteTaskResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Completed     bool                   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateTaskResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *CompleteTaskResponse) Reset() {
-	*x = CompleteTaskResponse{}
-	mi := &file_db_service_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateTaskResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *CompleteTaskResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateTaskResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
 }
 
-func (*CompleteTaskResponse) ProtoMessage() {}
+func (x *CreateTaskResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CreateTaskResponse) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *CreateTaskResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CreateTaskResponse) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *CreateTaskResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateTaskResponse) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateTaskResponse) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+type GetTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*DbTask              `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTasksResponse) Reset() {
+	*x = GetTasksResponse{}
+	mi := &file_db_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTasksResponse) ProtoMessage() {}
+
+func (x *GetTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetTasksResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTasksResponse) GetTasks() []*DbTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetTasksResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IcalUid       string                 `protobuf:"bytes,3,opt,name=ical_uid,json=icalUid,proto3" json:"ical_uid,omitempty"` // Если задан, задача ищется по UID календаря вместо id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_db_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTaskRequest) GetIcalUid() string {
+	if x != nil {
+		return x.IcalUid
+	}
+	return ""
+}
+
+type GetTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *DbTask                `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskResponse) Reset() {
+	*x = GetTaskResponse{}
+	mi := &file_db_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskResponse) ProtoMessage() {}
+
+func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetTaskResponse) GetTask() *DbTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type DeleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskResponse) Reset() {
+	*x = DeleteTaskResponse{}
+	mi := &file_db_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskResponse) ProtoMessage() {}
+
+func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteTaskResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteTaskResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CompleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Completed     bool                   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Version       int64                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTaskResponse) Reset() {
+	*x = CompleteTaskResponse{}
+	mi := &file_db_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTaskResponse) ProtoMessage() {}
+
+func (x *CompleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*CompleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CompleteTaskResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteTaskResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *CompleteTaskResponse) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *CompleteTaskResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *DbTask                `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskResponse) Reset() {
+	*x = UpdateTaskResponse{}
+	mi := &file_db_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskResponse) ProtoMessage() {}
+
+func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateTaskResponse) GetTask() *DbTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type DbTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Completed     bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Version       int64                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"` // увеличивается при каждом изменении задачи
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Tags          []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	ParentId      string                 `protobuf:"bytes,11,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // пусто для задач верхнего уровня
+	ListId        string                 `protobuf:"bytes,12,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"` // пусто для неархивных задач
+	IcalUid       string                 `protobuf:"bytes,14,opt,name=ical_uid,json=icalUid,proto3" json:"ical_uid,omitempty"`          // UID задачи в iCalendar, уникален в пределах пользователя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DbTask) Reset() {
+	*x = DbTask{}
+	mi := &file_db_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DbTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DbTask) ProtoMessage() {}
+
+func (x *DbTask) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DbTask.ProtoReflect.Descriptor instead.
+func (*DbTask) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DbTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DbTask) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DbTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DbTask) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DbTask) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *DbTask) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DbTask) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *DbTask) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DbTask) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *DbTask) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *DbTask) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *DbTask) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *DbTask) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *DbTask) GetIcalUid() string {
+	if x != nil {
+		return x.IcalUid
+	}
+	return ""
+}
+
+type ArchiveTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 - без проверки версии
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ArchiveTaskRequest) Reset() {
+	*x = ArchiveTaskRequest{}
+	mi := &file_db_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTaskRequest) ProtoMessage() {}
+
+func (x *ArchiveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTaskRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ArchiveTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ArchiveTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ArchiveTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ArchiveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *DbTask                `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTaskResponse) Reset() {
+	*x = ArchiveTaskResponse{}
+	mi := &file_db_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTaskResponse) ProtoMessage() {}
+
+func (x *ArchiveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTaskResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ArchiveTaskResponse) GetTask() *DbTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type UnarchiveTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 - без проверки версии
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnarchiveTaskRequest) Reset() {
+	*x = UnarchiveTaskRequest{}
+	mi := &file_db_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTaskRequest) ProtoMessage() {}
+
+func (x *UnarchiveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnarchiveTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnarchiveTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnarchiveTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UnarchiveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *DbTask                `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveTaskResponse) Reset() {
+	*x = UnarchiveTaskResponse{}
+	mi := &file_db_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTaskResponse) ProtoMessage() {}
+
+func (x *UnarchiveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UnarchiveTaskResponse) GetTask() *DbTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// Выгрузка задач: подзадачи идут сразу после родительской задачи
+type ExportTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTasksRequest) Reset() {
+	*x = ExportTasksRequest{}
+	mi := &file_db_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTasksRequest) ProtoMessage() {}
+
+func (x *ExportTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTasksRequest.ProtoReflect.Descriptor instead.
+func (*ExportTasksRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportTasksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ExportTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *DbTask                `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	ListName      string                 `protobuf:"bytes,2,opt,name=list_name,json=listName,proto3" json:"list_name,omitempty"` // пусто, если задача не в списке
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTasksResponse) Reset() {
+	*x = ExportTasksResponse{}
+	mi := &file_db_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTasksResponse) ProtoMessage() {}
+
+func (x *ExportTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTasksResponse.ProtoReflect.Descriptor instead.
+func (*ExportTasksResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExportTasksResponse) GetTask() *DbTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *ExportTasksResponse) GetListName() string {
+	if x != nil {
+		return x.ListName
+	}
+	return ""
+}
+
+// Импорт задач: все задачи создаются в одной транзакции
+type ImportTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"` // список для задач без list_name, пусто - без списка
+	Items         []*ImportTaskItem      `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTasksRequest) Reset() {
+	*x = ImportTasksRequest{}
+	mi := &file_db_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTasksRequest) ProtoMessage() {}
+
+func (x *ImportTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTasksRequest.ProtoReflect.Descriptor instead.
+func (*ImportTasksRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportTasksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportTasksRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *ImportTasksRequest) GetItems() []*ImportTaskItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ImportTaskItem struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Completed   bool                   `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	DueAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Tags        []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	ListName    string                 `protobuf:"bytes,7,opt,name=list_name,json=listName,proto3" json:"list_name,omitempty"` // список ищется по имени и создается, если его нет
+	Subtasks    []*ImportTaskItem      `protobuf:"bytes,8,rep,name=subtasks,proto3" json:"subtasks,omitempty"`                 // допускается один уровень подзадач
+	// Если задан и у пользователя есть задача с таким UID, она обновляется вместо создания новой
+	IcalUid string `protobuf:"bytes,9,opt,name=ical_uid,json=icalUid,proto3" json:"ical_uid,omitempty"`
+	// Если не 0, задача с ical_uid должна существовать и иметь эту версию, иначе Aborted
+	ExpectedVersion int64 `protobuf:"varint,10,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImportTaskItem) Reset() {
+	*x = ImportTaskItem{}
+	mi := &file_db_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTaskItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTaskItem) ProtoMessage() {}
+
+func (x *ImportTaskItem) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTaskItem.ProtoReflect.Descriptor instead.
+func (*ImportTaskItem) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportTaskItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportTaskItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportTaskItem) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *ImportTaskItem) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *ImportTaskItem) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *ImportTaskItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImportTaskItem) GetListName() string {
+	if x != nil {
+		return x.ListName
+	}
+	return ""
+}
+
+func (x *ImportTaskItem) GetSubtasks() []*ImportTaskItem {
+	if x != nil {
+		return x.Subtasks
+	}
+	return nil
+}
+
+func (x *ImportTaskItem) GetIcalUid() string {
+	if x != nil {
+		return x.IcalUid
+	}
+	return ""
+}
+
+func (x *ImportTaskItem) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ImportTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*DbTask              `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"` // созданные задачи
+	CreatedLists  []*TaskList            `protobuf:"bytes,2,rep,name=created_lists,json=createdLists,proto3" json:"created_lists,omitempty"`
+	UpdatedTasks  []*DbTask              `protobuf:"bytes,3,rep,name=updated_tasks,json=updatedTasks,proto3" json:"updated_tasks,omitempty"` // задачи, найденные по ical_uid и измененные импортом
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTasksResponse) Reset() {
+	*x = ImportTasksResponse{}
+	mi := &file_db_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTasksResponse) ProtoMessage() {}
+
+func (x *ImportTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTasksResponse.ProtoReflect.Descriptor instead.
+func (*ImportTasksResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImportTasksResponse) GetTasks() []*DbTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ImportTasksResponse) GetCreatedLists() []*TaskList {
+	if x != nil {
+		return x.CreatedLists
+	}
+	return nil
+}
+
+func (x *ImportTasksResponse) GetUpdatedTasks() []*DbTask {
+	if x != nil {
+		return x.UpdatedTasks
+	}
+	return nil
+}
+
+// Сообщения для настроек пользователя
+type UserSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`                  // IANA, например Europe/Moscow
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                                      // BCP 47, например ru-RU
+	WeekStart     int32                  `protobuf:"varint,4,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`              // первый день недели: 0 - воскресенье, 1 - понедельник, ... 6 - суббота
+	DefaultListId string                 `protobuf:"bytes,5,opt,name=default_list_id,json=defaultListId,proto3" json:"default_list_id,omitempty"` // список для новых задач без list_id, пусто - без списка
+	DefaultSort   TaskSort               `protobuf:"varint,6,opt,name=default_sort,json=defaultSort,proto3,enum=checklist.db.TaskSort" json:"default_sort,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSettings) Reset() {
+	*x = UserSettings{}
+	mi := &file_db_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettings) ProtoMessage() {}
+
+func (x *UserSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettings.ProtoReflect.Descriptor instead.
+func (*UserSettings) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UserSettings) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserSettings) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *UserSettings) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *UserSettings) GetWeekStart() int32 {
+	if x != nil {
+		return x.WeekStart
+	}
+	return 0
+}
+
+func (x *UserSettings) GetDefaultListId() string {
+	if x != nil {
+		return x.DefaultListId
+	}
+	return ""
+}
+
+func (x *UserSettings) GetDefaultSort() TaskSort {
+	if x != nil {
+		return x.DefaultSort
+	}
+	return TaskSort_TASK_SORT_UNSPECIFIED
+}
+
+func (x *UserSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsRequest) Reset() {
+	*x = GetSettingsRequest{}
+	mi := &file_db_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsRequest) ProtoMessage() {}
+
+func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSettingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *UserSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsResponse) Reset() {
+	*x = GetSettingsResponse{}
+	mi := &file_db_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsResponse) ProtoMessage() {}
+
+func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetSettingsResponse) GetSettings() *UserSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// Изменяются только заданные поля
+type UpdateSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TimeZone      *string                `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`
+	Locale        *string                `protobuf:"bytes,3,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	WeekStart     *int32                 `protobuf:"varint,4,opt,name=week_start,json=weekStart,proto3,oneof" json:"week_start,omitempty"`
+	DefaultListId *string                `protobuf:"bytes,5,opt,name=default_list_id,json=defaultListId,proto3,oneof" json:"default_list_id,omitempty"` // пустая строка убирает список по умолчанию
+	DefaultSort   *TaskSort              `protobuf:"varint,6,opt,name=default_sort,json=defaultSort,proto3,enum=checklist.db.TaskSort,oneof" json:"default_sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsRequest) Reset() {
+	*x = UpdateSettingsRequest{}
+	mi := &file_db_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateSettingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetWeekStart() int32 {
+	if x != nil && x.WeekStart != nil {
+		return *x.WeekStart
+	}
+	return 0
+}
+
+func (x *UpdateSettingsRequest) GetDefaultListId() string {
+	if x != nil && x.DefaultListId != nil {
+		return *x.DefaultListId
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetDefaultSort() TaskSort {
+	if x != nil && x.DefaultSort != nil {
+		return *x.DefaultSort
+	}
+	return TaskSort_TASK_SORT_UNSPECIFIED
+}
+
+type UpdateSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *UserSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsResponse) Reset() {
+	*x = UpdateSettingsResponse{}
+	mi := &file_db_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateSettingsResponse) GetSettings() *UserSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// Сообщения для политики автоархивации
+type ArchivePolicy struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Enabled          bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ArchiveAfterDays int32                  `protobuf:"varint,3,opt,name=archive_after_days,json=archiveAfterDays,proto3" json:"archive_after_days,omitempty"` // архивировать задачи, выполненные больше N дней назад
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ArchivePolicy) Reset() {
+	*x = ArchivePolicy{}
+	mi := &file_db_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePolicy) ProtoMessage() {}
+
+func (x *ArchivePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePolicy.ProtoReflect.Descriptor instead.
+func (*ArchivePolicy) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ArchivePolicy) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ArchivePolicy) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ArchivePolicy) GetArchiveAfterDays() int32 {
+	if x != nil {
+		return x.ArchiveAfterDays
+	}
+	return 0
+}
+
+func (x *ArchivePolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetArchivePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArchivePolicyRequest) Reset() {
+	*x = GetArchivePolicyRequest{}
+	mi := &file_db_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchivePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivePolicyRequest) ProtoMessage() {}
+
+func (x *GetArchivePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivePolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetArchivePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetArchivePolicyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetArchivePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *ArchivePolicy         `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArchivePolicyResponse) Reset() {
+	*x = GetArchivePolicyResponse{}
+	mi := &file_db_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchivePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivePolicyResponse) ProtoMessage() {}
+
+func (x *GetArchivePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivePolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetArchivePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetArchivePolicyResponse) GetPolicy() *ArchivePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetArchivePolicyRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Enabled          bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ArchiveAfterDays int32                  `protobuf:"varint,3,opt,name=archive_after_days,json=archiveAfterDays,proto3" json:"archive_after_days,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetArchivePolicyRequest) Reset() {
+	*x = SetArchivePolicyRequest{}
+	mi := &file_db_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetArchivePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetArchivePolicyRequest) ProtoMessage() {}
+
+func (x *SetArchivePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetArchivePolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetArchivePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetArchivePolicyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetArchivePolicyRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetArchivePolicyRequest) GetArchiveAfterDays() int32 {
+	if x != nil {
+		return x.ArchiveAfterDays
+	}
+	return 0
+}
+
+type SetArchivePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *ArchivePolicy         `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetArchivePolicyResponse) Reset() {
+	*x = SetArchivePolicyResponse{}
+	mi := &file_db_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetArchivePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetArchivePolicyResponse) ProtoMessage() {}
+
+func (x *SetArchivePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetArchivePolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetArchivePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetArchivePolicyResponse) GetPolicy() *ArchivePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// Сообщения для истории изменений задач
+type GetTaskHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskHistoryRequest) Reset() {
+	*x = GetTaskHistoryRequest{}
+	mi := &file_db_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskHistoryRequest) ProtoMessage() {}
+
+func (x *GetTaskHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetTaskHistoryRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetTaskHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTaskHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTaskHistoryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetTaskHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*TaskRevision        `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskHistoryResponse) Reset() {
+	*x = GetTaskHistoryResponse{}
+	mi := &file_db_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskHistoryResponse) ProtoMessage() {}
+
+func (x *GetTaskHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetTaskHistoryResponse) GetRevisions() []*TaskRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *GetTaskHistoryResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type RevertTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Revision        int32                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`                                      // номер ревизии, к состоянию после которой откатывается задача
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // 0 - без проверки версии
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RevertTaskRequest) Reset() {
+	*x = RevertTaskRequest{}
+	mi := &file_db_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertTaskRequest) ProtoMessage() {}
+
+func (x *RevertTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertTaskRequest.ProtoReflect.Descriptor instead.
+func (*RevertTaskRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RevertTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevertTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevertTaskRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RevertTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type RevertTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *DbTask                `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertTaskResponse) Reset() {
+	*x = RevertTaskResponse{}
+	mi := &file_db_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertTaskResponse) ProtoMessage() {}
+
+func (x *RevertTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertTaskResponse.ProtoReflect.Descriptor instead.
+func (*RevertTaskResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RevertTaskResponse) GetTask() *DbTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// Ревизия задачи: состояние до и после изменения
+type TaskRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	OldTask       *DbTask                `protobuf:"bytes,5,opt,name=old_task,json=oldTask,proto3" json:"old_task,omitempty"` // пусто для создания
+	NewTask       *DbTask                `protobuf:"bytes,6,opt,name=new_task,json=newTask,proto3" json:"new_task,omitempty"` // пусто для удаления
+	ActorId       string                 `protobuf:"bytes,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskRevision) Reset() {
+	*x = TaskRevision{}
+	mi := &file_db_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRevision) ProtoMessage() {}
+
+func (x *TaskRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRevision.ProtoReflect.Descriptor instead.
+func (*TaskRevision) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TaskRevision) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaskRevision) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *TaskRevision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TaskRevision) GetOldTask() *DbTask {
+	if x != nil {
+		return x.OldTask
+	}
+	return nil
+}
+
+func (x *TaskRevision) GetNewTask() *DbTask {
+	if x != nil {
+		return x.NewTask
+	}
+	return nil
+}
+
+func (x *TaskRevision) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *TaskRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Сообщения для списков задач
+type TaskList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskList) Reset() {
+	*x = TaskList{}
+	mi := &file_db_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskList) ProtoMessage() {}
+
+func (x *TaskList) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskList.ProtoReflect.Descriptor instead.
+func (*TaskList) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TaskList) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaskList) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TaskList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskList) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateListRequest) Reset() {
+	*x = CreateListRequest{}
+	mi := &file_db_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListRequest) ProtoMessage() {}
+
+func (x *CreateListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListRequest.ProtoReflect.Descriptor instead.
+func (*CreateListRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateListRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *TaskList              `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateListResponse) Reset() {
+	*x = CreateListResponse{}
+	mi := &file_db_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListResponse) ProtoMessage() {}
+
+func (x *CreateListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListResponse.ProtoReflect.Descriptor instead.
+func (*CreateListResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateListResponse) GetList() *TaskList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type GetListsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListsRequest) Reset() {
+	*x = GetListsRequest{}
+	mi := &file_db_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListsRequest) ProtoMessage() {}
+
+func (x *GetListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListsRequest.ProtoReflect.Descriptor instead.
+func (*GetListsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetListsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetListsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lists         []*TaskList            `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListsResponse) Reset() {
+	*x = GetListsResponse{}
+	mi := &file_db_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListsResponse) ProtoMessage() {}
+
+func (x *GetListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListsResponse.ProtoReflect.Descriptor instead.
+func (*GetListsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetListsResponse) GetLists() []*TaskList {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+type DeleteListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteListRequest) Reset() {
+	*x = DeleteListRequest{}
+	mi := &file_db_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListRequest) ProtoMessage() {}
+
+func (x *DeleteListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListRequest.ProtoReflect.Descriptor instead.
+func (*DeleteListRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteListRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteListRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteListResponse) Reset() {
+	*x = DeleteListResponse{}
+	mi := &file_db_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListResponse) ProtoMessage() {}
+
+func (x *DeleteListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListResponse.ProtoReflect.Descriptor instead.
+func (*DeleteListResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteListResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Заготовка задачи в шаблоне
+type TaskBlueprint struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Смещение срока относительно момента создания задач из шаблона
+	DueOffsetSeconds *int64           `protobuf:"varint,3,opt,name=due_offset_seconds,json=dueOffsetSeconds,proto3,oneof" json:"due_offset_seconds,omitempty"`
+	Tags             []string         `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Subtasks         []*TaskBlueprint `protobuf:"bytes,5,rep,name=subtasks,proto3" json:"subtasks,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TaskBlueprint) Reset() {
+	*x = TaskBlueprint{}
+	mi := &file_db_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskBlueprint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskBlueprint) ProtoMessage() {}
+
+func (x *TaskBlueprint) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskBlueprint.ProtoReflect.Descriptor instead.
+func (*TaskBlueprint) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TaskBlueprint) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TaskBlueprint) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskBlueprint) GetDueOffsetSeconds() int64 {
+	if x != nil && x.DueOffsetSeconds != nil {
+		return *x.DueOffsetSeconds
+	}
+	return 0
+}
+
+func (x *TaskBlueprint) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TaskBlueprint) GetSubtasks() []*TaskBlueprint {
+	if x != nil {
+		return x.Subtasks
+	}
+	return nil
+}
+
+type TaskTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Items         []*TaskBlueprint       `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTemplate) Reset() {
+	*x = TaskTemplate{}
+	mi := &file_db_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTemplate) ProtoMessage() {}
+
+func (x *TaskTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTemplate.ProtoReflect.Descriptor instead.
+func (*TaskTemplate) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TaskTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetItems() []*TaskBlueprint {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *TaskTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaskTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Items         []*TaskBlueprint       `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_db_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateTemplateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetItems() []*TaskBlueprint {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *TaskTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateResponse) Reset() {
+	*x = CreateTemplateResponse{}
+	mi := &file_db_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateTemplateResponse) GetTemplate() *TaskTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplatesRequest) Reset() {
+	*x = GetTemplatesRequest{}
+	mi := &file_db_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplatesRequest) ProtoMessage() {}
+
+func (x *GetTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetTemplatesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*TaskTemplate        `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplatesResponse) Reset() {
+	*x = GetTemplatesResponse{}
+	mi := &file_db_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplatesResponse) ProtoMessage() {}
+
+func (x *GetTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*GetTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetTemplatesResponse) GetTemplates() []*TaskTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type GetTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	mi := &file_db_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTemplateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *TaskTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateResponse) Reset() {
+	*x = GetTemplateResponse{}
+	mi := &file_db_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateResponse) ProtoMessage() {}
+
+func (x *GetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetTemplateResponse) GetTemplate() *TaskTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Items         []*TaskBlueprint       `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateRequest) Reset() {
+	*x = UpdateTemplateRequest{}
+	mi := &file_db_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetItems() []*TaskBlueprint {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *TaskTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateResponse) Reset() {
+	*x = UpdateTemplateResponse{}
+	mi := &file_db_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateTemplateResponse) GetTemplate() *TaskTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_db_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTemplateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateResponse) Reset() {
+	*x = DeleteTemplateResponse{}
+	mi := &file_db_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type InstantiateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ListId        string                 `protobuf:"bytes,3,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`                  // существующий список для задач
+	NewListName   string                 `protobuf:"bytes,4,opt,name=new_list_name,json=newListName,proto3" json:"new_list_name,omitempty"` // или имя нового списка
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`               // точка отсчета сроков, по умолчанию - сейчас
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateTemplateRequest) Reset() {
+	*x = InstantiateTemplateRequest{}
+	mi := &file_db_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateRequest) ProtoMessage() {}
+
+func (x *InstantiateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *InstantiateTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetNewListName() string {
+	if x != nil {
+		return x.NewListName
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+type InstantiateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*DbTask              `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	List          *TaskList              `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"` // пусто, если задачи созданы вне списка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateTemplateResponse) Reset() {
+	*x = InstantiateTemplateResponse{}
+	mi := &file_db_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateResponse) ProtoMessage() {}
+
+func (x *InstantiateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *InstantiateTemplateResponse) GetTasks() []*DbTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *InstantiateTemplateResponse) GetList() *TaskList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type SaveListAsTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	BaseTime      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=base_time,json=baseTime,proto3" json:"base_time,omitempty"` // точка отсчета смещений, по умолчанию - создание списка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveListAsTemplateRequest) Reset() {
+	*x = SaveListAsTemplateRequest{}
+	mi := &file_db_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveListAsTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveListAsTemplateRequest) ProtoMessage() {}
+
+func (x *SaveListAsTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveListAsTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SaveListAsTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SaveListAsTemplateRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *SaveListAsTemplateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SaveListAsTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveListAsTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SaveListAsTemplateRequest) GetBaseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BaseTime
+	}
+	return nil
+}
+
+type SaveListAsTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *TaskTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveListAsTemplateResponse) Reset() {
+	*x = SaveListAsTemplateResponse{}
+	mi := &file_db_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveListAsTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveListAsTemplateResponse) ProtoMessage() {}
+
+func (x *SaveListAsTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveListAsTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SaveListAsTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SaveListAsTemplateResponse) GetTemplate() *TaskTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// Новое сообщение для пользователя
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_db_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Сообщения для токенов календарной ленты
+type CalendarToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarToken) Reset() {
+	*x = CalendarToken{}
+	mi := &file_db_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarToken) ProtoMessage() {}
+
+func (x *CalendarToken) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarToken.ProtoReflect.Descriptor instead.
+func (*CalendarToken) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CalendarToken) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CalendarToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CalendarToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetCalendarTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarTokenRequest) Reset() {
+	*x = GetCalendarTokenRequest{}
+	mi := &file_db_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarTokenRequest) ProtoMessage() {}
+
+func (x *GetCalendarTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarTokenRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetCalendarTokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetCalendarTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *CalendarToken         `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarTokenResponse) Reset() {
+	*x = GetCalendarTokenResponse{}
+	mi := &file_db_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarTokenResponse) ProtoMessage() {}
+
+func (x *GetCalendarTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarTokenResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetCalendarTokenResponse) GetToken() *CalendarToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type ResetCalendarTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetCalendarTokenRequest) Reset() {
+	*x = ResetCalendarTokenRequest{}
+	mi := &file_db_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetCalendarTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCalendarTokenRequest) ProtoMessage() {}
+
+func (x *ResetCalendarTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetCalendarTokenRequest.ProtoReflect.Descriptor instead.
+func (*ResetCalendarTokenRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ResetCalendarTokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ResetCalendarTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *CalendarToken         `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetCalendarTokenResponse) Reset() {
+	*x = ResetCalendarTokenResponse{}
+	mi := &file_db_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetCalendarTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCalendarTokenResponse) ProtoMessage() {}
+
+func (x *ResetCalendarTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetCalendarTokenResponse.ProtoReflect.Descriptor instead.
+func (*ResetCalendarTokenResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ResetCalendarTokenResponse) GetToken() *CalendarToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type GetCalendarTokenOwnerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarTokenOwnerRequest) Reset() {
+	*x = GetCalendarTokenOwnerRequest{}
+	mi := &file_db_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarTokenOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarTokenOwnerRequest) ProtoMessage() {}
+
+func (x *GetCalendarTokenOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarTokenOwnerRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarTokenOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetCalendarTokenOwnerRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetCalendarTokenOwnerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarTokenOwnerResponse) Reset() {
+	*x = GetCalendarTokenOwnerResponse{}
+	mi := &file_db_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarTokenOwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarTokenOwnerResponse) ProtoMessage() {}
+
+func (x *GetCalendarTokenOwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarTokenOwnerResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarTokenOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetCalendarTokenOwnerResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Сообщения для вебхуков
+type Webhook struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Secret         string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`                           // ключ подписи HMAC-SHA256
+	EventTypes     []string               `protobuf:"bytes,5,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // пусто - все события
+	Enabled        bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	FailureCount   int32                  `protobuf:"varint,7,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`      // неудачных доставок подряд
+	DisabledReason string                 `protobuf:"bytes,8,opt,name=disabled_reason,json=disabledReason,proto3" json:"disabled_reason,omitempty"` // причина автоматического отключения
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_db_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Webhook) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *Webhook) GetDisabledReason() string {
+	if x != nil {
+		return x.DisabledReason
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Доставка события вебхуку, вместе с результатом последней попытки
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId      string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // уникальный ID события, одинаковый при повторной доставке
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload        string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"` // JSON тела запроса
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`   // pending, delivered или failed
+	Attempts       int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastStatusCode int32                  `protobuf:"varint,8,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError      string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	RedeliveryOf   string                 `protobuf:"bytes,11,opt,name=redelivery_of,json=redeliveryOf,proto3" json:"redelivery_of,omitempty"` // ID исходной доставки для повторной
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_db_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetRedeliveryOf() string {
+	if x != nil {
+		return x.RedeliveryOf
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_db_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreateWebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_db_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type GetWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhooksRequest) Reset() {
+	*x = GetWebhooksRequest{}
+	mi := &file_db_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhooksRequest) ProtoMessage() {}
+
+func (x *GetWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetWebhooksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhooksResponse) Reset() {
+	*x = GetWebhooksResponse{}
+	mi := &file_db_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhooksResponse) ProtoMessage() {}
+
+func (x *GetWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type GetWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookRequest) Reset() {
+	*x = GetWebhookRequest{}
+	mi := &file_db_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookRequest) ProtoMessage() {}
+
+func (x *GetWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetWebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookResponse) Reset() {
+	*x = GetWebhookResponse{}
+	mi := &file_db_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookResponse) ProtoMessage() {}
+
+func (x *GetWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type UpdateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"` // пусто - секрет не меняется
+	EventTypes    []string               `protobuf:"bytes,5,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Enabled       *bool                  `protobuf:"varint,6,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"` // включение сбрасывает счетчик неудач
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_db_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type UpdateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	mi := &file_db_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UpdateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_db_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DeleteWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteWebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_db_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DeleteWebhookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookDeliveriesRequest) Reset() {
+	*x = GetWebhookDeliveriesRequest{}
+	mi := &file_db_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *GetWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *GetWebhookDeliveriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetWebhookDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetWebhookDeliveriesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookDeliveriesResponse) Reset() {
+	*x = GetWebhookDeliveriesResponse{}
+	mi := &file_db_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *GetWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	DeliveryId    string                 `protobuf:"bytes,2,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_db_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *RedeliverWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *RedeliverWebhookRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *RedeliverWebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RedeliverWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"` // новая доставка в очереди
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookResponse) Reset() {
+	*x = RedeliverWebhookResponse{}
+	mi := &file_db_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RedeliverWebhookResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+// Ставит событие в очередь всем включенным вебхукам пользователя, подписанным на event_type.
+// Повтор с тем же event_id не создает доставок.
+type EnqueueWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload       string                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnqueueWebhookDeliveriesRequest) Reset() {
+	*x = EnqueueWebhookDeliveriesRequest{}
+	mi := &file_db_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnqueueWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *EnqueueWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*EnqueueWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *EnqueueWebhookDeliveriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EnqueueWebhookDeliveriesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EnqueueWebhookDeliveriesRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *EnqueueWebhookDeliveriesRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type EnqueueWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // создано доставок
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnqueueWebhookDeliveriesResponse) Reset() {
+	*x = EnqueueWebhookDeliveriesResponse{}
+	mi := &file_db_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnqueueWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *EnqueueWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*EnqueueWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *EnqueueWebhookDeliveriesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Выдает доставки, время попытки которых наступило, и откладывает их на lease_seconds,
+// чтобы их не взял другой экземпляр
+type ClaimWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	LeaseSeconds  int32                  `protobuf:"varint,2,opt,name=lease_seconds,json=leaseSeconds,proto3" json:"lease_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimWebhookDeliveriesRequest) Reset() {
+	*x = ClaimWebhookDeliveriesRequest{}
+	mi := &file_db_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ClaimWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ClaimWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ClaimWebhookDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ClaimWebhookDeliveriesRequest) GetLeaseSeconds() int32 {
+	if x != nil {
+		return x.LeaseSeconds
+	}
+	return 0
+}
+
+type ClaimedWebhookDelivery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimedWebhookDelivery) Reset() {
+	*x = ClaimedWebhookDelivery{}
+	mi := &file_db_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimedWebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimedWebhookDelivery) ProtoMessage() {}
+
+func (x *ClaimedWebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimedWebhookDelivery.ProtoReflect.Descriptor instead.
+func (*ClaimedWebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ClaimedWebhookDelivery) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+func (x *ClaimedWebhookDelivery) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ClaimedWebhookDelivery) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ClaimWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Deliveries    []*ClaimedWebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimWebhookDeliveriesResponse) Reset() {
+	*x = ClaimWebhookDeliveriesResponse{}
+	mi := &file_db_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ClaimWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ClaimWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ClaimWebhookDeliveriesResponse) GetDeliveries() []*ClaimedWebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type CompleteWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 0, если ответ не получен
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	RetryAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=retry_at,json=retryAt,proto3" json:"retry_at,omitempty"`                 // время следующей попытки; пусто - попытки исчерпаны
+	DisableAfter  int32                  `protobuf:"varint,6,opt,name=disable_after,json=disableAfter,proto3" json:"disable_after,omitempty"` // отключить вебхук после стольких неудачных доставок подряд, 0 - не отключать
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteWebhookDeliveryRequest) Reset() {
+	*x = CompleteWebhookDeliveryRequest{}
+	mi := &file_db_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *CompleteWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*CompleteWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CompleteWebhookDeliveryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteWebhookDeliveryRequest) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CompleteWebhookDeliveryRequest) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CompleteWebhookDeliveryRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CompleteWebhookDeliveryRequest) GetRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetryAt
+	}
+	return nil
+}
+
+func (x *CompleteWebhookDeliveryRequest) GetDisableAfter() int32 {
+	if x != nil {
+		return x.DisableAfter
+	}
+	return 0
+}
+
+type CompleteWebhookDeliveryResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Delivery        *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	WebhookDisabled bool                   `protobuf:"varint,2,opt,name=webhook_disabled,json=webhookDisabled,proto3" json:"webhook_disabled,omitempty"` // вебхук отключен этой неудачей
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompleteWebhookDeliveryResponse) Reset() {
+	*x = CompleteWebhookDeliveryResponse{}
+	mi := &file_db_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteWebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *CompleteWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*CompleteWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CompleteWebhookDeliveryResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+func (x *CompleteWebhookDeliveryResponse) GetWebhookDisabled() bool {
+	if x != nil {
+		return x.WebhookDisabled
+	}
+	return false
+}
+
+// Сообщения для напоминаний
+type Reminder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RemindAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`                       // абсолютное время
+	OffsetSeconds *int64                 `protobuf:"varint,5,opt,name=offset_seconds,json=offsetSeconds,proto3,oneof" json:"offset_seconds,omitempty"` // или смещение до срока задачи
+	FireAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fire_at,json=fireAt,proto3" json:"fire_at,omitempty"`                             // время срабатывания; пусто, если у задачи нет срока
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                                           // pending, sent, failed или cancelled
+	Attempts      int32                  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reminder) Reset() {
+	*x = Reminder{}
+	mi := &file_db_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reminder) ProtoMessage() {}
+
+func (x *Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reminder.ProtoReflect.Descriptor instead.
+func (*Reminder) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Reminder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reminder) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Reminder) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Reminder) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetOffsetSeconds() int64 {
+	if x != nil && x.OffsetSeconds != nil {
+		return *x.OffsetSeconds
+	}
+	return 0
+}
+
+func (x *Reminder) GetFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FireAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Reminder) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Reminder) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Reminder) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RemindAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	OffsetSeconds *int64                 `protobuf:"varint,4,opt,name=offset_seconds,json=offsetSeconds,proto3,oneof" json:"offset_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReminderRequest) Reset() {
+	*x = CreateReminderRequest{}
+	mi := &file_db_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReminderRequest) ProtoMessage() {}
+
+func (x *CreateReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReminderRequest.ProtoReflect.Descriptor instead.
+func (*CreateReminderRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CreateReminderRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CreateReminderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateReminderRequest) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *CreateReminderRequest) GetOffsetSeconds() int64 {
+	if x != nil && x.OffsetSeconds != nil {
+		return *x.OffsetSeconds
+	}
+	return 0
+}
+
+type CreateReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminder      *Reminder              `protobuf:"bytes,1,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReminderResponse) Reset() {
+	*x = CreateReminderResponse{}
+	mi := &file_db_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReminderResponse) ProtoMessage() {}
+
+func (x *CreateReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReminderResponse.ProtoReflect.Descriptor instead.
+func (*CreateReminderResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CreateReminderResponse) GetReminder() *Reminder {
+	if x != nil {
+		return x.Reminder
+	}
+	return nil
+}
+
+type GetRemindersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRemindersRequest) Reset() {
+	*x = GetRemindersRequest{}
+	mi := &file_db_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRemindersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRemindersRequest) ProtoMessage() {}
+
+func (x *GetRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRemindersRequest.ProtoReflect.Descriptor instead.
+func (*GetRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetRemindersRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetRemindersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetRemindersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminders     []*Reminder            `protobuf:"bytes,1,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRemindersResponse) Reset() {
+	*x = GetRemindersResponse{}
+	mi := &file_db_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRemindersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRemindersResponse) ProtoMessage() {}
+
+func (x *GetRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRemindersResponse.ProtoReflect.Descriptor instead.
+func (*GetRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetRemindersResponse) GetReminders() []*Reminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+type DeleteReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReminderRequest) Reset() {
+	*x = DeleteReminderRequest{}
+	mi := &file_db_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReminderRequest) ProtoMessage() {}
+
+func (x *DeleteReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReminderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReminderRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DeleteReminderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteReminderRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DeleteReminderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReminderResponse) Reset() {
+	*x = DeleteReminderResponse{}
+	mi := &file_db_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReminderResponse) ProtoMessage() {}
+
+func (x *DeleteReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReminderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReminderResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DeleteReminderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Сообщения для дайджеста
+type DigestSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	DeliveryTime  string                 `protobuf:"bytes,3,opt,name=delivery_time,json=deliveryTime,proto3" json:"delivery_time,omitempty"` // HH:MM в часовом поясе time_zone
+	TimeZone      string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`             // часовой пояс из настроек пользователя
+	Format        string                 `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`                                 // text, markdown или html
+	LastSentOn    string                 `protobuf:"bytes,6,opt,name=last_sent_on,json=lastSentOn,proto3" json:"last_sent_on,omitempty"`     // YYYY-MM-DD, пусто, если дайджест еще не отправлялся
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigestSettings) Reset() {
+	*x = DigestSettings{}
+	mi := &file_db_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestSettings) ProtoMessage() {}
+
+func (x *DigestSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestSettings.ProtoReflect.Descriptor instead.
+func (*DigestSettings) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DigestSettings) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *DigestSettings) GetDeliveryTime() string {
+	if x != nil {
+		return x.DeliveryTime
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetLastSentOn() string {
+	if x != nil {
+		return x.LastSentOn
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetDigestSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestSettingsRequest) Reset() {
+	*x = GetDigestSettingsRequest{}
+	mi := &file_db_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestSettingsRequest) ProtoMessage() {}
+
+func (x *GetDigestSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetDigestSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetDigestSettingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetDigestSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *DigestSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestSettingsResponse) Reset() {
+	*x = GetDigestSettingsResponse{}
+	mi := &file_db_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestSettingsResponse) ProtoMessage() {}
+
+func (x *GetDigestSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetDigestSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetDigestSettingsResponse) GetSettings() *DigestSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SetDigestSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	DeliveryTime  string                 `protobuf:"bytes,3,opt,name=delivery_time,json=deliveryTime,proto3" json:"delivery_time,omitempty"`
+	Format        string                 `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDigestSettingsRequest) Reset() {
+	*x = SetDigestSettingsRequest{}
+	mi := &file_db_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigestSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigestSettingsRequest) ProtoMessage() {}
+
+func (x *SetDigestSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigestSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetDigestSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SetDigestSettingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetDigestSettingsRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetDigestSettingsRequest) GetDeliveryTime() string {
+	if x != nil {
+		return x.DeliveryTime
+	}
+	return ""
+}
+
+func (x *SetDigestSettingsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type SetDigestSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *DigestSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDigestSettingsResponse) Reset() {
+	*x = SetDigestSettingsResponse{}
+	mi := &file_db_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigestSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigestSettingsResponse) ProtoMessage() {}
+
+func (x *SetDigestSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigestSettingsResponse.ProtoReflect.Descriptor instead.
+func (*SetDigestSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *SetDigestSettingsResponse) GetSettings() *DigestSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GetDigestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`     // YYYY-MM-DD в часовом поясе пользователя, по умолчанию - сегодня
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // по умолчанию - формат из настроек
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestRequest) Reset() {
+	*x = GetDigestRequest{}
+	mi := &file_db_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestRequest) ProtoMessage() {}
+
+func (x *GetDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestRequest.ProtoReflect.Descriptor instead.
+func (*GetDigestRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetDigestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDigestRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetDigestRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type Digest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Date               string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	TimeZone           string                 `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	DueToday           []*DbTask              `protobuf:"bytes,3,rep,name=due_today,json=dueToday,proto3" json:"due_today,omitempty"`
+	Overdue            []*DbTask              `protobuf:"bytes,4,rep,name=overdue,proto3" json:"overdue,omitempty"`
+	CompletedYesterday []*DbTask              `protobuf:"bytes,5,rep,name=completed_yesterday,json=completedYesterday,proto3" json:"completed_yesterday,omitempty"`
+	Format             string                 `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	ContentType        string                 `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content            string                 `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"` // дайджест, отрисованный в формате format
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Digest) Reset() {
+	*x = Digest{}
+	mi := &file_db_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Digest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Digest) ProtoMessage() {}
+
+func (x *Digest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Digest.ProtoReflect.Descriptor instead.
+func (*Digest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *Digest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Digest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
 
-func (x *CompleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[13]
+func (x *Digest) GetDueToday() []*DbTask {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DueToday
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CompleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*CompleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{13}
+func (x *Digest) GetOverdue() []*DbTask {
+	if x != nil {
+		return x.Overdue
+	}
+	return nil
 }
 
-func (x *CompleteTaskResponse) GetId() string {
+func (x *Digest) GetCompletedYesterday() []*DbTask {
 	if x != nil {
-		return x.Id
+		return x.CompletedYesterday
+	}
+	return nil
+}
+
+func (x *Digest) GetFormat() string {
+	if x != nil {
+		return x.Format
 	}
 	return ""
 }
 
-func (x *CompleteTaskResponse) GetCompleted() bool {
+func (x *Digest) GetContentType() string {
 	if x != nil {
-		return x.Completed
+		return x.ContentType
 	}
-	return false
+	return ""
 }
 
-func (x *CompleteTaskResponse) GetCompletedAt() *timestamppb.Timestamp {
+func (x *Digest) GetContent() string {
 	if x != nil {
-		return x.CompletedAt
+		return x.Content
+	}
+	return ""
+}
+
+type GetDigestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digest        *Digest                `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestResponse) Reset() {
+	*x = GetDigestResponse{}
+	mi := &file_db_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestResponse) ProtoMessage() {}
+
+func (x *GetDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestResponse.ProtoReflect.Descriptor instead.
+func (*GetDigestResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetDigestResponse) GetDigest() *Digest {
+	if x != nil {
+		return x.Digest
 	}
 	return nil
 }
 
-type DbTask struct {
+// Число выполненных задач за период, start - первый день периода (YYYY-MM-DD) в часовом поясе пользователя
+type StatsBucket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Completed     bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Completed     int32                  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DbTask) Reset() {
-	*x = DbTask{}
-	mi := &file_db_service_proto_msgTypes[14]
+func (x *StatsBucket) Reset() {
+	*x = StatsBucket{}
+	mi := &file_db_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DbTask) String() string {
+func (x *StatsBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DbTask) ProtoMessage() {}
+func (*StatsBucket) ProtoMessage() {}
 
-func (x *DbTask) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[14]
+func (x *StatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,86 +7124,171 @@ func (x *DbTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DbTask.ProtoReflect.Descriptor instead.
-func (*DbTask) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use StatsBucket.ProtoReflect.Descriptor instead.
+func (*StatsBucket) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *DbTask) GetId() string {
+func (x *StatsBucket) GetStart() string {
 	if x != nil {
-		return x.Id
+		return x.Start
 	}
 	return ""
 }
 
-func (x *DbTask) GetUserId() string {
+func (x *StatsBucket) GetCompleted() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Completed
 	}
-	return ""
+	return 0
 }
 
-func (x *DbTask) GetTitle() string {
+type TaskStats struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	OpenCount            int32                  `protobuf:"varint,1,opt,name=open_count,json=openCount,proto3" json:"open_count,omitempty"`                                     // невыполненные задачи вне архива
+	OverdueCount         int32                  `protobuf:"varint,2,opt,name=overdue_count,json=overdueCount,proto3" json:"overdue_count,omitempty"`                            // невыполненные задачи вне архива со сроком до начала сегодняшнего дня
+	CompletedCount       int32                  `protobuf:"varint,3,opt,name=completed_count,json=completedCount,proto3" json:"completed_count,omitempty"`                      // выполненные задачи, включая архивные
+	AvgCompletionSeconds float64                `protobuf:"fixed64,4,opt,name=avg_completion_seconds,json=avgCompletionSeconds,proto3" json:"avg_completion_seconds,omitempty"` // среднее completed_at - created_at
+	CurrentStreakDays    int32                  `protobuf:"varint,5,opt,name=current_streak_days,json=currentStreakDays,proto3" json:"current_streak_days,omitempty"`           // дней подряд с выполненными задачами, заканчивая сегодня или вчера
+	LongestStreakDays    int32                  `protobuf:"varint,6,opt,name=longest_streak_days,json=longestStreakDays,proto3" json:"longest_streak_days,omitempty"`
+	Daily                []*StatsBucket         `protobuf:"bytes,7,rep,name=daily,proto3" json:"daily,omitempty"`     // последние 30 дней
+	Weekly               []*StatsBucket         `protobuf:"bytes,8,rep,name=weekly,proto3" json:"weekly,omitempty"`   // последние 12 недель, неделя начинается с week_start из настроек
+	Monthly              []*StatsBucket         `protobuf:"bytes,9,rep,name=monthly,proto3" json:"monthly,omitempty"` // последние 12 месяцев
+	TimeZone             string                 `protobuf:"bytes,10,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	GeneratedAt          *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TaskStats) Reset() {
+	*x = TaskStats{}
+	mi := &file_db_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskStats) ProtoMessage() {}
+
+func (x *TaskStats) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[114]
 	if x != nil {
-		return x.Title
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DbTask) GetDescription() string {
+// Deprecated: Use TaskStats.ProtoReflect.Descriptor instead.
+func (*TaskStats) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *TaskStats) GetOpenCount() int32 {
 	if x != nil {
-		return x.Description
+		return x.OpenCount
 	}
-	return ""
+	return 0
 }
 
-func (x *DbTask) GetCompleted() bool {
+func (x *TaskStats) GetOverdueCount() int32 {
 	if x != nil {
-		return x.Completed
+		return x.OverdueCount
 	}
-	return false
+	return 0
 }
 
-func (x *DbTask) GetCreatedAt() *timestamppb.Timestamp {
+func (x *TaskStats) GetCompletedCount() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.CompletedCount
+	}
+	return 0
+}
+
+func (x *TaskStats) GetAvgCompletionSeconds() float64 {
+	if x != nil {
+		return x.AvgCompletionSeconds
+	}
+	return 0
+}
+
+func (x *TaskStats) GetCurrentStreakDays() int32 {
+	if x != nil {
+		return x.CurrentStreakDays
+	}
+	return 0
+}
+
+func (x *TaskStats) GetLongestStreakDays() int32 {
+	if x != nil {
+		return x.LongestStreakDays
+	}
+	return 0
+}
+
+func (x *TaskStats) GetDaily() []*StatsBucket {
+	if x != nil {
+		return x.Daily
 	}
 	return nil
 }
 
-func (x *DbTask) GetCompletedAt() *timestamppb.Timestamp {
+func (x *TaskStats) GetWeekly() []*StatsBucket {
 	if x != nil {
-		return x.CompletedAt
+		return x.Weekly
 	}
 	return nil
 }
 
-// Новое сообщение для пользователя
-type User struct {
+func (x *TaskStats) GetMonthly() []*StatsBucket {
+	if x != nil {
+		return x.Monthly
+	}
+	return nil
+}
+
+func (x *TaskStats) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *TaskStats) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+type GetStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	mi := &file_db_service_proto_msgTypes[15]
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_db_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *GetStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*GetStatsRequest) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[15]
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -992,35 +7299,58 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *User) GetId() string {
+func (x *GetStatsRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *User) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type GetStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         *TaskStats             `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) GetUsername() string {
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	mi := &file_db_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[116]
 	if x != nil {
-		return x.Username
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetStatsResponse) GetStats() *TaskStats {
 	if x != nil {
-		return x.CreatedAt
+		return x.Stats
 	}
 	return nil
 }
@@ -1054,22 +7384,49 @@ const file_db_service_proto_rawDesc = "" +
 	"\x18AuthenticateUserResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"d\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xe1\x01\n" +
 	"\x11CreateTaskRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x85\x01\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x121\n" +
+	"\x06due_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x1b\n" +
+	"\tparent_id\x18\x06 \x01(\tR\bparentId\x12\x17\n" +
+	"\alist_id\x18\a \x01(\tR\x06listId\"\xd3\x02\n" +
 	"\x0fGetTasksRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
 	"\x11include_completed\x18\x02 \x01(\bR\x10includeCompleted\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\"<\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x17\n" +
+	"\alist_id\x18\x05 \x01(\tR\x06listId\x12*\n" +
+	"\x04view\x18\x06 \x01(\x0e2\x16.checklist.db.TaskViewR\x04view\x12)\n" +
+	"\x03due\x18\a \x01(\x0e2\x17.checklist.db.DueFilterR\x03due\x12\x19\n" +
+	"\bdue_from\x18\b \x01(\tR\adueFrom\x12\x15\n" +
+	"\x06due_to\x18\t \x01(\tR\x05dueTo\x12*\n" +
+	"\x04sort\x18\n" +
+	" \x01(\x0e2\x16.checklist.db.TaskSortR\x04sort\"g\n" +
 	"\x11DeleteTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\">\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"i\n" +
 	"\x13CompleteTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x8d\x02\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"\xc4\x02\n" +
+	"\x11UpdateTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\x03R\x0fexpectedVersion\x121\n" +
+	"\x06due_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12 \n" +
+	"\fclear_due_at\x18\a \x01(\bR\n" +
+	"clearDueAt\x12*\n" +
+	"\x04tags\x18\b \x01(\v2\x16.checklist.db.TaskTagsR\x04tagsB\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_description\"\"\n" +
+	"\bTaskTags\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xa4\x03\n" +
 	"\x12CreateTaskResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
@@ -1078,18 +7435,33 @@ const file_db_service_proto_rawDesc = "" +
 	"\tcompleted\x18\x05 \x01(\bR\tcompleted\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
-	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"_\n" +
+	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\x121\n" +
+	"\x06due_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12\x1b\n" +
+	"\tparent_id\x18\v \x01(\tR\bparentId\x12\x17\n" +
+	"\alist_id\x18\f \x01(\tR\x06listId\"_\n" +
 	"\x10GetTasksResponse\x12*\n" +
 	"\x05tasks\x18\x01 \x03(\v2\x14.checklist.db.DbTaskR\x05tasks\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"H\n" +
+	"totalCount\"T\n" +
+	"\x0eGetTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\bical_uid\x18\x03 \x01(\tR\aicalUid\";\n" +
+	"\x0fGetTaskResponse\x12(\n" +
+	"\x04task\x18\x01 \x01(\v2\x14.checklist.db.DbTaskR\x04task\"H\n" +
 	"\x12DeleteTaskResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x83\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x9d\x01\n" +
 	"\x14CompleteTaskResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\tcompleted\x18\x02 \x01(\bR\tcompleted\x12=\n" +
-	"\fcompleted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\x81\x02\n" +
+	"\fcompleted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\">\n" +
+	"\x12UpdateTaskResponse\x12(\n" +
+	"\x04task\x18\x01 \x01(\v2\x14.checklist.db.DbTaskR\x04task\"\xf0\x03\n" +
 	"\x06DbTask\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
@@ -1098,13 +7470,461 @@ const file_db_service_proto_rawDesc = "" +
 	"\tcompleted\x18\x05 \x01(\bR\tcompleted\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
-	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\x81\x01\n" +
+	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\x121\n" +
+	"\x06due_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12\x1b\n" +
+	"\tparent_id\x18\v \x01(\tR\bparentId\x12\x17\n" +
+	"\alist_id\x18\f \x01(\tR\x06listId\x12;\n" +
+	"\varchived_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12\x19\n" +
+	"\bical_uid\x18\x0e \x01(\tR\aicalUid\"h\n" +
+	"\x12ArchiveTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"?\n" +
+	"\x13ArchiveTaskResponse\x12(\n" +
+	"\x04task\x18\x01 \x01(\v2\x14.checklist.db.DbTaskR\x04task\"j\n" +
+	"\x14UnarchiveTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"A\n" +
+	"\x15UnarchiveTaskResponse\x12(\n" +
+	"\x04task\x18\x01 \x01(\v2\x14.checklist.db.DbTaskR\x04task\"-\n" +
+	"\x12ExportTasksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\\\n" +
+	"\x13ExportTasksResponse\x12(\n" +
+	"\x04task\x18\x01 \x01(\v2\x14.checklist.db.DbTaskR\x04task\x12\x1b\n" +
+	"\tlist_name\x18\x02 \x01(\tR\blistName\"z\n" +
+	"\x12ImportTasksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\tR\x06listId\x122\n" +
+	"\x05items\x18\x03 \x03(\v2\x1c.checklist.db.ImportTaskItemR\x05items\"\x89\x03\n" +
+	"\x0eImportTaskItem\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\bR\tcompleted\x12=\n" +
+	"\fcompleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x121\n" +
+	"\x06due_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1b\n" +
+	"\tlist_name\x18\a \x01(\tR\blistName\x128\n" +
+	"\bsubtasks\x18\b \x03(\v2\x1c.checklist.db.ImportTaskItemR\bsubtasks\x12\x19\n" +
+	"\bical_uid\x18\t \x01(\tR\aicalUid\x12)\n" +
+	"\x10expected_version\x18\n" +
+	" \x01(\x03R\x0fexpectedVersion\"\xb9\x01\n" +
+	"\x13ImportTasksResponse\x12*\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x14.checklist.db.DbTaskR\x05tasks\x12;\n" +
+	"\rcreated_lists\x18\x02 \x03(\v2\x16.checklist.db.TaskListR\fcreatedLists\x129\n" +
+	"\rupdated_tasks\x18\x03 \x03(\v2\x14.checklist.db.DbTaskR\fupdatedTasks\"\x99\x02\n" +
+	"\fUserSettings\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x04 \x01(\x05R\tweekStart\x12&\n" +
+	"\x0fdefault_list_id\x18\x05 \x01(\tR\rdefaultListId\x129\n" +
+	"\fdefault_sort\x18\x06 \x01(\x0e2\x16.checklist.db.TaskSortR\vdefaultSort\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"-\n" +
+	"\x12GetSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"M\n" +
+	"\x13GetSettingsResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.checklist.db.UserSettingsR\bsettings\"\xcd\x02\n" +
+	"\x15UpdateSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\ttime_zone\x18\x02 \x01(\tH\x00R\btimeZone\x88\x01\x01\x12\x1b\n" +
+	"\x06locale\x18\x03 \x01(\tH\x01R\x06locale\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"week_start\x18\x04 \x01(\x05H\x02R\tweekStart\x88\x01\x01\x12+\n" +
+	"\x0fdefault_list_id\x18\x05 \x01(\tH\x03R\rdefaultListId\x88\x01\x01\x12>\n" +
+	"\fdefault_sort\x18\x06 \x01(\x0e2\x16.checklist.db.TaskSortH\x04R\vdefaultSort\x88\x01\x01B\f\n" +
+	"\n" +
+	"_time_zoneB\t\n" +
+	"\a_localeB\r\n" +
+	"\v_week_startB\x12\n" +
+	"\x10_default_list_idB\x0f\n" +
+	"\r_default_sort\"P\n" +
+	"\x16UpdateSettingsResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.checklist.db.UserSettingsR\bsettings\"\xab\x01\n" +
+	"\rArchivePolicy\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12,\n" +
+	"\x12archive_after_days\x18\x03 \x01(\x05R\x10archiveAfterDays\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"2\n" +
+	"\x17GetArchivePolicyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"O\n" +
+	"\x18GetArchivePolicyResponse\x123\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1b.checklist.db.ArchivePolicyR\x06policy\"z\n" +
+	"\x17SetArchivePolicyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12,\n" +
+	"\x12archive_after_days\x18\x03 \x01(\x05R\x10archiveAfterDays\"O\n" +
+	"\x18SetArchivePolicyResponse\x123\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1b.checklist.db.ArchivePolicyR\x06policy\"w\n" +
+	"\x15GetTaskHistoryRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"s\n" +
+	"\x16GetTaskHistoryResponse\x128\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1a.checklist.db.TaskRevisionR\trevisions\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\x83\x01\n" +
+	"\x11RevertTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x05R\brevision\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\">\n" +
+	"\x12RevertTaskResponse\x12(\n" +
+	"\x04task\x18\x01 \x01(\v2\x14.checklist.db.DbTaskR\x04task\"\xa3\x02\n" +
+	"\fTaskRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x05R\brevision\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12/\n" +
+	"\bold_task\x18\x05 \x01(\v2\x14.checklist.db.DbTaskR\aoldTask\x12/\n" +
+	"\bnew_task\x18\x06 \x01(\v2\x14.checklist.db.DbTaskR\anewTask\x12\x19\n" +
+	"\bactor_id\x18\a \x01(\tR\aactorId\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x82\x01\n" +
+	"\bTaskList\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"@\n" +
+	"\x11CreateListRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"@\n" +
+	"\x12CreateListResponse\x12*\n" +
+	"\x04list\x18\x01 \x01(\v2\x16.checklist.db.TaskListR\x04list\"*\n" +
+	"\x0fGetListsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"@\n" +
+	"\x10GetListsResponse\x12,\n" +
+	"\x05lists\x18\x01 \x03(\v2\x16.checklist.db.TaskListR\x05lists\"<\n" +
+	"\x11DeleteListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"H\n" +
+	"\x12DeleteListResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xde\x01\n" +
+	"\rTaskBlueprint\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x121\n" +
+	"\x12due_offset_seconds\x18\x03 \x01(\x03H\x00R\x10dueOffsetSeconds\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x127\n" +
+	"\bsubtasks\x18\x05 \x03(\v2\x1b.checklist.db.TaskBlueprintR\bsubtasksB\x15\n" +
+	"\x13_due_offset_seconds\"\x96\x02\n" +
+	"\fTaskTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x121\n" +
+	"\x05items\x18\x05 \x03(\v2\x1b.checklist.db.TaskBlueprintR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x99\x01\n" +
+	"\x15CreateTemplateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x121\n" +
+	"\x05items\x18\x04 \x03(\v2\x1b.checklist.db.TaskBlueprintR\x05items\"P\n" +
+	"\x16CreateTemplateResponse\x126\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1a.checklist.db.TaskTemplateR\btemplate\".\n" +
+	"\x13GetTemplatesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"P\n" +
+	"\x14GetTemplatesResponse\x128\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1a.checklist.db.TaskTemplateR\ttemplates\"=\n" +
+	"\x12GetTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"M\n" +
+	"\x13GetTemplateResponse\x126\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1a.checklist.db.TaskTemplateR\btemplate\"\xa9\x01\n" +
+	"\x15UpdateTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x121\n" +
+	"\x05items\x18\x05 \x03(\v2\x1b.checklist.db.TaskBlueprintR\x05items\"P\n" +
+	"\x16UpdateTemplateResponse\x126\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1a.checklist.db.TaskTemplateR\btemplate\"@\n" +
+	"\x15DeleteTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"L\n" +
+	"\x16DeleteTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xca\x01\n" +
+	"\x1aInstantiateTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\alist_id\x18\x03 \x01(\tR\x06listId\x12\"\n" +
+	"\rnew_list_name\x18\x04 \x01(\tR\vnewListName\x125\n" +
+	"\bstart_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\"u\n" +
+	"\x1bInstantiateTemplateResponse\x12*\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x14.checklist.db.DbTaskR\x05tasks\x12*\n" +
+	"\x04list\x18\x02 \x01(\v2\x16.checklist.db.TaskListR\x04list\"\xbc\x01\n" +
+	"\x19SaveListAsTemplateRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\tR\x06listId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x127\n" +
+	"\tbase_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bbaseTime\"T\n" +
+	"\x1aSaveListAsTemplateResponse\x126\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1a.checklist.db.TaskTemplateR\btemplate\"\x81\x01\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
 	"\busername\x18\x03 \x01(\tR\busername\x129\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt2\xdf\x04\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"y\n" +
+	"\rCalendarToken\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"2\n" +
+	"\x17GetCalendarTokenRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"M\n" +
+	"\x18GetCalendarTokenResponse\x121\n" +
+	"\x05token\x18\x01 \x01(\v2\x1b.checklist.db.CalendarTokenR\x05token\"4\n" +
+	"\x19ResetCalendarTokenRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"O\n" +
+	"\x1aResetCalendarTokenResponse\x121\n" +
+	"\x05token\x18\x01 \x01(\v2\x1b.checklist.db.CalendarTokenR\x05token\"4\n" +
+	"\x1cGetCalendarTokenOwnerRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"8\n" +
+	"\x1dGetCalendarTokenOwnerResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xdb\x02\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x05 \x03(\tR\n" +
+	"eventTypes\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x12#\n" +
+	"\rfailure_count\x18\a \x01(\x05R\ffailureCount\x12'\n" +
+	"\x0fdisabled_reason\x18\b \x01(\tR\x0edisabledReason\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf0\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12(\n" +
+	"\x10last_status_code\x18\b \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12B\n" +
+	"\x0fnext_attempt_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12#\n" +
+	"\rredelivery_of\x18\v \x01(\tR\fredeliveryOf\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"z\n" +
+	"\x14CreateWebhookRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\"H\n" +
+	"\x15CreateWebhookResponse\x12/\n" +
+	"\awebhook\x18\x01 \x01(\v2\x15.checklist.db.WebhookR\awebhook\"-\n" +
+	"\x12GetWebhooksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"H\n" +
+	"\x13GetWebhooksResponse\x121\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x15.checklist.db.WebhookR\bwebhooks\"<\n" +
+	"\x11GetWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"E\n" +
+	"\x12GetWebhookResponse\x12/\n" +
+	"\awebhook\x18\x01 \x01(\v2\x15.checklist.db.WebhookR\awebhook\"\xb5\x01\n" +
+	"\x14UpdateWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x05 \x03(\tR\n" +
+	"eventTypes\x12\x1d\n" +
+	"\aenabled\x18\x06 \x01(\bH\x00R\aenabled\x88\x01\x01B\n" +
+	"\n" +
+	"\b_enabled\"H\n" +
+	"\x15UpdateWebhookResponse\x12/\n" +
+	"\awebhook\x18\x01 \x01(\v2\x15.checklist.db.WebhookR\awebhook\"?\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"1\n" +
+	"\x15DeleteWebhookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x83\x01\n" +
+	"\x1bGetWebhookDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"]\n" +
+	"\x1cGetWebhookDeliveriesResponse\x12=\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1d.checklist.db.WebhookDeliveryR\n" +
+	"deliveries\"r\n" +
+	"\x17RedeliverWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x1f\n" +
+	"\vdelivery_id\x18\x02 \x01(\tR\n" +
+	"deliveryId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"U\n" +
+	"\x18RedeliverWebhookResponse\x129\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x1d.checklist.db.WebhookDeliveryR\bdelivery\"\x8e\x01\n" +
+	"\x1fEnqueueWebhookDeliveriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x18\n" +
+	"\apayload\x18\x04 \x01(\tR\apayload\"8\n" +
+	" EnqueueWebhookDeliveriesResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"Z\n" +
+	"\x1dClaimWebhookDeliveriesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12#\n" +
+	"\rlease_seconds\x18\x02 \x01(\x05R\fleaseSeconds\"}\n" +
+	"\x16ClaimedWebhookDelivery\x129\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x1d.checklist.db.WebhookDeliveryR\bdelivery\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\"f\n" +
+	"\x1eClaimWebhookDeliveriesResponse\x12D\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2$.checklist.db.ClaimedWebhookDeliveryR\n" +
+	"deliveries\"\xdd\x01\n" +
+	"\x1eCompleteWebhookDeliveryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1f\n" +
+	"\vstatus_code\x18\x03 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x125\n" +
+	"\bretry_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aretryAt\x12#\n" +
+	"\rdisable_after\x18\x06 \x01(\x05R\fdisableAfter\"\x87\x01\n" +
+	"\x1fCompleteWebhookDeliveryResponse\x129\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x1d.checklist.db.WebhookDeliveryR\bdelivery\x12)\n" +
+	"\x10webhook_disabled\x18\x02 \x01(\bR\x0fwebhookDisabled\"\xbc\x03\n" +
+	"\bReminder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x127\n" +
+	"\tremind_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12*\n" +
+	"\x0eoffset_seconds\x18\x05 \x01(\x03H\x00R\roffsetSeconds\x88\x01\x01\x123\n" +
+	"\afire_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06fireAt\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x123\n" +
+	"\asent_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x11\n" +
+	"\x0f_offset_seconds\"\xc1\x01\n" +
+	"\x15CreateReminderRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x127\n" +
+	"\tremind_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12*\n" +
+	"\x0eoffset_seconds\x18\x04 \x01(\x03H\x00R\roffsetSeconds\x88\x01\x01B\x11\n" +
+	"\x0f_offset_seconds\"L\n" +
+	"\x16CreateReminderResponse\x122\n" +
+	"\breminder\x18\x01 \x01(\v2\x16.checklist.db.ReminderR\breminder\"G\n" +
+	"\x13GetRemindersRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"L\n" +
+	"\x14GetRemindersResponse\x124\n" +
+	"\treminders\x18\x01 \x03(\v2\x16.checklist.db.ReminderR\treminders\"Y\n" +
+	"\x15DeleteReminderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"2\n" +
+	"\x16DeleteReminderResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xfa\x01\n" +
+	"\x0eDigestSettings\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12#\n" +
+	"\rdelivery_time\x18\x03 \x01(\tR\fdeliveryTime\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\x12\x16\n" +
+	"\x06format\x18\x05 \x01(\tR\x06format\x12 \n" +
+	"\flast_sent_on\x18\x06 \x01(\tR\n" +
+	"lastSentOn\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"3\n" +
+	"\x18GetDigestSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"U\n" +
+	"\x19GetDigestSettingsResponse\x128\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1c.checklist.db.DigestSettingsR\bsettings\"\x9b\x01\n" +
+	"\x18SetDigestSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12#\n" +
+	"\rdelivery_time\x18\x03 \x01(\tR\fdeliveryTime\x12\x16\n" +
+	"\x06format\x18\x05 \x01(\tR\x06formatJ\x04\b\x04\x10\x05R\ttime_zone\"U\n" +
+	"\x19SetDigestSettingsResponse\x128\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1c.checklist.db.DigestSettingsR\bsettings\"W\n" +
+	"\x10GetDigestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\"\xb8\x02\n" +
+	"\x06Digest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\x121\n" +
+	"\tdue_today\x18\x03 \x03(\v2\x14.checklist.db.DbTaskR\bdueToday\x12.\n" +
+	"\aoverdue\x18\x04 \x03(\v2\x14.checklist.db.DbTaskR\aoverdue\x12E\n" +
+	"\x13completed_yesterday\x18\x05 \x03(\v2\x14.checklist.db.DbTaskR\x12completedYesterday\x12\x16\n" +
+	"\x06format\x18\x06 \x01(\tR\x06format\x12!\n" +
+	"\fcontent_type\x18\a \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\b \x01(\tR\acontent\"A\n" +
+	"\x11GetDigestResponse\x12,\n" +
+	"\x06digest\x18\x01 \x01(\v2\x14.checklist.db.DigestR\x06digest\"A\n" +
+	"\vStatsBucket\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\x05R\tcompleted\"\x83\x04\n" +
+	"\tTaskStats\x12\x1d\n" +
+	"\n" +
+	"open_count\x18\x01 \x01(\x05R\topenCount\x12#\n" +
+	"\roverdue_count\x18\x02 \x01(\x05R\foverdueCount\x12'\n" +
+	"\x0fcompleted_count\x18\x03 \x01(\x05R\x0ecompletedCount\x124\n" +
+	"\x16avg_completion_seconds\x18\x04 \x01(\x01R\x14avgCompletionSeconds\x12.\n" +
+	"\x13current_streak_days\x18\x05 \x01(\x05R\x11currentStreakDays\x12.\n" +
+	"\x13longest_streak_days\x18\x06 \x01(\x05R\x11longestStreakDays\x12/\n" +
+	"\x05daily\x18\a \x03(\v2\x19.checklist.db.StatsBucketR\x05daily\x121\n" +
+	"\x06weekly\x18\b \x03(\v2\x19.checklist.db.StatsBucketR\x06weekly\x123\n" +
+	"\amonthly\x18\t \x03(\v2\x19.checklist.db.StatsBucketR\amonthly\x12\x1b\n" +
+	"\ttime_zone\x18\n" +
+	" \x01(\tR\btimeZone\x12=\n" +
+	"\fgenerated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"*\n" +
+	"\x0fGetStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"A\n" +
+	"\x10GetStatsResponse\x12-\n" +
+	"\x05stats\x18\x01 \x01(\v2\x17.checklist.db.TaskStatsR\x05stats*\x7f\n" +
+	"\bTaskView\x12\x19\n" +
+	"\x15TASK_VIEW_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TASK_VIEW_ACTIVE\x10\x01\x12\x17\n" +
+	"\x13TASK_VIEW_COMPLETED\x10\x02\x12\x16\n" +
+	"\x12TASK_VIEW_ARCHIVED\x10\x03\x12\x11\n" +
+	"\rTASK_VIEW_ALL\x10\x04*\x8b\x01\n" +
+	"\tDueFilter\x12\x1a\n" +
+	"\x16DUE_FILTER_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10DUE_FILTER_TODAY\x10\x01\x12\x16\n" +
+	"\x12DUE_FILTER_OVERDUE\x10\x02\x12\x18\n" +
+	"\x14DUE_FILTER_THIS_WEEK\x10\x03\x12\x1a\n" +
+	"\x16DUE_FILTER_NO_DUE_DATE\x10\x04*\x8c\x01\n" +
+	"\bTaskSort\x12\x19\n" +
+	"\x15TASK_SORT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16TASK_SORT_CREATED_DESC\x10\x01\x12\x19\n" +
+	"\x15TASK_SORT_CREATED_ASC\x10\x02\x12\x15\n" +
+	"\x11TASK_SORT_DUE_ASC\x10\x03\x12\x17\n" +
+	"\x13TASK_SORT_TITLE_ASC\x10\x042\xee#\n" +
 	"\x0fDatabaseService\x12Q\n" +
 	"\n" +
 	"CreateUser\x12\x1f.checklist.db.CreateUserRequest\x1a .checklist.db.CreateUserResponse\"\x00\x12H\n" +
@@ -1112,10 +7932,57 @@ const file_db_service_proto_rawDesc = "" +
 	"\x10AuthenticateUser\x12%.checklist.db.AuthenticateUserRequest\x1a&.checklist.db.AuthenticateUserResponse\"\x00\x12Q\n" +
 	"\n" +
 	"CreateTask\x12\x1f.checklist.db.CreateTaskRequest\x1a .checklist.db.CreateTaskResponse\"\x00\x12K\n" +
-	"\bGetTasks\x12\x1d.checklist.db.GetTasksRequest\x1a\x1e.checklist.db.GetTasksResponse\"\x00\x12Q\n" +
+	"\bGetTasks\x12\x1d.checklist.db.GetTasksRequest\x1a\x1e.checklist.db.GetTasksResponse\"\x00\x12H\n" +
+	"\aGetTask\x12\x1c.checklist.db.GetTaskRequest\x1a\x1d.checklist.db.GetTaskResponse\"\x00\x12Q\n" +
 	"\n" +
 	"DeleteTask\x12\x1f.checklist.db.DeleteTaskRequest\x1a .checklist.db.DeleteTaskResponse\"\x00\x12W\n" +
-	"\fCompleteTask\x12!.checklist.db.CompleteTaskRequest\x1a\".checklist.db.CompleteTaskResponse\"\x00B\x06Z\x04.;pbb\x06proto3"
+	"\fCompleteTask\x12!.checklist.db.CompleteTaskRequest\x1a\".checklist.db.CompleteTaskResponse\"\x00\x12Q\n" +
+	"\n" +
+	"UpdateTask\x12\x1f.checklist.db.UpdateTaskRequest\x1a .checklist.db.UpdateTaskResponse\"\x00\x12T\n" +
+	"\vArchiveTask\x12 .checklist.db.ArchiveTaskRequest\x1a!.checklist.db.ArchiveTaskResponse\"\x00\x12Z\n" +
+	"\rUnarchiveTask\x12\".checklist.db.UnarchiveTaskRequest\x1a#.checklist.db.UnarchiveTaskResponse\"\x00\x12V\n" +
+	"\vExportTasks\x12 .checklist.db.ExportTasksRequest\x1a!.checklist.db.ExportTasksResponse\"\x000\x01\x12T\n" +
+	"\vImportTasks\x12 .checklist.db.ImportTasksRequest\x1a!.checklist.db.ImportTasksResponse\"\x00\x12T\n" +
+	"\vGetSettings\x12 .checklist.db.GetSettingsRequest\x1a!.checklist.db.GetSettingsResponse\"\x00\x12]\n" +
+	"\x0eUpdateSettings\x12#.checklist.db.UpdateSettingsRequest\x1a$.checklist.db.UpdateSettingsResponse\"\x00\x12c\n" +
+	"\x10GetArchivePolicy\x12%.checklist.db.GetArchivePolicyRequest\x1a&.checklist.db.GetArchivePolicyResponse\"\x00\x12c\n" +
+	"\x10SetArchivePolicy\x12%.checklist.db.SetArchivePolicyRequest\x1a&.checklist.db.SetArchivePolicyResponse\"\x00\x12c\n" +
+	"\x10GetCalendarToken\x12%.checklist.db.GetCalendarTokenRequest\x1a&.checklist.db.GetCalendarTokenResponse\"\x00\x12i\n" +
+	"\x12ResetCalendarToken\x12'.checklist.db.ResetCalendarTokenRequest\x1a(.checklist.db.ResetCalendarTokenResponse\"\x00\x12r\n" +
+	"\x15GetCalendarTokenOwner\x12*.checklist.db.GetCalendarTokenOwnerRequest\x1a+.checklist.db.GetCalendarTokenOwnerResponse\"\x00\x12]\n" +
+	"\x0eGetTaskHistory\x12#.checklist.db.GetTaskHistoryRequest\x1a$.checklist.db.GetTaskHistoryResponse\"\x00\x12Q\n" +
+	"\n" +
+	"RevertTask\x12\x1f.checklist.db.RevertTaskRequest\x1a .checklist.db.RevertTaskResponse\"\x00\x12Q\n" +
+	"\n" +
+	"CreateList\x12\x1f.checklist.db.CreateListRequest\x1a .checklist.db.CreateListResponse\"\x00\x12K\n" +
+	"\bGetLists\x12\x1d.checklist.db.GetListsRequest\x1a\x1e.checklist.db.GetListsResponse\"\x00\x12Q\n" +
+	"\n" +
+	"DeleteList\x12\x1f.checklist.db.DeleteListRequest\x1a .checklist.db.DeleteListResponse\"\x00\x12]\n" +
+	"\x0eCreateTemplate\x12#.checklist.db.CreateTemplateRequest\x1a$.checklist.db.CreateTemplateResponse\"\x00\x12W\n" +
+	"\fGetTemplates\x12!.checklist.db.GetTemplatesRequest\x1a\".checklist.db.GetTemplatesResponse\"\x00\x12T\n" +
+	"\vGetTemplate\x12 .checklist.db.GetTemplateRequest\x1a!.checklist.db.GetTemplateResponse\"\x00\x12]\n" +
+	"\x0eUpdateTemplate\x12#.checklist.db.UpdateTemplateRequest\x1a$.checklist.db.UpdateTemplateResponse\"\x00\x12]\n" +
+	"\x0eDeleteTemplate\x12#.checklist.db.DeleteTemplateRequest\x1a$.checklist.db.DeleteTemplateResponse\"\x00\x12l\n" +
+	"\x13InstantiateTemplate\x12(.checklist.db.InstantiateTemplateRequest\x1a).checklist.db.InstantiateTemplateResponse\"\x00\x12i\n" +
+	"\x12SaveListAsTemplate\x12'.checklist.db.SaveListAsTemplateRequest\x1a(.checklist.db.SaveListAsTemplateResponse\"\x00\x12Z\n" +
+	"\rCreateWebhook\x12\".checklist.db.CreateWebhookRequest\x1a#.checklist.db.CreateWebhookResponse\"\x00\x12T\n" +
+	"\vGetWebhooks\x12 .checklist.db.GetWebhooksRequest\x1a!.checklist.db.GetWebhooksResponse\"\x00\x12Q\n" +
+	"\n" +
+	"GetWebhook\x12\x1f.checklist.db.GetWebhookRequest\x1a .checklist.db.GetWebhookResponse\"\x00\x12Z\n" +
+	"\rUpdateWebhook\x12\".checklist.db.UpdateWebhookRequest\x1a#.checklist.db.UpdateWebhookResponse\"\x00\x12Z\n" +
+	"\rDeleteWebhook\x12\".checklist.db.DeleteWebhookRequest\x1a#.checklist.db.DeleteWebhookResponse\"\x00\x12o\n" +
+	"\x14GetWebhookDeliveries\x12).checklist.db.GetWebhookDeliveriesRequest\x1a*.checklist.db.GetWebhookDeliveriesResponse\"\x00\x12c\n" +
+	"\x10RedeliverWebhook\x12%.checklist.db.RedeliverWebhookRequest\x1a&.checklist.db.RedeliverWebhookResponse\"\x00\x12]\n" +
+	"\x0eCreateReminder\x12#.checklist.db.CreateReminderRequest\x1a$.checklist.db.CreateReminderResponse\"\x00\x12W\n" +
+	"\fGetReminders\x12!.checklist.db.GetRemindersRequest\x1a\".checklist.db.GetRemindersResponse\"\x00\x12]\n" +
+	"\x0eDeleteReminder\x12#.checklist.db.DeleteReminderRequest\x1a$.checklist.db.DeleteReminderResponse\"\x00\x12f\n" +
+	"\x11GetDigestSettings\x12&.checklist.db.GetDigestSettingsRequest\x1a'.checklist.db.GetDigestSettingsResponse\"\x00\x12f\n" +
+	"\x11SetDigestSettings\x12&.checklist.db.SetDigestSettingsRequest\x1a'.checklist.db.SetDigestSettingsResponse\"\x00\x12N\n" +
+	"\tGetDigest\x12\x1e.checklist.db.GetDigestRequest\x1a\x1f.checklist.db.GetDigestResponse\"\x00\x12K\n" +
+	"\bGetStats\x12\x1d.checklist.db.GetStatsRequest\x1a\x1e.checklist.db.GetStatsResponse\"\x00\x12{\n" +
+	"\x18EnqueueWebhookDeliveries\x12-.checklist.db.EnqueueWebhookDeliveriesRequest\x1a..checklist.db.EnqueueWebhookDeliveriesResponse\"\x00\x12u\n" +
+	"\x16ClaimWebhookDeliveries\x12+.checklist.db.ClaimWebhookDeliveriesRequest\x1a,.checklist.db.ClaimWebhookDeliveriesResponse\"\x00\x12x\n" +
+	"\x17CompleteWebhookDelivery\x12,.checklist.db.CompleteWebhookDeliveryRequest\x1a-.checklist.db.CompleteWebhookDeliveryResponse\"\x00B\x06Z\x04.;pbb\x06proto3"
 
 var (
 	file_db_service_proto_rawDescOnce sync.Once
@@ -1129,55 +7996,333 @@ func file_db_service_proto_rawDescGZIP() []byte {
 	return file_db_service_proto_rawDescData
 }
 
-var file_db_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_db_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_db_service_proto_msgTypes = make([]protoimpl.MessageInfo, 117)
 var file_db_service_proto_goTypes = []any{
-	(*CreateUserRequest)(nil),        // 0: checklist.db.CreateUserRequest
-	(*GetUserRequest)(nil),           // 1: checklist.db.GetUserRequest
-	(*AuthenticateUserRequest)(nil),  // 2: checklist.db.AuthenticateUserRequest
-	(*CreateUserResponse)(nil),       // 3: checklist.db.CreateUserResponse
-	(*GetUserResponse)(nil),          // 4: checklist.db.GetUserResponse
-	(*AuthenticateUserResponse)(nil), // 5: checklist.db.AuthenticateUserResponse
-	(*CreateTaskRequest)(nil),        // 6: checklist.db.CreateTaskRequest
-	(*GetTasksRequest)(nil),          // 7: checklist.db.GetTasksRequest
-	(*DeleteTaskRequest)(nil),        // 8: checklist.db.DeleteTaskRequest
-	(*CompleteTaskRequest)(nil),      // 9: checklist.db.CompleteTaskRequest
-	(*CreateTaskResponse)(nil),       // 10: checklist.db.CreateTaskResponse
-	(*GetTasksResponse)(nil),         // 11: checklist.db.GetTasksResponse
-	(*DeleteTaskResponse)(nil),       // 12: checklist.db.DeleteTaskResponse
-	(*CompleteTaskResponse)(nil),     // 13: checklist.db.CompleteTaskResponse
-	(*DbTask)(nil),                   // 14: checklist.db.DbTask
-	(*User)(nil),                     // 15: checklist.db.User
-	(*timestamppb.Timestamp)(nil),    // 16: google.protobuf.Timestamp
+	(TaskView)(0),                            // 0: checklist.db.TaskView
+	(DueFilter)(0),                           // 1: checklist.db.DueFilter
+	(TaskSort)(0),                            // 2: checklist.db.TaskSort
+	(*CreateUserRequest)(nil),                // 3: checklist.db.CreateUserRequest
+	(*GetUserRequest)(nil),                   // 4: checklist.db.GetUserRequest
+	(*AuthenticateUserRequest)(nil),          // 5: checklist.db.AuthenticateUserRequest
+	(*CreateUserResponse)(nil),               // 6: checklist.db.CreateUserResponse
+	(*GetUserResponse)(nil),                  // 7: checklist.db.GetUserResponse
+	(*AuthenticateUserResponse)(nil),         // 8: checklist.db.AuthenticateUserResponse
+	(*CreateTaskRequest)(nil),                // 9: checklist.db.CreateTaskRequest
+	(*GetTasksRequest)(nil),                  // 10: checklist.db.GetTasksRequest
+	(*DeleteTaskRequest)(nil),                // 11: checklist.db.DeleteTaskRequest
+	(*CompleteTaskRequest)(nil),              // 12: checklist.db.CompleteTaskRequest
+	(*UpdateTaskRequest)(nil),                // 13: checklist.db.UpdateTaskRequest
+	(*TaskTags)(nil),                         // 14: checklist.db.TaskTags
+	(*CreateTaskResponse)(nil),               // 15: checklist.db.CreateTaskResponse
+	(*GetTasksResponse)(nil),                 // 16: checklist.db.GetTasksResponse
+	(*GetTaskRequest)(nil),                   // 17: checklist.db.GetTaskRequest
+	(*GetTaskResponse)(nil),                  // 18: checklist.db.GetTaskResponse
+	(*DeleteTaskResponse)(nil),               // 19: checklist.db.DeleteTaskResponse
+	(*CompleteTaskResponse)(nil),             // 20: checklist.db.CompleteTaskResponse
+	(*UpdateTaskResponse)(nil),               // 21: checklist.db.UpdateTaskResponse
+	(*DbTask)(nil),                           // 22: checklist.db.DbTask
+	(*ArchiveTaskRequest)(nil),               // 23: checklist.db.ArchiveTaskRequest
+	(*ArchiveTaskResponse)(nil),              // 24: checklist.db.ArchiveTaskResponse
+	(*UnarchiveTaskRequest)(nil),             // 25: checklist.db.UnarchiveTaskRequest
+	(*UnarchiveTaskResponse)(nil),            // 26: checklist.db.UnarchiveTaskResponse
+	(*ExportTasksRequest)(nil),               // 27: checklist.db.ExportTasksRequest
+	(*ExportTasksResponse)(nil),              // 28: checklist.db.ExportTasksResponse
+	(*ImportTasksRequest)(nil),               // 29: checklist.db.ImportTasksRequest
+	(*ImportTaskItem)(nil),                   // 30: checklist.db.ImportTaskItem
+	(*ImportTasksResponse)(nil),              // 31: checklist.db.ImportTasksResponse
+	(*UserSettings)(nil),                     // 32: checklist.db.UserSettings
+	(*GetSettingsRequest)(nil),               // 33: checklist.db.GetSettingsRequest
+	(*GetSettingsResponse)(nil),              // 34: checklist.db.GetSettingsResponse
+	(*UpdateSettingsRequest)(nil),            // 35: checklist.db.UpdateSettingsRequest
+	(*UpdateSettingsResponse)(nil),           // 36: checklist.db.UpdateSettingsResponse
+	(*ArchivePolicy)(nil),                    // 37: checklist.db.ArchivePolicy
+	(*GetArchivePolicyRequest)(nil),          // 38: checklist.db.GetArchivePolicyRequest
+	(*GetArchivePolicyResponse)(nil),         // 39: checklist.db.GetArchivePolicyResponse
+	(*SetArchivePolicyRequest)(nil),          // 40: checklist.db.SetArchivePolicyRequest
+	(*SetArchivePolicyResponse)(nil),         // 41: checklist.db.SetArchivePolicyResponse
+	(*GetTaskHistoryRequest)(nil),            // 42: checklist.db.GetTaskHistoryRequest
+	(*GetTaskHistoryResponse)(nil),           // 43: checklist.db.GetTaskHistoryResponse
+	(*RevertTaskRequest)(nil),                // 44: checklist.db.RevertTaskRequest
+	(*RevertTaskResponse)(nil),               // 45: checklist.db.RevertTaskResponse
+	(*TaskRevision)(nil),                     // 46: checklist.db.TaskRevision
+	(*TaskList)(nil),                         // 47: checklist.db.TaskList
+	(*CreateListRequest)(nil),                // 48: checklist.db.CreateListRequest
+	(*CreateListResponse)(nil),               // 49: checklist.db.CreateListResponse
+	(*GetListsRequest)(nil),                  // 50: checklist.db.GetListsRequest
+	(*GetListsResponse)(nil),                 // 51: checklist.db.GetListsResponse
+	(*DeleteListRequest)(nil),                // 52: checklist.db.DeleteListRequest
+	(*DeleteListResponse)(nil),               // 53: checklist.db.DeleteListResponse
+	(*TaskBlueprint)(nil),                    // 54: checklist.db.TaskBlueprint
+	(*TaskTemplate)(nil),                     // 55: checklist.db.TaskTemplate
+	(*CreateTemplateRequest)(nil),            // 56: checklist.db.CreateTemplateRequest
+	(*CreateTemplateResponse)(nil),           // 57: checklist.db.CreateTemplateResponse
+	(*GetTemplatesRequest)(nil),              // 58: checklist.db.GetTemplatesRequest
+	(*GetTemplatesResponse)(nil),             // 59: checklist.db.GetTemplatesResponse
+	(*GetTemplateRequest)(nil),               // 60: checklist.db.GetTemplateRequest
+	(*GetTemplateResponse)(nil),              // 61: checklist.db.GetTemplateResponse
+	(*UpdateTemplateRequest)(nil),            // 62: checklist.db.UpdateTemplateRequest
+	(*UpdateTemplateResponse)(nil),           // 63: checklist.db.UpdateTemplateResponse
+	(*DeleteTemplateRequest)(nil),            // 64: checklist.db.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil),           // 65: checklist.db.DeleteTemplateResponse
+	(*InstantiateTemplateRequest)(nil),       // 66: checklist.db.InstantiateTemplateRequest
+	(*InstantiateTemplateResponse)(nil),      // 67: checklist.db.InstantiateTemplateResponse
+	(*SaveListAsTemplateRequest)(nil),        // 68: checklist.db.SaveListAsTemplateRequest
+	(*SaveListAsTemplateResponse)(nil),       // 69: checklist.db.SaveListAsTemplateResponse
+	(*User)(nil),                             // 70: checklist.db.User
+	(*CalendarToken)(nil),                    // 71: checklist.db.CalendarToken
+	(*GetCalendarTokenRequest)(nil),          // 72: checklist.db.GetCalendarTokenRequest
+	(*GetCalendarTokenResponse)(nil),         // 73: checklist.db.GetCalendarTokenResponse
+	(*ResetCalendarTokenRequest)(nil),        // 74: checklist.db.ResetCalendarTokenRequest
+	(*ResetCalendarTokenResponse)(nil),       // 75: checklist.db.ResetCalendarTokenResponse
+	(*GetCalendarTokenOwnerRequest)(nil),     // 76: checklist.db.GetCalendarTokenOwnerRequest
+	(*GetCalendarTokenOwnerResponse)(nil),    // 77: checklist.db.GetCalendarTokenOwnerResponse
+	(*Webhook)(nil),                          // 78: checklist.db.Webhook
+	(*WebhookDelivery)(nil),                  // 79: checklist.db.WebhookDelivery
+	(*CreateWebhookRequest)(nil),             // 80: checklist.db.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),            // 81: checklist.db.CreateWebhookResponse
+	(*GetWebhooksRequest)(nil),               // 82: checklist.db.GetWebhooksRequest
+	(*GetWebhooksResponse)(nil),              // 83: checklist.db.GetWebhooksResponse
+	(*GetWebhookRequest)(nil),                // 84: checklist.db.GetWebhookRequest
+	(*GetWebhookResponse)(nil),               // 85: checklist.db.GetWebhookResponse
+	(*UpdateWebhookRequest)(nil),             // 86: checklist.db.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),            // 87: checklist.db.UpdateWebhookResponse
+	(*DeleteWebhookRequest)(nil),             // 88: checklist.db.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),            // 89: checklist.db.DeleteWebhookResponse
+	(*GetWebhookDeliveriesRequest)(nil),      // 90: checklist.db.GetWebhookDeliveriesRequest
+	(*GetWebhookDeliveriesResponse)(nil),     // 91: checklist.db.GetWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),          // 92: checklist.db.RedeliverWebhookRequest
+	(*RedeliverWebhookResponse)(nil),         // 93: checklist.db.RedeliverWebhookResponse
+	(*EnqueueWebhookDeliveriesRequest)(nil),  // 94: checklist.db.EnqueueWebhookDeliveriesRequest
+	(*EnqueueWebhookDeliveriesResponse)(nil), // 95: checklist.db.EnqueueWebhookDeliveriesResponse
+	(*ClaimWebhookDeliveriesRequest)(nil),    // 96: checklist.db.ClaimWebhookDeliveriesRequest
+	(*ClaimedWebhookDelivery)(nil),           // 97: checklist.db.ClaimedWebhookDelivery
+	(*ClaimWebhookDeliveriesResponse)(nil),   // 98: checklist.db.ClaimWebhookDeliveriesResponse
+	(*CompleteWebhookDeliveryRequest)(nil),   // 99: checklist.db.CompleteWebhookDeliveryRequest
+	(*CompleteWebhookDeliveryResponse)(nil),  // 100: checklist.db.CompleteWebhookDeliveryResponse
+	(*Reminder)(nil),                         // 101: checklist.db.Reminder
+	(*CreateReminderRequest)(nil),            // 102: checklist.db.CreateReminderRequest
+	(*CreateReminderResponse)(nil),           // 103: checklist.db.CreateReminderResponse
+	(*GetRemindersRequest)(nil),              // 104: checklist.db.GetRemindersRequest
+	(*GetRemindersResponse)(nil),             // 105: checklist.db.GetRemindersResponse
+	(*DeleteReminderRequest)(nil),            // 106: checklist.db.DeleteReminderRequest
+	(*DeleteReminderResponse)(nil),           // 107: checklist.db.DeleteReminderResponse
+	(*DigestSettings)(nil),                   // 108: checklist.db.DigestSettings
+	(*GetDigestSettingsRequest)(nil),         // 109: checklist.db.GetDigestSettingsRequest
+	(*GetDigestSettingsResponse)(nil),        // 110: checklist.db.GetDigestSettingsResponse
+	(*SetDigestSettingsRequest)(nil),         // 111: checklist.db.SetDigestSettingsRequest
+	(*SetDigestSettingsResponse)(nil),        // 112: checklist.db.SetDigestSettingsResponse
+	(*GetDigestRequest)(nil),                 // 113: checklist.db.GetDigestRequest
+	(*Digest)(nil),                           // 114: checklist.db.Digest
+	(*GetDigestResponse)(nil),                // 115: checklist.db.GetDigestResponse
+	(*StatsBucket)(nil),                      // 116: checklist.db.StatsBucket
+	(*TaskStats)(nil),                        // 117: checklist.db.TaskStats
+	(*GetStatsRequest)(nil),                  // 118: checklist.db.GetStatsRequest
+	(*GetStatsResponse)(nil),                 // 119: checklist.db.GetStatsResponse
+	(*timestamppb.Timestamp)(nil),            // 120: google.protobuf.Timestamp
 }
 var file_db_service_proto_depIdxs = []int32{
-	16, // 0: checklist.db.CreateUserResponse.created_at:type_name -> google.protobuf.Timestamp
-	16, // 1: checklist.db.GetUserResponse.created_at:type_name -> google.protobuf.Timestamp
-	16, // 2: checklist.db.CreateTaskResponse.created_at:type_name -> google.protobuf.Timestamp
-	16, // 3: checklist.db.CreateTaskResponse.completed_at:type_name -> google.protobuf.Timestamp
-	14, // 4: checklist.db.GetTasksResponse.tasks:type_name -> checklist.db.DbTask
-	16, // 5: checklist.db.CompleteTaskResponse.completed_at:type_name -> google.protobuf.Timestamp
-	16, // 6: checklist.db.DbTask.created_at:type_name -> google.protobuf.Timestamp
-	16, // 7: checklist.db.DbTask.completed_at:type_name -> google.protobuf.Timestamp
-	16, // 8: checklist.db.User.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 9: checklist.db.DatabaseService.CreateUser:input_type -> checklist.db.CreateUserRequest
-	1,  // 10: checklist.db.DatabaseService.GetUser:input_type -> checklist.db.GetUserRequest
-	2,  // 11: checklist.db.DatabaseService.AuthenticateUser:input_type -> checklist.db.AuthenticateUserRequest
-	6,  // 12: checklist.db.DatabaseService.CreateTask:input_type -> checklist.db.CreateTaskRequest
-	7,  // 13: checklist.db.DatabaseService.GetTasks:input_type -> checklist.db.GetTasksRequest
-	8,  // 14: checklist.db.DatabaseService.DeleteTask:input_type -> checklist.db.DeleteTaskRequest
-	9,  // 15: checklist.db.DatabaseService.CompleteTask:input_type -> checklist.db.CompleteTaskRequest
-	3,  // 16: checklist.db.DatabaseService.CreateUser:output_type -> checklist.db.CreateUserResponse
-	4,  // 17: checklist.db.DatabaseService.GetUser:output_type -> checklist.db.GetUserResponse
-	5,  // 18: checklist.db.DatabaseService.AuthenticateUser:output_type -> checklist.db.AuthenticateUserResponse
-	10, // 19: checklist.db.DatabaseService.CreateTask:output_type -> checklist.db.CreateTaskResponse
-	11, // 20: checklist.db.DatabaseService.GetTasks:output_type -> checklist.db.GetTasksResponse
-	12, // 21: checklist.db.DatabaseService.DeleteTask:output_type -> checklist.db.DeleteTaskResponse
-	13, // 22: checklist.db.DatabaseService.CompleteTask:output_type -> checklist.db.CompleteTaskResponse
-	16, // [16:23] is the sub-list for method output_type
-	9,  // [9:16] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	120, // 0: checklist.db.CreateUserResponse.created_at:type_name -> google.protobuf.Timestamp
+	120, // 1: checklist.db.GetUserResponse.created_at:type_name -> google.protobuf.Timestamp
+	120, // 2: checklist.db.CreateTaskRequest.due_at:type_name -> google.protobuf.Timestamp
+	0,   // 3: checklist.db.GetTasksRequest.view:type_name -> checklist.db.TaskView
+	1,   // 4: checklist.db.GetTasksRequest.due:type_name -> checklist.db.DueFilter
+	2,   // 5: checklist.db.GetTasksRequest.sort:type_name -> checklist.db.TaskSort
+	120, // 6: checklist.db.UpdateTaskRequest.due_at:type_name -> google.protobuf.Timestamp
+	14,  // 7: checklist.db.UpdateTaskRequest.tags:type_name -> checklist.db.TaskTags
+	120, // 8: checklist.db.CreateTaskResponse.created_at:type_name -> google.protobuf.Timestamp
+	120, // 9: checklist.db.CreateTaskResponse.completed_at:type_name -> google.protobuf.Timestamp
+	120, // 10: checklist.db.CreateTaskResponse.due_at:type_name -> google.protobuf.Timestamp
+	22,  // 11: checklist.db.GetTasksResponse.tasks:type_name -> checklist.db.DbTask
+	22,  // 12: checklist.db.GetTaskResponse.task:type_name -> checklist.db.DbTask
+	120, // 13: checklist.db.CompleteTaskResponse.completed_at:type_name -> google.protobuf.Timestamp
+	22,  // 14: checklist.db.UpdateTaskResponse.task:type_name -> checklist.db.DbTask
+	120, // 15: checklist.db.DbTask.created_at:type_name -> google.protobuf.Timestamp
+	120, // 16: checklist.db.DbTask.completed_at:type_name -> google.protobuf.Timestamp
+	120, // 17: checklist.db.DbTask.due_at:type_name -> google.protobuf.Timestamp
+	120, // 18: checklist.db.DbTask.archived_at:type_name -> google.protobuf.Timestamp
+	22,  // 19: checklist.db.ArchiveTaskResponse.task:type_name -> checklist.db.DbTask
+	22,  // 20: checklist.db.UnarchiveTaskResponse.task:type_name -> checklist.db.DbTask
+	22,  // 21: checklist.db.ExportTasksResponse.task:type_name -> checklist.db.DbTask
+	30,  // 22: checklist.db.ImportTasksRequest.items:type_name -> checklist.db.ImportTaskItem
+	120, // 23: checklist.db.ImportTaskItem.completed_at:type_name -> google.protobuf.Timestamp
+	120, // 24: checklist.db.ImportTaskItem.due_at:type_name -> google.protobuf.Timestamp
+	30,  // 25: checklist.db.ImportTaskItem.subtasks:type_name -> checklist.db.ImportTaskItem
+	22,  // 26: checklist.db.ImportTasksResponse.tasks:type_name -> checklist.db.DbTask
+	47,  // 27: checklist.db.ImportTasksResponse.created_lists:type_name -> checklist.db.TaskList
+	22,  // 28: checklist.db.ImportTasksResponse.updated_tasks:type_name -> checklist.db.DbTask
+	2,   // 29: checklist.db.UserSettings.default_sort:type_name -> checklist.db.TaskSort
+	120, // 30: checklist.db.UserSettings.updated_at:type_name -> google.protobuf.Timestamp
+	32,  // 31: checklist.db.GetSettingsResponse.settings:type_name -> checklist.db.UserSettings
+	2,   // 32: checklist.db.UpdateSettingsRequest.default_sort:type_name -> checklist.db.TaskSort
+	32,  // 33: checklist.db.UpdateSettingsResponse.settings:type_name -> checklist.db.UserSettings
+	120, // 34: checklist.db.ArchivePolicy.updated_at:type_name -> google.protobuf.Timestamp
+	37,  // 35: checklist.db.GetArchivePolicyResponse.policy:type_name -> checklist.db.ArchivePolicy
+	37,  // 36: checklist.db.SetArchivePolicyResponse.policy:type_name -> checklist.db.ArchivePolicy
+	46,  // 37: checklist.db.GetTaskHistoryResponse.revisions:type_name -> checklist.db.TaskRevision
+	22,  // 38: checklist.db.RevertTaskResponse.task:type_name -> checklist.db.DbTask
+	22,  // 39: checklist.db.TaskRevision.old_task:type_name -> checklist.db.DbTask
+	22,  // 40: checklist.db.TaskRevision.new_task:type_name -> checklist.db.DbTask
+	120, // 41: checklist.db.TaskRevision.created_at:type_name -> google.protobuf.Timestamp
+	120, // 42: checklist.db.TaskList.created_at:type_name -> google.protobuf.Timestamp
+	47,  // 43: checklist.db.CreateListResponse.list:type_name -> checklist.db.TaskList
+	47,  // 44: checklist.db.GetListsResponse.lists:type_name -> checklist.db.TaskList
+	54,  // 45: checklist.db.TaskBlueprint.subtasks:type_name -> checklist.db.TaskBlueprint
+	54,  // 46: checklist.db.TaskTemplate.items:type_name -> checklist.db.TaskBlueprint
+	120, // 47: checklist.db.TaskTemplate.created_at:type_name -> google.protobuf.Timestamp
+	120, // 48: checklist.db.TaskTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	54,  // 49: checklist.db.CreateTemplateRequest.items:type_name -> checklist.db.TaskBlueprint
+	55,  // 50: checklist.db.CreateTemplateResponse.template:type_name -> checklist.db.TaskTemplate
+	55,  // 51: checklist.db.GetTemplatesResponse.templates:type_name -> checklist.db.TaskTemplate
+	55,  // 52: checklist.db.GetTemplateResponse.template:type_name -> checklist.db.TaskTemplate
+	54,  // 53: checklist.db.UpdateTemplateRequest.items:type_name -> checklist.db.TaskBlueprint
+	55,  // 54: checklist.db.UpdateTemplateResponse.template:type_name -> checklist.db.TaskTemplate
+	120, // 55: checklist.db.InstantiateTemplateRequest.start_at:type_name -> google.protobuf.Timestamp
+	22,  // 56: checklist.db.InstantiateTemplateResponse.tasks:type_name -> checklist.db.DbTask
+	47,  // 57: checklist.db.InstantiateTemplateResponse.list:type_name -> checklist.db.TaskList
+	120, // 58: checklist.db.SaveListAsTemplateRequest.base_time:type_name -> google.protobuf.Timestamp
+	55,  // 59: checklist.db.SaveListAsTemplateResponse.template:type_name -> checklist.db.TaskTemplate
+	120, // 60: checklist.db.User.created_at:type_name -> google.protobuf.Timestamp
+	120, // 61: checklist.db.CalendarToken.created_at:type_name -> google.protobuf.Timestamp
+	71,  // 62: checklist.db.GetCalendarTokenResponse.token:type_name -> checklist.db.CalendarToken
+	71,  // 63: checklist.db.ResetCalendarTokenResponse.token:type_name -> checklist.db.CalendarToken
+	120, // 64: checklist.db.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	120, // 65: checklist.db.Webhook.updated_at:type_name -> google.protobuf.Timestamp
+	120, // 66: checklist.db.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	120, // 67: checklist.db.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	120, // 68: checklist.db.WebhookDelivery.updated_at:type_name -> google.protobuf.Timestamp
+	78,  // 69: checklist.db.CreateWebhookResponse.webhook:type_name -> checklist.db.Webhook
+	78,  // 70: checklist.db.GetWebhooksResponse.webhooks:type_name -> checklist.db.Webhook
+	78,  // 71: checklist.db.GetWebhookResponse.webhook:type_name -> checklist.db.Webhook
+	78,  // 72: checklist.db.UpdateWebhookResponse.webhook:type_name -> checklist.db.Webhook
+	79,  // 73: checklist.db.GetWebhookDeliveriesResponse.deliveries:type_name -> checklist.db.WebhookDelivery
+	79,  // 74: checklist.db.RedeliverWebhookResponse.delivery:type_name -> checklist.db.WebhookDelivery
+	79,  // 75: checklist.db.ClaimedWebhookDelivery.delivery:type_name -> checklist.db.WebhookDelivery
+	97,  // 76: checklist.db.ClaimWebhookDeliveriesResponse.deliveries:type_name -> checklist.db.ClaimedWebhookDelivery
+	120, // 77: checklist.db.CompleteWebhookDeliveryRequest.retry_at:type_name -> google.protobuf.Timestamp
+	79,  // 78: checklist.db.CompleteWebhookDeliveryResponse.delivery:type_name -> checklist.db.WebhookDelivery
+	120, // 79: checklist.db.Reminder.remind_at:type_name -> google.protobuf.Timestamp
+	120, // 80: checklist.db.Reminder.fire_at:type_name -> google.protobuf.Timestamp
+	120, // 81: checklist.db.Reminder.sent_at:type_name -> google.protobuf.Timestamp
+	120, // 82: checklist.db.Reminder.created_at:type_name -> google.protobuf.Timestamp
+	120, // 83: checklist.db.CreateReminderRequest.remind_at:type_name -> google.protobuf.Timestamp
+	101, // 84: checklist.db.CreateReminderResponse.reminder:type_name -> checklist.db.Reminder
+	101, // 85: checklist.db.GetRemindersResponse.reminders:type_name -> checklist.db.Reminder
+	120, // 86: checklist.db.DigestSettings.updated_at:type_name -> google.protobuf.Timestamp
+	108, // 87: checklist.db.GetDigestSettingsResponse.settings:type_name -> checklist.db.DigestSettings
+	108, // 88: checklist.db.SetDigestSettingsResponse.settings:type_name -> checklist.db.DigestSettings
+	22,  // 89: checklist.db.Digest.due_today:type_name -> checklist.db.DbTask
+	22,  // 90: checklist.db.Digest.overdue:type_name -> checklist.db.DbTask
+	22,  // 91: checklist.db.Digest.completed_yesterday:type_name -> checklist.db.DbTask
+	114, // 92: checklist.db.GetDigestResponse.digest:type_name -> checklist.db.Digest
+	116, // 93: checklist.db.TaskStats.daily:type_name -> checklist.db.StatsBucket
+	116, // 94: checklist.db.TaskStats.weekly:type_name -> checklist.db.StatsBucket
+	116, // 95: checklist.db.TaskStats.monthly:type_name -> checklist.db.StatsBucket
+	120, // 96: checklist.db.TaskStats.generated_at:type_name -> google.protobuf.Timestamp
+	117, // 97: checklist.db.GetStatsResponse.stats:type_name -> checklist.db.TaskStats
+	3,   // 98: checklist.db.DatabaseService.CreateUser:input_type -> checklist.db.CreateUserRequest
+	4,   // 99: checklist.db.DatabaseService.GetUser:input_type -> checklist.db.GetUserRequest
+	5,   // 100: checklist.db.DatabaseService.AuthenticateUser:input_type -> checklist.db.AuthenticateUserRequest
+	9,   // 101: checklist.db.DatabaseService.CreateTask:input_type -> checklist.db.CreateTaskRequest
+	10,  // 102: checklist.db.DatabaseService.GetTasks:input_type -> checklist.db.GetTasksRequest
+	17,  // 103: checklist.db.DatabaseService.GetTask:input_type -> checklist.db.GetTaskRequest
+	11,  // 104: checklist.db.DatabaseService.DeleteTask:input_type -> checklist.db.DeleteTaskRequest
+	12,  // 105: checklist.db.DatabaseService.CompleteTask:input_type -> checklist.db.CompleteTaskRequest
+	13,  // 106: checklist.db.DatabaseService.UpdateTask:input_type -> checklist.db.UpdateTaskRequest
+	23,  // 107: checklist.db.DatabaseService.ArchiveTask:input_type -> checklist.db.ArchiveTaskRequest
+	25,  // 108: checklist.db.DatabaseService.UnarchiveTask:input_type -> checklist.db.UnarchiveTaskRequest
+	27,  // 109: checklist.db.DatabaseService.ExportTasks:input_type -> checklist.db.ExportTasksRequest
+	29,  // 110: checklist.db.DatabaseService.ImportTasks:input_type -> checklist.db.ImportTasksRequest
+	33,  // 111: checklist.db.DatabaseService.GetSettings:input_type -> checklist.db.GetSettingsRequest
+	35,  // 112: checklist.db.DatabaseService.UpdateSettings:input_type -> checklist.db.UpdateSettingsRequest
+	38,  // 113: checklist.db.DatabaseService.GetArchivePolicy:input_type -> checklist.db.GetArchivePolicyRequest
+	40,  // 114: checklist.db.DatabaseService.SetArchivePolicy:input_type -> checklist.db.SetArchivePolicyRequest
+	72,  // 115: checklist.db.DatabaseService.GetCalendarToken:input_type -> checklist.db.GetCalendarTokenRequest
+	74,  // 116: checklist.db.DatabaseService.ResetCalendarToken:input_type -> checklist.db.ResetCalendarTokenRequest
+	76,  // 117: checklist.db.DatabaseService.GetCalendarTokenOwner:input_type -> checklist.db.GetCalendarTokenOwnerRequest
+	42,  // 118: checklist.db.DatabaseService.GetTaskHistory:input_type -> checklist.db.GetTaskHistoryRequest
+	44,  // 119: checklist.db.DatabaseService.RevertTask:input_type -> checklist.db.RevertTaskRequest
+	48,  // 120: checklist.db.DatabaseService.CreateList:input_type -> checklist.db.CreateListRequest
+	50,  // 121: checklist.db.DatabaseService.GetLists:input_type -> checklist.db.GetListsRequest
+	52,  // 122: checklist.db.DatabaseService.DeleteList:input_type -> checklist.db.DeleteListRequest
+	56,  // 123: checklist.db.DatabaseService.CreateTemplate:input_type -> checklist.db.CreateTemplateRequest
+	58,  // 124: checklist.db.DatabaseService.GetTemplates:input_type -> checklist.db.GetTemplatesRequest
+	60,  // 125: checklist.db.DatabaseService.GetTemplate:input_type -> checklist.db.GetTemplateRequest
+	62,  // 126: checklist.db.DatabaseService.UpdateTemplate:input_type -> checklist.db.UpdateTemplateRequest
+	64,  // 127: checklist.db.DatabaseService.DeleteTemplate:input_type -> checklist.db.DeleteTemplateRequest
+	66,  // 128: checklist.db.DatabaseService.InstantiateTemplate:input_type -> checklist.db.InstantiateTemplateRequest
+	68,  // 129: checklist.db.DatabaseService.SaveListAsTemplate:input_type -> checklist.db.SaveListAsTemplateRequest
+	80,  // 130: checklist.db.DatabaseService.CreateWebhook:input_type -> checklist.db.CreateWebhookRequest
+	82,  // 131: checklist.db.DatabaseService.GetWebhooks:input_type -> checklist.db.GetWebhooksRequest
+	84,  // 132: checklist.db.DatabaseService.GetWebhook:input_type -> checklist.db.GetWebhookRequest
+	86,  // 133: checklist.db.DatabaseService.UpdateWebhook:input_type -> checklist.db.UpdateWebhookRequest
+	88,  // 134: checklist.db.DatabaseService.DeleteWebhook:input_type -> checklist.db.DeleteWebhookRequest
+	90,  // 135: checklist.db.DatabaseService.GetWebhookDeliveries:input_type -> checklist.db.GetWebhookDeliveriesRequest
+	92,  // 136: checklist.db.DatabaseService.RedeliverWebhook:input_type -> checklist.db.RedeliverWebhookRequest
+	102, // 137: checklist.db.DatabaseService.CreateReminder:input_type -> checklist.db.CreateReminderRequest
+	104, // 138: checklist.db.DatabaseService.GetReminders:input_type -> checklist.db.GetRemindersRequest
+	106, // 139: checklist.db.DatabaseService.DeleteReminder:input_type -> checklist.db.DeleteReminderRequest
+	109, // 140: checklist.db.DatabaseService.GetDigestSettings:input_type -> checklist.db.GetDigestSettingsRequest
+	111, // 141: checklist.db.DatabaseService.SetDigestSettings:input_type -> checklist.db.SetDigestSettingsRequest
+	113, // 142: checklist.db.DatabaseService.GetDigest:input_type -> checklist.db.GetDigestRequest
+	118, // 143: checklist.db.DatabaseService.GetStats:input_type -> checklist.db.GetStatsRequest
+	94,  // 144: checklist.db.DatabaseService.EnqueueWebhookDeliveries:input_type -> checklist.db.EnqueueWebhookDeliveriesRequest
+	96,  // 145: checklist.db.DatabaseService.ClaimWebhookDeliveries:input_type -> checklist.db.ClaimWebhookDeliveriesRequest
+	99,  // 146: checklist.db.DatabaseService.CompleteWebhookDelivery:input_type -> checklist.db.CompleteWebhookDeliveryRequest
+	6,   // 147: checklist.db.DatabaseService.CreateUser:output_type -> checklist.db.CreateUserResponse
+	7,   // 148: checklist.db.DatabaseService.GetUser:output_type -> checklist.db.GetUserResponse
+	8,   // 149: checklist.db.DatabaseService.AuthenticateUser:output_type -> checklist.db.AuthenticateUserResponse
+	15,  // 150: checklist.db.DatabaseService.CreateTask:output_type -> checklist.db.CreateTaskResponse
+	16,  // 151: checklist.db.DatabaseService.GetTasks:output_type -> checklist.db.GetTasksResponse
+	18,  // 152: checklist.db.DatabaseService.GetTask:output_type -> checklist.db.GetTaskResponse
+	19,  // 153: checklist.db.DatabaseService.DeleteTask:output_type -> checklist.db.DeleteTaskResponse
+	20,  // 154: checklist.db.DatabaseService.CompleteTask:output_type -> checklist.db.CompleteTaskResponse
+	21,  // 155: checklist.db.DatabaseService.UpdateTask:output_type -> checklist.db.UpdateTaskResponse
+	24,  // 156: checklist.db.DatabaseService.ArchiveTask:output_type -> checklist.db.ArchiveTaskResponse
+	26,  // 157: checklist.db.DatabaseService.UnarchiveTask:output_type -> checklist.db.UnarchiveTaskResponse
+	28,  // 158: checklist.db.DatabaseService.ExportTasks:output_type -> checklist.db.ExportTasksResponse
+	31,  // 159: checklist.db.DatabaseService.ImportTasks:output_type -> checklist.db.ImportTasksResponse
+	34,  // 160: checklist.db.DatabaseService.GetSettings:output_type -> checklist.db.GetSettingsResponse
+	36,  // 161: checklist.db.DatabaseService.UpdateSettings:output_type -> checklist.db.UpdateSettingsResponse
+	39,  // 162: checklist.db.DatabaseService.GetArchivePolicy:output_type -> checklist.db.GetArchivePolicyResponse
+	41,  // 163: checklist.db.DatabaseService.SetArchivePolicy:output_type -> checklist.db.SetArchivePolicyResponse
+	73,  // 164: checklist.db.DatabaseService.GetCalendarToken:output_type -> checklist.db.GetCalendarTokenResponse
+	75,  // 165: checklist.db.DatabaseService.ResetCalendarToken:output_type -> checklist.db.ResetCalendarTokenResponse
+	77,  // 166: checklist.db.DatabaseService.GetCalendarTokenOwner:output_type -> checklist.db.GetCalendarTokenOwnerResponse
+	43,  // 167: checklist.db.DatabaseService.GetTaskHistory:output_type -> checklist.db.GetTaskHistoryResponse
+	45,  // 168: checklist.db.DatabaseService.RevertTask:output_type -> checklist.db.RevertTaskResponse
+	49,  // 169: checklist.db.DatabaseService.CreateList:output_type -> checklist.db.CreateListResponse
+	51,  // 170: checklist.db.DatabaseService.GetLists:output_type -> checklist.db.GetListsResponse
+	53,  // 171: checklist.db.DatabaseService.DeleteList:output_type -> checklist.db.DeleteListResponse
+	57,  // 172: checklist.db.DatabaseService.CreateTemplate:output_type -> checklist.db.CreateTemplateResponse
+	59,  // 173: checklist.db.DatabaseService.GetTemplates:output_type -> checklist.db.GetTemplatesResponse
+	61,  // 174: checklist.db.DatabaseService.GetTemplate:output_type -> checklist.db.GetTemplateResponse
+	63,  // 175: checklist.db.DatabaseService.UpdateTemplate:output_type -> checklist.db.UpdateTemplateResponse
+	65,  // 176: checklist.db.DatabaseService.DeleteTemplate:output_type -> checklist.db.DeleteTemplateResponse
+	67,  // 177: checklist.db.DatabaseService.InstantiateTemplate:output_type -> checklist.db.InstantiateTemplateResponse
+	69,  // 178: checklist.db.DatabaseService.SaveListAsTemplate:output_type -> checklist.db.SaveListAsTemplateResponse
+	81,  // 179: checklist.db.DatabaseService.CreateWebhook:output_type -> checklist.db.CreateWebhookResponse
+	83,  // 180: checklist.db.DatabaseService.GetWebhooks:output_type -> checklist.db.GetWebhooksResponse
+	85,  // 181: checklist.db.DatabaseService.GetWebhook:output_type -> checklist.db.GetWebhookResponse
+	87,  // 182: checklist.db.DatabaseService.UpdateWebhook:output_type -> checklist.db.UpdateWebhookResponse
+	89,  // 183: checklist.db.DatabaseService.DeleteWebhook:output_type -> checklist.db.DeleteWebhookResponse
+	91,  // 184: checklist.db.DatabaseService.GetWebhookDeliveries:output_type -> checklist.db.GetWebhookDeliveriesResponse
+	93,  // 185: checklist.db.DatabaseService.RedeliverWebhook:output_type -> checklist.db.RedeliverWebhookResponse
+	103, // 186: checklist.db.DatabaseService.CreateReminder:output_type -> checklist.db.CreateReminderResponse
+	105, // 187: checklist.db.DatabaseService.GetReminders:output_type -> checklist.db.GetRemindersResponse
+	107, // 188: checklist.db.DatabaseService.DeleteReminder:output_type -> checklist.db.DeleteReminderResponse
+	110, // 189: checklist.db.DatabaseService.GetDigestSettings:output_type -> checklist.db.GetDigestSettingsResponse
+	112, // 190: checklist.db.DatabaseService.SetDigestSettings:output_type -> checklist.db.SetDigestSettingsResponse
+	115, // 191: checklist.db.DatabaseService.GetDigest:output_type -> checklist.db.GetDigestResponse
+	119, // 192: checklist.db.DatabaseService.GetStats:output_type -> checklist.db.GetStatsResponse
+	95,  // 193: checklist.db.DatabaseService.EnqueueWebhookDeliveries:output_type -> checklist.db.EnqueueWebhookDeliveriesResponse
+	98,  // 194: checklist.db.DatabaseService.ClaimWebhookDeliveries:output_type -> checklist.db.ClaimWebhookDeliveriesResponse
+	100, // 195: checklist.db.DatabaseService.CompleteWebhookDelivery:output_type -> checklist.db.CompleteWebhookDeliveryResponse
+	147, // [147:196] is the sub-list for method output_type
+	98,  // [98:147] is the sub-list for method input_type
+	98,  // [98:98] is the sub-list for extension type_name
+	98,  // [98:98] is the sub-list for extension extendee
+	0,   // [0:98] is the sub-list for field type_name
 }
 
 func init() { file_db_service_proto_init() }
@@ -1185,18 +8330,25 @@ func file_db_service_proto_init() {
 	if File_db_service_proto != nil {
 		return
 	}
+	file_db_service_proto_msgTypes[10].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[32].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[51].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[83].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[98].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[99].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_db_service_proto_rawDesc), len(file_db_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   16,
+			NumEnums:      3,
+			NumMessages:   117,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_db_service_proto_goTypes,
 		DependencyIndexes: file_db_service_proto_depIdxs,
+		EnumInfos:         file_db_service_proto_enumTypes,
 		MessageInfos:      file_db_service_proto_msgTypes,
 	}.Build()
 	File_db_service_proto = out.File
@@ -0,0 +1,762 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: kafka_service.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Тип действия пользователя
+type ActionType int32
+
+const (
+	ActionType_ACTION_UNKNOWN        ActionType = 0
+	ActionType_ACTION_CREATE_TASK    ActionType = 1 // Создание задачи
+	ActionType_ACTION_DELETE_TASK    ActionType = 2 // Удаление задачи
+	ActionType_ACTION_COMPLETE_TASK  ActionType = 3 // Завершение задачи
+	ActionType_ACTION_GET_TASKS      ActionType = 4 // Получение списка задач
+	ActionType_ACTION_REVERT_TASK    ActionType = 5 // Откат задачи к ревизии
+	ActionType_ACTION_UPDATE_TASK    ActionType = 6 // Изменение задачи
+	ActionType_ACTION_ARCHIVE_TASK   ActionType = 7 // Перенос задачи в архив
+	ActionType_ACTION_UNARCHIVE_TASK ActionType = 8 // Возврат задачи из архива
+)
+
+// Enum value maps for ActionType.
+var (
+	ActionType_name = map[int32]string{
+		0: "ACTION_UNKNOWN",
+		1: "ACTION_CREATE_TASK",
+		2: "ACTION_DELETE_TASK",
+		3: "ACTION_COMPLETE_TASK",
+		4: "ACTION_GET_TASKS",
+		5: "ACTION_REVERT_TASK",
+		6: "ACTION_UPDATE_TASK",
+		7: "ACTION_ARCHIVE_TASK",
+		8: "ACTION_UNARCHIVE_TASK",
+	}
+	ActionType_value = map[string]int32{
+		"ACTION_UNKNOWN":        0,
+		"ACTION_CREATE_TASK":    1,
+		"ACTION_DELETE_TASK":    2,
+		"ACTION_COMPLETE_TASK":  3,
+		"ACTION_GET_TASKS":      4,
+		"ACTION_REVERT_TASK":    5,
+		"ACTION_UPDATE_TASK":    6,
+		"ACTION_ARCHIVE_TASK":   7,
+		"ACTION_UNARCHIVE_TASK": 8,
+	}
+)
+
+func (x ActionType) Enum() *ActionType {
+	p := new(ActionType)
+	*p = x
+	return p
+}
+
+func (x ActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kafka_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ActionType) Type() protoreflect.EnumType {
+	return &file_kafka_service_proto_enumTypes[0]
+}
+
+func (x ActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActionType.Descriptor instead.
+func (ActionType) EnumDescriptor() ([]byte, []int) {
+	return file_kafka_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Событие действия пользователя
+type TaskEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Время, когда пользователь отправил запрос
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Действие пользователя
+	Action ActionType `protobuf:"varint,2,opt,name=action,proto3,enum=checklist.ActionType" json:"action,omitempty"`
+	// ID пользователя
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// ID задачи (опционально, для GetTasks может быть пустым)
+	TaskId string `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Текстовое описание события; заменено attributes и встречается только в событиях схемы 1
+	//
+	// Deprecated: Marked as deprecated in kafka_service.proto.
+	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	// Уникальный ID события (UUIDv7, упорядочен по времени создания)
+	EventId string `protobuf:"bytes,6,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// ID запроса к api_service (заголовок X-Request-Id или сгенерированный)
+	RequestId string `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// ID трассировки из заголовка traceparent (W3C Trace Context)
+	TraceId string `protobuf:"bytes,8,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	// Сервис, отправивший событие
+	Source string `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
+	// Версия схемы события; совпадает с заголовком schema-version
+	SchemaVersion int32 `protobuf:"varint,10,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// Атрибуты события: client_ip, user_agent и данные действия (title, origin, count, revision)
+	Attributes    map[string]string `protobuf:"bytes,11,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskEvent) Reset() {
+	*x = TaskEvent{}
+	mi := &file_kafka_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskEvent) ProtoMessage() {}
+
+func (x *TaskEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_kafka_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskEvent.ProtoReflect.Descriptor instead.
+func (*TaskEvent) Descriptor() ([]byte, []int) {
+	return file_kafka_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TaskEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ACTION_UNKNOWN
+}
+
+func (x *TaskEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in kafka_service.proto.
+func (x *TaskEvent) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *TaskEvent) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Событие, сохраненное kafka_service
+type StoredEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event         *TaskEvent             `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	ReceivedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"` // время чтения из Kafka
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoredEvent) Reset() {
+	*x = StoredEvent{}
+	mi := &file_kafka_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoredEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredEvent) ProtoMessage() {}
+
+func (x *StoredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_kafka_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoredEvent.ProtoReflect.Descriptor instead.
+func (*StoredEvent) Descriptor() ([]byte, []int) {
+	return file_kafka_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StoredEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StoredEvent) GetEvent() *TaskEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *StoredEvent) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+// Пустые поля не ограничивают выборку
+type EventFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action        ActionType             `protobuf:"varint,2,opt,name=action,proto3,enum=checklist.ActionType" json:"action,omitempty"` // ACTION_UNKNOWN - любое действие
+	TaskId        string                 `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"` // включительно
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`     // не включительно
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventFilter) Reset() {
+	*x = EventFilter{}
+	mi := &file_kafka_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFilter) ProtoMessage() {}
+
+func (x *EventFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_kafka_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventFilter.ProtoReflect.Descriptor instead.
+func (*EventFilter) Descriptor() ([]byte, []int) {
+	return file_kafka_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventFilter) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EventFilter) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ACTION_UNKNOWN
+}
+
+func (x *EventFilter) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *EventFilter) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *EventFilter) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type QueryEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *EventFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // по умолчанию 50, не больше 1000
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryEventsRequest) Reset() {
+	*x = QueryEventsRequest{}
+	mi := &file_kafka_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEventsRequest) ProtoMessage() {}
+
+func (x *QueryEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kafka_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryEventsRequest.ProtoReflect.Descriptor instead.
+func (*QueryEventsRequest) Descriptor() ([]byte, []int) {
+	return file_kafka_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryEventsRequest) GetFilter() *EventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *QueryEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryEventsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type QueryEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*StoredEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryEventsResponse) Reset() {
+	*x = QueryEventsResponse{}
+	mi := &file_kafka_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEventsResponse) ProtoMessage() {}
+
+func (x *QueryEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kafka_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryEventsResponse.ProtoReflect.Descriptor instead.
+func (*QueryEventsResponse) Descriptor() ([]byte, []int) {
+	return file_kafka_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryEventsResponse) GetEvents() []*StoredEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *QueryEventsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type CountEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *EventFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"` // фильтр по действию не применяется
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountEventsRequest) Reset() {
+	*x = CountEventsRequest{}
+	mi := &file_kafka_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountEventsRequest) ProtoMessage() {}
+
+func (x *CountEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kafka_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountEventsRequest.ProtoReflect.Descriptor instead.
+func (*CountEventsRequest) Descriptor() ([]byte, []int) {
+	return file_kafka_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CountEventsRequest) GetFilter() *EventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ActionCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        ActionType             `protobuf:"varint,1,opt,name=action,proto3,enum=checklist.ActionType" json:"action,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionCount) Reset() {
+	*x = ActionCount{}
+	mi := &file_kafka_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionCount) ProtoMessage() {}
+
+func (x *ActionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_kafka_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionCount.ProtoReflect.Descriptor instead.
+func (*ActionCount) Descriptor() ([]byte, []int) {
+	return file_kafka_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ActionCount) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ACTION_UNKNOWN
+}
+
+func (x *ActionCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CountEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        []*ActionCount         `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountEventsResponse) Reset() {
+	*x = CountEventsResponse{}
+	mi := &file_kafka_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountEventsResponse) ProtoMessage() {}
+
+func (x *CountEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kafka_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountEventsResponse.ProtoReflect.Descriptor instead.
+func (*CountEventsResponse) Descriptor() ([]byte, []int) {
+	return file_kafka_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CountEventsResponse) GetCounts() []*ActionCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+var File_kafka_service_proto protoreflect.FileDescriptor
+
+const file_kafka_service_proto_rawDesc = "" +
+	"\n" +
+	"\x13kafka_service.proto\x12\tchecklist\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdd\x03\n" +
+	"\tTaskEvent\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12-\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x15.checklist.ActionTypeR\x06action\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x17\n" +
+	"\atask_id\x18\x04 \x01(\tR\x06taskId\x12\x1c\n" +
+	"\adetails\x18\x05 \x01(\tB\x02\x18\x01R\adetails\x12\x19\n" +
+	"\bevent_id\x18\x06 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\trequestId\x12\x19\n" +
+	"\btrace_id\x18\b \x01(\tR\atraceId\x12\x16\n" +
+	"\x06source\x18\t \x01(\tR\x06source\x12%\n" +
+	"\x0eschema_version\x18\n" +
+	" \x01(\x05R\rschemaVersion\x12D\n" +
+	"\n" +
+	"attributes\x18\v \x03(\v2$.checklist.TaskEvent.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x86\x01\n" +
+	"\vStoredEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12*\n" +
+	"\x05event\x18\x02 \x01(\v2\x14.checklist.TaskEventR\x05event\x12;\n" +
+	"\vreceived_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\"\xca\x01\n" +
+	"\vEventFilter\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12-\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x15.checklist.ActionTypeR\x06action\x12\x17\n" +
+	"\atask_id\x18\x03 \x01(\tR\x06taskId\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"r\n" +
+	"\x12QueryEventsRequest\x12.\n" +
+	"\x06filter\x18\x01 \x01(\v2\x16.checklist.EventFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"f\n" +
+	"\x13QueryEventsResponse\x12.\n" +
+	"\x06events\x18\x01 \x03(\v2\x16.checklist.StoredEventR\x06events\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"D\n" +
+	"\x12CountEventsRequest\x12.\n" +
+	"\x06filter\x18\x01 \x01(\v2\x16.checklist.EventFilterR\x06filter\"R\n" +
+	"\vActionCount\x12-\n" +
+	"\x06action\x18\x01 \x01(\x0e2\x15.checklist.ActionTypeR\x06action\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"E\n" +
+	"\x13CountEventsResponse\x12.\n" +
+	"\x06counts\x18\x01 \x03(\v2\x16.checklist.ActionCountR\x06counts*\xe4\x01\n" +
+	"\n" +
+	"ActionType\x12\x12\n" +
+	"\x0eACTION_UNKNOWN\x10\x00\x12\x16\n" +
+	"\x12ACTION_CREATE_TASK\x10\x01\x12\x16\n" +
+	"\x12ACTION_DELETE_TASK\x10\x02\x12\x18\n" +
+	"\x14ACTION_COMPLETE_TASK\x10\x03\x12\x14\n" +
+	"\x10ACTION_GET_TASKS\x10\x04\x12\x16\n" +
+	"\x12ACTION_REVERT_TASK\x10\x05\x12\x16\n" +
+	"\x12ACTION_UPDATE_TASK\x10\x06\x12\x17\n" +
+	"\x13ACTION_ARCHIVE_TASK\x10\a\x12\x19\n" +
+	"\x15ACTION_UNARCHIVE_TASK\x10\b2\xb3\x01\n" +
+	"\x11EventQueryService\x12N\n" +
+	"\vQueryEvents\x12\x1d.checklist.QueryEventsRequest\x1a\x1e.checklist.QueryEventsResponse\"\x00\x12N\n" +
+	"\vCountEvents\x12\x1d.checklist.CountEventsRequest\x1a\x1e.checklist.CountEventsResponse\"\x00B\x06Z\x04.;pbb\x06proto3"
+
+var (
+	file_kafka_service_proto_rawDescOnce sync.Once
+	file_kafka_service_proto_rawDescData []byte
+)
+
+func file_kafka_service_proto_rawDescGZIP() []byte {
+	file_kafka_service_proto_rawDescOnce.Do(func() {
+		file_kafka_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_kafka_service_proto_rawDesc), len(file_kafka_service_proto_rawDesc)))
+	})
+	return file_kafka_service_proto_rawDescData
+}
+
+var file_kafka_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_kafka_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_kafka_service_proto_goTypes = []any{
+	(ActionType)(0),               // 0: checklist.ActionType
+	(*TaskEvent)(nil),             // 1: checklist.TaskEvent
+	(*StoredEvent)(nil),           // 2: checklist.StoredEvent
+	(*EventFilter)(nil),           // 3: checklist.EventFilter
+	(*QueryEventsRequest)(nil),    // 4: checklist.QueryEventsRequest
+	(*QueryEventsResponse)(nil),   // 5: checklist.QueryEventsResponse
+	(*CountEventsRequest)(nil),    // 6: checklist.CountEventsRequest
+	(*ActionCount)(nil),           // 7: checklist.ActionCount
+	(*CountEventsResponse)(nil),   // 8: checklist.CountEventsResponse
+	nil,                           // 9: checklist.TaskEvent.AttributesEntry
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+}
+var file_kafka_service_proto_depIdxs = []int32{
+	10, // 0: checklist.TaskEvent.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 1: checklist.TaskEvent.action:type_name -> checklist.ActionType
+	9,  // 2: checklist.TaskEvent.attributes:type_name -> checklist.TaskEvent.AttributesEntry
+	1,  // 3: checklist.StoredEvent.event:type_name -> checklist.TaskEvent
+	10, // 4: checklist.StoredEvent.received_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: checklist.EventFilter.action:type_name -> checklist.ActionType
+	10, // 6: checklist.EventFilter.from:type_name -> google.protobuf.Timestamp
+	10, // 7: checklist.EventFilter.to:type_name -> google.protobuf.Timestamp
+	3,  // 8: checklist.QueryEventsRequest.filter:type_name -> checklist.EventFilter
+	2,  // 9: checklist.QueryEventsResponse.events:type_name -> checklist.StoredEvent
+	3,  // 10: checklist.CountEventsRequest.filter:type_name -> checklist.EventFilter
+	0,  // 11: checklist.ActionCount.action:type_name -> checklist.ActionType
+	7,  // 12: checklist.CountEventsResponse.counts:type_name -> checklist.ActionCount
+	4,  // 13: checklist.EventQueryService.QueryEvents:input_type -> checklist.QueryEventsRequest
+	6,  // 14: checklist.EventQueryService.CountEvents:input_type -> checklist.CountEventsRequest
+	5,  // 15: checklist.EventQueryService.QueryEvents:output_type -> checklist.QueryEventsResponse
+	8,  // 16: checklist.EventQueryService.CountEvents:output_type -> checklist.CountEventsResponse
+	15, // [15:17] is the sub-list for method output_type
+	13, // [13:15] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_kafka_service_proto_init() }
+func file_kafka_service_proto_init() {
+	if File_kafka_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_kafka_service_proto_rawDesc), len(file_kafka_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_kafka_service_proto_goTypes,
+		DependencyIndexes: file_kafka_service_proto_depIdxs,
+		EnumInfos:         file_kafka_service_proto_enumTypes,
+		MessageInfos:      file_kafka_service_proto_msgTypes,
+	}.Build()
+	File_kafka_service_proto = out.File
+	file_kafka_service_proto_goTypes = nil
+	file_kafka_service_proto_depIdxs = nil
+}
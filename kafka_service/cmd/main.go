@@ -3,14 +3,23 @@ package main
 import (
 	"context"
 	"log"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/bagdasarian/checklist-app/kafka_service/config"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/client"
 	"github.com/bagdasarian/checklist-app/kafka_service/internal/consumer"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/dlq"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/eventstore"
 	"github.com/bagdasarian/checklist-app/kafka_service/internal/logger"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/server"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/source"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/webhook"
+	"github.com/bagdasarian/checklist-app/kafka_service/pkg/pb"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -25,42 +34,96 @@ func main() {
 	}
 	defer eventLogger.Close()
 
-	kafkaConsumer, err := consumer.NewConsumer(cfg, eventLogger)
-	if err != nil {
-		log.Fatalf("Failed to create Kafka consumer: %v", err)
+	// DLQ - топик Kafka, поэтому доступен только при чтении событий из Kafka
+	var deadLetters consumer.DeadLetterWriter
+	if cfg.Kafka.DLQTopic != "" && cfg.Events.Backend == source.BackendKafka {
+		writer := dlq.NewWriter(cfg.GetKafkaBrokers(), cfg.Kafka.DLQTopic)
+		defer writer.Close()
+		deadLetters = writer
 	}
-	defer kafkaConsumer.Close()
 
-	log.Println("Waiting for Kafka to initialize...")
+	log.Printf("Waiting for %s to initialize...", cfg.Events.Backend)
 	time.Sleep(10 * time.Second)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	events, err := source.New(ctx, cfg, cfg.Kafka.GroupID)
+	if err != nil {
+		log.Fatalf("Failed to create event source: %v", err)
+	}
+	eventConsumer, err := consumer.NewConsumer(cfg, events, eventLogger, deadLetters)
+	if err != nil {
+		log.Fatalf("Failed to create event consumer: %v", err)
+	}
+	defer eventConsumer.Close()
+
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
 	go func() {
-		if err := kafkaConsumer.Start(ctx); err != nil {
+		if err := eventConsumer.Start(ctx); err != nil {
 			log.Printf("Consumer error: %v", err)
 			cancel()
 		}
 	}()
 
-	go func() {
-		ticker := time.NewTicker(1 * time.Minute)
-		defer ticker.Stop()
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-ticker.C:
-				if err := eventLogger.RotateLog(); err != nil {
-					log.Printf("Error rotating log: %v", err)
-				}
+	if cfg.Webhook.Enabled {
+		dbClient, err := client.NewDBClient(cfg.GetDBServiceAddr())
+		if err != nil {
+			log.Fatalf("Failed to create DB client: %v", err)
+		}
+		defer dbClient.Close()
+
+		webhookEvents, err := source.New(ctx, cfg, cfg.Webhook.GroupID)
+		if err != nil {
+			log.Fatalf("Failed to create webhook event source: %v", err)
+		}
+		enqueuer := webhook.NewEnqueuer(cfg, webhookEvents, dbClient)
+		defer enqueuer.Close()
+		go func() {
+			if err := enqueuer.Start(ctx); err != nil {
+				log.Printf("Webhook enqueuer error: %v", err)
 			}
+		}()
+
+		dispatcher := webhook.NewDispatcher(cfg, dbClient, webhook.NewSender(cfg.GetWebhookTimeout(), cfg.Webhook.AllowPrivateNetworks))
+		go dispatcher.Run(ctx)
+	}
+
+	if cfg.EventStore.Enabled {
+		store, err := eventstore.New(ctx, cfg)
+		if err != nil {
+			log.Fatalf("Failed to create event store: %v", err)
 		}
-	}()
+		defer store.Close()
+
+		storeEvents, err := source.New(ctx, cfg, cfg.EventStore.GroupID)
+		if err != nil {
+			log.Fatalf("Failed to create event store source: %v", err)
+		}
+		recorder := eventstore.NewRecorder(cfg, storeEvents, store)
+		defer recorder.Close()
+		go func() {
+			if err := recorder.Start(ctx); err != nil {
+				log.Printf("Event recorder error: %v", err)
+			}
+		}()
+
+		lis, err := net.Listen("tcp", ":"+cfg.GRPC.Port)
+		if err != nil {
+			log.Fatalf("Failed to listen: %v", err)
+		}
+		grpcServer := grpc.NewServer()
+		pb.RegisterEventQueryServiceServer(grpcServer, server.NewEventQueryService(store))
+		defer grpcServer.GracefulStop()
+		go func() {
+			log.Printf("Starting event query service on port %s", cfg.GRPC.Port)
+			if err := grpcServer.Serve(lis); err != nil {
+				log.Printf("Event query service error: %v", err)
+			}
+		}()
+	}
 
 	log.Println("Kafka service started. Waiting for messages...")
 
@@ -0,0 +1,105 @@
+// Команда reprocess возвращает сообщения из DLQ в исходный топик, где их снова обрабатывает
+// основной потребитель kafka_service: сам он пишет лог событий и пропускает уже записанные события.
+// Сообщение, которое снова не удалось обработать, возвращается в DLQ с увеличенным числом попыток
+// и в этом запуске больше не читается. Команда завершается, когда новых сообщений нет дольше -idle.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/kafka_service/config"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/dlq"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/event"
+	"github.com/segmentio/kafka-go"
+)
+
+func main() {
+	groupID := flag.String("group", "kafka-service-dlq-reprocess", "consumer group for reading the dead-letter topic")
+	idle := flag.Duration("idle", 10*time.Second, "stop after no new messages for this long")
+	limit := flag.Int("limit", 0, "maximum number of messages to process, 0 - no limit")
+	dryRun := flag.Bool("dry-run", false, "only print messages without republishing or committing them")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	if cfg.Kafka.DLQTopic == "" {
+		log.Fatal("Dead-letter topic is not configured (KAFKA_DLQ_TOPIC)")
+	}
+
+	// Топик задается в каждом сообщении; ключ сохраняется, поэтому сообщение попадает в партицию
+	// с остальными событиями пользователя
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(cfg.GetKafkaBrokers()...),
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}
+	defer writer.Close()
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     cfg.GetKafkaBrokers(),
+		Topic:       cfg.Kafka.DLQTopic,
+		GroupID:     *groupID,
+		StartOffset: kafka.FirstOffset,
+		MinBytes:    1,
+		MaxBytes:    10e6,
+	})
+	defer reader.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Сообщения, записанные в DLQ после запуска, остаются до следующего запуска
+	startedAt := time.Now()
+
+	var replayed, skipped int
+	for *limit == 0 || replayed+skipped < *limit {
+		fetchCtx, cancel := context.WithTimeout(ctx, *idle)
+		msg, err := reader.FetchMessage(fetchCtx)
+		cancel()
+		if errors.Is(err, context.DeadlineExceeded) {
+			log.Printf("No new messages in %s for %v", cfg.Kafka.DLQTopic, *idle)
+			break
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("Error reading message: %v", err)
+			}
+			break
+		}
+
+		if !msg.Time.Before(startedAt) {
+			continue
+		}
+
+		failure, err := dlq.Parse(msg)
+		if err != nil {
+			log.Printf("Skipping message at %d/%d: %v", msg.Partition, msg.Offset, err)
+			skipped++
+		} else if *dryRun {
+			log.Printf("Message from %s: %d attempts, failed at %s: %s",
+				event.Position(failure.Message), failure.Attempts, failure.FailedAt, failure.Reason)
+			continue
+		} else if err := writer.WriteMessages(ctx, failure.Replay()); err != nil {
+			log.Printf("Stopping: failed to republish message from %s: %v", event.Position(failure.Message), err)
+			break
+		} else {
+			replayed++
+		}
+
+		if err := reader.CommitMessages(ctx, msg); err != nil {
+			log.Printf("Stopping: failed to commit offset: %v", err)
+			break
+		}
+	}
+
+	log.Printf("Republished %d messages, skipped %d", replayed, skipped)
+}
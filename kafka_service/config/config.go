@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -11,13 +12,78 @@ type Config struct {
 		Brokers []string `yaml:"brokers" env:"KAFKA_BROKERS" env-default:"localhost:9092"`
 		Topic   string   `yaml:"topic" env:"KAFKA_TOPIC" env-default:"task-events"`
 		GroupID string   `yaml:"group_id" env:"KAFKA_GROUP_ID" env-default:"kafka-service"`
+		// Топик для сообщений, которые не удалось обработать; пустой - такие сообщения отбрасываются
+		DLQTopic    string `yaml:"dlq_topic" env:"KAFKA_DLQ_TOPIC" env-default:"task-events-dlq"`
+		MaxAttempts int    `yaml:"max_attempts" env:"KAFKA_MAX_ATTEMPTS" env-default:"3"` // попыток записи события перед отправкой в DLQ
+		// Смещения фиксируются после записи событий в лог: пачкой из CommitBatchSize событий
+		// или через CommitInterval секунд после первого незафиксированного события
+		CommitBatchSize int `yaml:"commit_batch_size" env:"KAFKA_COMMIT_BATCH_SIZE" env-default:"100"`
+		CommitInterval  int `yaml:"commit_interval" env:"KAFKA_COMMIT_INTERVAL" env-default:"1"` // секунды
 	} `yaml:"kafka"`
 
+	// Брокер, из которого читаются события: kafka, redis (Redis Streams) или nats (NATS JetStream).
+	// Поток Redis и subject NATS называются как топик Kafka, группы потребителей - как в Kafka.
+	Events struct {
+		Backend string `yaml:"backend" env:"EVENT_BACKEND" env-default:"kafka"`
+	} `yaml:"events"`
+
+	Redis struct {
+		Host     string `yaml:"host" env:"REDIS_HOST" env-default:"localhost"`
+		Port     string `yaml:"port" env:"REDIS_PORT" env-default:"6379"`
+		Password string `yaml:"password" env:"REDIS_PASSWORD" env-default:""`
+		DB       int    `yaml:"db" env:"REDIS_DB" env-default:"0"`
+	} `yaml:"redis"`
+
+	NATS struct {
+		URL    string `yaml:"url" env:"NATS_URL" env-default:"nats://localhost:4222"`
+		Stream string `yaml:"stream" env:"NATS_STREAM" env-default:"TASK_EVENTS"`
+	} `yaml:"nats"`
+
 	Logging struct {
 		FilePath string `yaml:"file_path" env:"LOG_FILE_PATH" env-default:"/var/log/kafka-service/events.log"`
-		MaxSize  int    `yaml:"max_size" env:"LOG_MAX_SIZE" env-default:"100"` // MB
-		MaxFiles int    `yaml:"max_files" env:"LOG_MAX_FILES" env-default:"10"`
+		MaxSize  int    `yaml:"max_size" env:"LOG_MAX_SIZE" env-default:"100"`   // MB, 0 - без ротации по размеру
+		Rotation string `yaml:"rotation" env:"LOG_ROTATION" env-default:"daily"` // hourly, daily; пусто - только по размеру
+		MaxFiles int    `yaml:"max_files" env:"LOG_MAX_FILES" env-default:"10"`  // 0 - без ограничения
+		MaxAge   int    `yaml:"max_age" env:"LOG_MAX_AGE" env-default:"30"`      // дней, 0 - без ограничения
+		Compress bool   `yaml:"compress" env:"LOG_COMPRESS" env-default:"true"`
+		// fsync после каждого события; если выключен, лог сбрасывается на диск пачкой перед фиксацией смещений
+		SyncEachEvent bool `yaml:"sync_each_event" env:"LOG_SYNC_EACH_EVENT" env-default:"true"`
 	} `yaml:"logging"`
+
+	// Хранилище событий задач для EventQueryService: схема events в PostgreSQL
+	EventStore struct {
+		Enabled  bool   `yaml:"enabled" env:"EVENT_STORE_ENABLED" env-default:"true"`
+		Host     string `yaml:"host" env:"DB_HOST" env-default:"postgres"`
+		Port     string `yaml:"port" env:"DB_PORT" env-default:"5432"`
+		User     string `yaml:"user" env:"DB_USER" env-default:"docker"`
+		Password string `yaml:"password" env:"DB_PASSWORD" env-default:"docker"`
+		Name     string `yaml:"name" env:"DB_NAME" env-default:"test_db"`
+		GroupID  string `yaml:"group_id" env:"EVENT_STORE_GROUP_ID" env-default:"kafka-service-events"`
+	} `yaml:"event_store"`
+
+	GRPC struct {
+		Port string `yaml:"port" env:"GRPC_PORT" env-default:"50052"`
+	} `yaml:"grpc"`
+
+	DBService struct {
+		Host string `yaml:"host" env:"DB_SERVICE_HOST" env-default:"localhost"`
+		Port string `yaml:"port" env:"DB_SERVICE_PORT" env-default:"50051"`
+	} `yaml:"db_service"`
+
+	// Отправка событий задач на вебхуки пользователей
+	Webhook struct {
+		Enabled        bool   `yaml:"enabled" env:"WEBHOOK_ENABLED" env-default:"true"`
+		GroupID        string `yaml:"group_id" env:"WEBHOOK_GROUP_ID" env-default:"kafka-service-webhooks"`
+		Timeout        int    `yaml:"timeout" env:"WEBHOOK_TIMEOUT" env-default:"10"`                   // секунды
+		MaxAttempts    int    `yaml:"max_attempts" env:"WEBHOOK_MAX_ATTEMPTS" env-default:"8"`          // попыток на доставку
+		RetryBaseDelay int    `yaml:"retry_base_delay" env:"WEBHOOK_RETRY_BASE_DELAY" env-default:"30"` // секунды, удваивается с каждой попыткой
+		RetryMaxDelay  int    `yaml:"retry_max_delay" env:"WEBHOOK_RETRY_MAX_DELAY" env-default:"3600"` // секунды
+		DisableAfter   int    `yaml:"disable_after" env:"WEBHOOK_DISABLE_AFTER" env-default:"5"`        // неудачных доставок подряд, 0 - не отключать
+		PollInterval   int    `yaml:"poll_interval" env:"WEBHOOK_POLL_INTERVAL" env-default:"5"`        // секунды
+		BatchSize      int    `yaml:"batch_size" env:"WEBHOOK_BATCH_SIZE" env-default:"20"`
+		// Разрешить доставку на адреса внутренней сети (localhost, частные сети); только для разработки и тестов
+		AllowPrivateNetworks bool `yaml:"allow_private_networks" env:"WEBHOOK_ALLOW_PRIVATE_NETWORKS" env-default:"false"`
+	} `yaml:"webhook"`
 }
 
 func Load() (*Config, error) {
@@ -41,3 +107,55 @@ func (c *Config) GetKafkaBrokers() []string {
 	return c.Kafka.Brokers
 }
 
+func (c *Config) GetRedisAddr() string {
+	return fmt.Sprintf("%s:%s", c.Redis.Host, c.Redis.Port)
+}
+
+func (c *Config) GetEventStoreURL() string {
+	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		c.EventStore.User, c.EventStore.Password, c.EventStore.Host, c.EventStore.Port, c.EventStore.Name)
+}
+
+func (c *Config) GetCommitInterval() time.Duration {
+	interval := time.Duration(c.Kafka.CommitInterval) * time.Second
+	if interval == 0 {
+		return time.Second
+	}
+	return interval
+}
+
+func (c *Config) GetDBServiceAddr() string {
+	return fmt.Sprintf("%s:%s", c.DBService.Host, c.DBService.Port)
+}
+
+func (c *Config) GetWebhookTimeout() time.Duration {
+	timeout := time.Duration(c.Webhook.Timeout) * time.Second
+	if timeout == 0 {
+		return 10 * time.Second
+	}
+	return timeout
+}
+
+func (c *Config) GetWebhookRetryBaseDelay() time.Duration {
+	delay := time.Duration(c.Webhook.RetryBaseDelay) * time.Second
+	if delay == 0 {
+		return 30 * time.Second
+	}
+	return delay
+}
+
+func (c *Config) GetWebhookRetryMaxDelay() time.Duration {
+	delay := time.Duration(c.Webhook.RetryMaxDelay) * time.Second
+	if delay == 0 {
+		return time.Hour
+	}
+	return delay
+}
+
+func (c *Config) GetWebhookPollInterval() time.Duration {
+	interval := time.Duration(c.Webhook.PollInterval) * time.Second
+	if interval == 0 {
+		return 5 * time.Second
+	}
+	return interval
+}
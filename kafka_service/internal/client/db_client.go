@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type DBClient struct {
+	conn   *grpc.ClientConn
+	client dbpb.DatabaseServiceClient
+}
+
+func NewDBClient(addr string) (*DBClient, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to db_service: %w", err)
+	}
+
+	log.Printf("Successfully connected to db_service at %s", addr)
+
+	return &DBClient{
+		conn:   conn,
+		client: dbpb.NewDatabaseServiceClient(conn),
+	}, nil
+}
+
+func (c *DBClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
+
+func (c *DBClient) GetTask(ctx context.Context, req *dbpb.GetTaskRequest) (*dbpb.GetTaskResponse, error) {
+	return c.client.GetTask(ctx, req)
+}
+
+func (c *DBClient) EnqueueWebhookDeliveries(ctx context.Context, req *dbpb.EnqueueWebhookDeliveriesRequest) (*dbpb.EnqueueWebhookDeliveriesResponse, error) {
+	return c.client.EnqueueWebhookDeliveries(ctx, req)
+}
+
+func (c *DBClient) ClaimWebhookDeliveries(ctx context.Context, req *dbpb.ClaimWebhookDeliveriesRequest) (*dbpb.ClaimWebhookDeliveriesResponse, error) {
+	return c.client.ClaimWebhookDeliveries(ctx, req)
+}
+
+func (c *DBClient) CompleteWebhookDelivery(ctx context.Context, req *dbpb.CompleteWebhookDeliveryRequest) (*dbpb.CompleteWebhookDeliveryResponse, error) {
+	return c.client.CompleteWebhookDelivery(ctx, req)
+}
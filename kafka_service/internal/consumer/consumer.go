@@ -2,91 +2,255 @@ package consumer
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/bagdasarian/checklist-app/kafka_service/config"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/event"
 	"github.com/bagdasarian/checklist-app/kafka_service/internal/logger"
-	"github.com/bagdasarian/checklist-app/kafka_service/pkg/pb"
-	"github.com/segmentio/kafka-go"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/source"
 )
 
+// Сколько ID последних событий помнит потребитель, чтобы не записать событие повторно.
+// Должно быть больше числа событий, которые могут остаться незафиксированными.
+const dedupCapacity = 10000
+
+// Сколько байт в конце лога читается при запуске, чтобы восстановить ID записанных событий
+const dedupTailBytes = 4 << 20
+
+// Пауза между повторами записи, которую нельзя пропустить: отправки в DLQ
+// или записи в лог, если DLQ нет
+const (
+	retryMinDelay = time.Second
+	retryMaxDelay = 30 * time.Second
+)
+
+// DeadLetterWriter принимает сообщения, которые не удалось обработать (dlq.Writer)
+type DeadLetterWriter interface {
+	Send(ctx context.Context, msg source.Message, attempts int, reason error) error
+}
+
+// Consumer читает события и записывает их в лог. Сообщение подтверждается только после того,
+// как событие записано на диск или отправлено в DLQ, поэтому после сбоя события читаются
+// повторно, а уже записанные пропускаются по ID события (см. event.DedupKey), в том числе
+// если издатель отправил событие повторно и оно получило новую позицию.
 type Consumer struct {
-	reader *kafka.Reader
-	logger *logger.Logger
-	config *config.Config
+	events  source.EventSource
+	handler *Handler
+	logger  *logger.Logger
+	dlq     DeadLetterWriter
+	seen    *recentIDs
+	config  *config.Config
 }
 
-func NewConsumer(cfg *config.Config, eventLogger *logger.Logger) (*Consumer, error) {
-	reader := kafka.NewReader(kafka.ReaderConfig{
-		Brokers:  cfg.GetKafkaBrokers(),
-		Topic:    cfg.Kafka.Topic,
-		GroupID:  cfg.Kafka.GroupID,
-		MinBytes: 10e3,
-		MaxBytes: 10e6,
-	})
+// NewConsumer создает потребителя событий из events. Если deadLetters равен nil, нераспознанные
+// сообщения только записываются в лог сервиса, а запись в лог событий повторяется, пока не удастся.
+func NewConsumer(cfg *config.Config, events source.EventSource, eventLogger *logger.Logger, deadLetters DeadLetterWriter) (*Consumer, error) {
+	seen := newRecentIDs(dedupCapacity)
+	ids, err := eventLogger.RecentEventIDs(dedupTailBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read logged event IDs: %w", err)
+	}
+	for _, id := range ids {
+		seen.add(id)
+	}
 
 	return &Consumer{
-		reader: reader,
-		logger: eventLogger,
-		config: cfg,
+		events:  events,
+		handler: NewHandler(cfg, eventLogger),
+		logger:  eventLogger,
+		dlq:     deadLetters,
+		seen:    seen,
+		config:  cfg,
 	}, nil
 }
 
-// Start запускает обработку сообщений из Kafka
+// Start запускает обработку сообщений
 func (c *Consumer) Start(ctx context.Context) error {
-	log.Printf("Starting Kafka consumer for topic: %s", c.config.Kafka.Topic)
+	log.Printf("Starting event consumer for topic: %s", c.config.Kafka.Topic)
 
 	var retryCount int
 	maxRetryDelay := 30 * time.Second
 	baseDelay := 1 * time.Second
 
+	batchSize := c.config.Kafka.CommitBatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	commitInterval := c.config.GetCommitInterval()
+
+	// Обработанные, но еще не зафиксированные сообщения
+	var pending []source.Message
+	var pendingSince time.Time
+	commit := func(ctx context.Context) {
+		if len(pending) == 0 {
+			return
+		}
+		// Смещения фиксируются только после того, как события записаны на диск
+		if err := c.logger.Sync(); err != nil {
+			log.Printf("Error syncing event log, not committing %d messages: %v", len(pending), err)
+			return
+		}
+		if err := c.events.Commit(ctx, pending...); err != nil {
+			log.Printf("Error committing %d messages: %v", len(pending), err)
+			return
+		}
+		pending = pending[:0]
+	}
+
 	for {
-		select {
-		case <-ctx.Done():
-			log.Println("Stopping Kafka consumer...")
-			return c.reader.Close()
-		default:
-			msg, err := c.reader.ReadMessage(ctx)
-			if err != nil {
-				if err == context.Canceled {
-					return nil
-				}
+		var commitAt time.Time
+		if len(pending) > 0 {
+			commitAt = pendingSince.Add(commitInterval)
+		}
+		msg, err := c.fetch(ctx, commitAt)
 
-				if isLeaderNotAvailableError(err) {
-					retryCount++
-					delay := baseDelay * time.Duration(1<<uint(min(retryCount, 5)))
-					if delay > maxRetryDelay {
-						delay = maxRetryDelay
-					}
-					log.Printf("Leader not available (attempt %d), retrying in %v: %v", retryCount, delay, err)
-					time.Sleep(delay)
-					continue
+		if ctx.Err() != nil {
+			log.Println("Stopping event consumer...")
+			// Контекст уже отменен, фиксируем обработанные сообщения с отдельным таймаутом
+			commitCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			commit(commitCtx)
+			cancel()
+			return nil
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			commit(ctx)
+			continue
+		}
+		if err != nil {
+			if isLeaderNotAvailableError(err) {
+				retryCount++
+				delay := baseDelay * time.Duration(1<<uint(min(retryCount, 5)))
+				if delay > maxRetryDelay {
+					delay = maxRetryDelay
 				}
-
-				log.Printf("Error reading message: %v", err)
-				retryCount = 0
-				time.Sleep(baseDelay)
+				log.Printf("Leader not available (attempt %d), retrying in %v: %v", retryCount, delay, err)
+				time.Sleep(delay)
 				continue
 			}
 
+			log.Printf("Error reading message: %v", err)
 			retryCount = 0
+			time.Sleep(baseDelay)
+			continue
+		}
 
-			var event pb.TaskEvent
-			if err := json.Unmarshal(msg.Value, &event); err != nil {
-				log.Printf("Error unmarshaling message: %v", err)
-				continue
-			}
+		retryCount = 0
 
-			if err := c.logger.LogEvent(&event); err != nil {
-				log.Printf("Error logging event: %v", err)
-			}
+		if !c.process(ctx, msg) {
+			continue
+		}
+
+		if len(pending) == 0 {
+			pendingSince = time.Now()
+		}
+		pending = append(pending, msg)
+		if len(pending) >= batchSize {
+			commit(ctx)
 		}
 	}
 }
 
+// fetch читает следующее сообщение; если задан deadline, ожидание ограничено им
+func (c *Consumer) fetch(ctx context.Context, deadline time.Time) (source.Message, error) {
+	if deadline.IsZero() {
+		return c.events.Fetch(ctx)
+	}
+	fetchCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+	return c.events.Fetch(fetchCtx)
+}
+
+// process записывает событие в лог или отправляет его в DLQ; уже записанное событие пропускается.
+// Возвращает false, если обработку прервала остановка потребителя и смещение фиксировать нельзя.
+// ID события запоминается только после записи в лог, поэтому событие, возвращенное из DLQ
+// командой reprocess, обрабатывается заново.
+func (c *Consumer) process(ctx context.Context, msg source.Message) bool {
+	taskEvent, err := event.Decode(msg)
+	if err != nil {
+		// Нераспознанное сообщение без DLQ некуда отложить, и повтор его не исправит
+		if c.dlq == nil {
+			log.Printf("Dropping message at %s: %v", event.Position(msg), err)
+			return true
+		}
+		return c.deadLetter(ctx, msg, 1, err)
+	}
+
+	key := event.DedupKey(msg, taskEvent)
+	if c.seen.contains(key) {
+		log.Printf("Skipping already logged event %s at %s", key, event.Position(msg))
+		return true
+	}
+
+	delay := retryMinDelay
+	for {
+		attempts, err := c.handler.Log(ctx, msg, taskEvent)
+		if err == nil {
+			c.seen.add(key)
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		if c.dlq != nil {
+			return c.deadLetter(ctx, msg, attempts, err)
+		}
+
+		// Без DLQ пропущенное событие потерялось бы, поэтому запись повторяется
+		log.Printf("Failed to log event at %s, retrying in %v: %v", event.Position(msg), delay, err)
+		if !wait(ctx, delay) {
+			return false
+		}
+		delay = nextDelay(delay)
+	}
+}
+
+// deadLetter отправляет необработанное сообщение в DLQ, повторяя отправку, пока она не удастся.
+// Возвращает false, если потребитель остановлен раньше: тогда смещение фиксировать нельзя,
+// иначе сообщение не попадет ни в лог событий, ни в DLQ.
+func (c *Consumer) deadLetter(ctx context.Context, msg source.Message, attempts int, reason error) bool {
+	position := event.Position(msg)
+	delay := retryMinDelay
+	for {
+		err := c.dlq.Send(ctx, msg, attempts, reason)
+		if err == nil {
+			log.Printf("Message at %s sent to dead-letter topic after %d attempts: %v", position, attempts, reason)
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+
+		log.Printf("Failed to send message at %s to dead-letter topic, retrying in %v: %v (original error: %v)",
+			position, delay, err, reason)
+		if !wait(ctx, delay) {
+			return false
+		}
+		delay = nextDelay(delay)
+	}
+}
+
+// wait ждет delay; false, если раньше отменен контекст
+func wait(ctx context.Context, delay time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(delay):
+		return true
+	}
+}
+
+// nextDelay удваивает паузу между повторами до retryMaxDelay
+func nextDelay(delay time.Duration) time.Duration {
+	if delay*2 > retryMaxDelay {
+		return retryMaxDelay
+	}
+	return delay * 2
+}
+
 // isLeaderNotAvailableError проверяет, является ли ошибка связанной с недоступностью лидера
 func isLeaderNotAvailableError(err error) bool {
 	if err == nil {
@@ -106,8 +270,8 @@ func min(a, b int) int {
 	return b
 }
 
-// Close закрывает соединение с Kafka
+// Close закрывает соединение с брокером
 func (c *Consumer) Close() error {
-	return c.reader.Close()
+	return c.events.Close()
 }
 
@@ -0,0 +1,308 @@
+package consumer
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/kafka_service/config"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/event"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/logger"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/source"
+	"github.com/bagdasarian/checklist-app/kafka_service/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func testConfig(t *testing.T) *config.Config {
+	cfg := &config.Config{}
+	cfg.Logging.FilePath = filepath.Join(t.TempDir(), "events.log")
+	cfg.Logging.SyncEachEvent = true
+	cfg.Kafka.CommitBatchSize = 1
+	return cfg
+}
+
+func eventMessage(t *testing.T, offset int64, taskEvent *pb.TaskEvent) source.Message {
+	value, err := proto.Marshal(taskEvent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return source.Message{
+		Stream: "task-events",
+		Offset: offset,
+		Key:    []byte(taskEvent.UserId),
+		Value:  value,
+		Time:   time.Now(),
+		Headers: []source.Header{
+			{Key: event.HeaderContentType, Value: []byte(event.ContentTypeProtobuf)},
+			{Key: event.HeaderSchemaVersion, Value: []byte(event.SchemaVersion)},
+		},
+	}
+}
+
+// consume обрабатывает msgs новым потребителем и возвращает число подтвержденных сообщений
+func consume(t *testing.T, cfg *config.Config, msgs ...source.Message) int {
+	return consumeWith(t, cfg, nil, msgs...)
+}
+
+// consumeWith работает как consume, но перед запуском передает потребителя в setup
+func consumeWith(t *testing.T, cfg *config.Config, setup func(c *Consumer, eventLogger *logger.Logger), msgs ...source.Message) int {
+	eventLogger, err := logger.NewLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer eventLogger.Close()
+
+	ch := make(chan source.Message, len(msgs))
+	for _, msg := range msgs {
+		ch <- msg
+	}
+	events := source.NewMemorySource(ch)
+	c, err := NewConsumer(cfg, events, eventLogger, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if setup != nil {
+		setup(c, eventLogger)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Start(ctx)
+	}()
+	deadline := time.Now().Add(5 * time.Second)
+	for len(events.Committed()) < len(msgs) && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	<-done
+	return len(events.Committed())
+}
+
+// failingLog отклоняет первые failures записей, остальные передает в лог
+type failingLog struct {
+	EventLog
+	failures int
+}
+
+func (l *failingLog) LogEvent(position string, taskEvent *pb.TaskEvent) error {
+	if l.failures > 0 {
+		l.failures--
+		return errors.New("disk is full")
+	}
+	return l.EventLog.LogEvent(position, taskEvent)
+}
+
+// fakeDeadLetters запоминает отправленные в DLQ сообщения или отклоняет их с ошибкой err
+type fakeDeadLetters struct {
+	err error
+
+	mu   sync.Mutex
+	sent []source.Message
+	// Число вызовов Send, включая неудачные
+	calls int
+}
+
+func (d *fakeDeadLetters) Send(ctx context.Context, msg source.Message, attempts int, reason error) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.calls++
+	if d.err != nil {
+		return d.err
+	}
+	d.sent = append(d.sent, msg)
+	return nil
+}
+
+func (d *fakeDeadLetters) Calls() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.calls
+}
+
+// gzipFile сжимает файл path на месте, как сжатый архив лога
+func gzipFile(t *testing.T, path string) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(data)
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// loggedEntries возвращает записи лога событий
+func loggedEntries(t *testing.T, cfg *config.Config) []logger.LogEntry {
+	file, err := os.Open(cfg.Logging.FilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var entries []logger.LogEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry logger.LogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestConsumerSkipsRepublishedEvent(t *testing.T) {
+	cfg := testConfig(t)
+	first := &pb.TaskEvent{EventId: "event-1", UserId: "user-1", Action: pb.ActionType_ACTION_CREATE_TASK}
+	second := &pb.TaskEvent{EventId: "event-2", UserId: "user-1", Action: pb.ActionType_ACTION_UPDATE_TASK}
+
+	// Издатель отправил первое событие повторно, поэтому оно пришло с новым смещением
+	committed := consume(t, cfg,
+		eventMessage(t, 0, first),
+		eventMessage(t, 1, second),
+		eventMessage(t, 2, first),
+	)
+	if committed != 3 {
+		t.Fatalf("committed %d messages, want 3", committed)
+	}
+
+	entries := loggedEntries(t, cfg)
+	if len(entries) != 2 {
+		t.Fatalf("logged %d events, want 2", len(entries))
+	}
+	if entries[0].EventID != "event-1" || entries[1].EventID != "event-2" {
+		t.Fatalf("logged events %q, %q", entries[0].EventID, entries[1].EventID)
+	}
+}
+
+func TestConsumerRestoresEventIDsFromLog(t *testing.T) {
+	cfg := testConfig(t)
+	taskEvent := &pb.TaskEvent{EventId: "event-1", UserId: "user-1", Action: pb.ActionType_ACTION_CREATE_TASK}
+	consume(t, cfg, eventMessage(t, 0, taskEvent))
+
+	// После перезапуска то же событие приходит с другим смещением
+	consume(t, cfg, eventMessage(t, 5, taskEvent))
+
+	if entries := loggedEntries(t, cfg); len(entries) != 1 {
+		t.Fatalf("logged %d events, want 1", len(entries))
+	}
+}
+
+func TestConsumerDeduplicatesLegacyEventsByPosition(t *testing.T) {
+	cfg := testConfig(t)
+	legacy := &pb.TaskEvent{UserId: "user-1", Action: pb.ActionType_ACTION_CREATE_TASK}
+
+	consume(t, cfg, eventMessage(t, 0, legacy), eventMessage(t, 1, legacy))
+	// Повторное чтение того же сообщения после перезапуска
+	consume(t, cfg, eventMessage(t, 1, legacy))
+
+	if entries := loggedEntries(t, cfg); len(entries) != 2 {
+		t.Fatalf("logged %d events, want 2", len(entries))
+	}
+}
+
+func TestConsumerLogsRedeliveredEventAfterFailure(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.Kafka.MaxAttempts = 1
+	taskEvent := &pb.TaskEvent{EventId: "event-1", UserId: "user-1", Action: pb.ActionType_ACTION_CREATE_TASK}
+
+	// Первая запись не удалась; reprocess вернул событие из DLQ с тем же event_id
+	deadLetters := &fakeDeadLetters{}
+	committed := consumeWith(t, cfg, func(c *Consumer, eventLogger *logger.Logger) {
+		c.handler = NewHandler(cfg, &failingLog{EventLog: eventLogger, failures: 1})
+		c.dlq = deadLetters
+	}, eventMessage(t, 0, taskEvent), eventMessage(t, 1, taskEvent))
+	if committed != 2 {
+		t.Fatalf("committed %d messages, want 2", committed)
+	}
+	if len(deadLetters.sent) != 1 {
+		t.Fatalf("sent %d messages to DLQ, want 1", len(deadLetters.sent))
+	}
+
+	entries := loggedEntries(t, cfg)
+	if len(entries) != 1 || entries[0].EventID != "event-1" {
+		t.Fatalf("logged %d events, want event-1 once", len(entries))
+	}
+}
+
+func TestConsumerDoesNotCommitWhenDeadLetterFails(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.Kafka.MaxAttempts = 1
+	eventLogger, err := logger.NewLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer eventLogger.Close()
+
+	ch := make(chan source.Message, 1)
+	ch <- eventMessage(t, 0, &pb.TaskEvent{EventId: "event-1", UserId: "user-1"})
+	events := source.NewMemorySource(ch)
+	deadLetters := &fakeDeadLetters{err: errors.New("broker is unavailable")}
+	c, err := NewConsumer(cfg, events, eventLogger, deadLetters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.handler = NewHandler(cfg, &failingLog{EventLog: eventLogger, failures: 1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Start(ctx)
+	}()
+	// Отправка в DLQ повторяется, а сообщение остается неподтвержденным
+	deadline := time.Now().Add(5 * time.Second)
+	for deadLetters.Calls() < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	if calls := deadLetters.Calls(); calls < 2 {
+		t.Fatalf("DLQ send was attempted %d times, want a retry", calls)
+	}
+	if committed := len(events.Committed()); committed != 0 {
+		t.Fatalf("committed %d messages, want 0", committed)
+	}
+}
+
+func TestConsumerRestoresEventIDsAfterRotation(t *testing.T) {
+	for _, name := range []string{"20260101-120000.000", "20260101-120000", "20260101-120000.000.gz"} {
+		t.Run(name, func(t *testing.T) {
+			cfg := testConfig(t)
+			taskEvent := &pb.TaskEvent{EventId: "event-1", UserId: "user-1", Action: pb.ActionType_ACTION_CREATE_TASK}
+			consume(t, cfg, eventMessage(t, 0, taskEvent))
+
+			// Лог ротирован перед перезапуском, ID события есть только в архиве
+			archive := cfg.Logging.FilePath + "." + name
+			if err := os.Rename(cfg.Logging.FilePath, archive); err != nil {
+				t.Fatal(err)
+			}
+			if strings.HasSuffix(name, ".gz") {
+				gzipFile(t, archive)
+			}
+			consume(t, cfg, eventMessage(t, 5, taskEvent))
+
+			if entries := loggedEntries(t, cfg); len(entries) != 0 {
+				t.Fatalf("logged %d events after rotation, want 0", len(entries))
+			}
+		})
+	}
+}
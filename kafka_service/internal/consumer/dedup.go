@@ -0,0 +1,35 @@
+package consumer
+
+// recentIDs - ограниченное множество ID последних записанных событий.
+// При переполнении вытесняется самый старый ID.
+type recentIDs struct {
+	ids   map[string]struct{}
+	order []string
+	next  int
+}
+
+func newRecentIDs(capacity int) *recentIDs {
+	return &recentIDs{
+		ids:   make(map[string]struct{}, capacity),
+		order: make([]string, 0, capacity),
+	}
+}
+
+func (r *recentIDs) contains(id string) bool {
+	_, ok := r.ids[id]
+	return ok
+}
+
+func (r *recentIDs) add(id string) {
+	if r.contains(id) {
+		return
+	}
+	if len(r.order) < cap(r.order) {
+		r.order = append(r.order, id)
+	} else {
+		delete(r.ids, r.order[r.next])
+		r.order[r.next] = id
+		r.next = (r.next + 1) % len(r.order)
+	}
+	r.ids[id] = struct{}{}
+}
@@ -0,0 +1,57 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/kafka_service/config"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/event"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/source"
+	"github.com/bagdasarian/checklist-app/kafka_service/pkg/pb"
+)
+
+// EventLog - лог, в который Handler записывает события (logger.Logger)
+type EventLog interface {
+	LogEvent(position string, event *pb.TaskEvent) error
+}
+
+// Handler записывает разобранное событие в лог событий
+type Handler struct {
+	logger      EventLog
+	maxAttempts int
+}
+
+func NewHandler(cfg *config.Config, eventLogger EventLog) *Handler {
+	maxAttempts := cfg.Kafka.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 3
+	}
+	return &Handler{
+		logger:      eventLogger,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Log записывает разобранное событие в лог, повторяя запись до maxAttempts раз.
+// Возвращает число сделанных попыток.
+func (h *Handler) Log(ctx context.Context, msg source.Message, taskEvent *pb.TaskEvent) (int, error) {
+	for attempt := 1; ; attempt++ {
+		err := h.logger.LogEvent(event.Position(msg), taskEvent)
+		if err == nil {
+			return attempt, nil
+		}
+		if attempt >= h.maxAttempts || ctx.Err() != nil {
+			return attempt, fmt.Errorf("failed to log event: %w", err)
+		}
+
+		delay := time.Duration(attempt) * time.Second
+		log.Printf("Error logging event at %s (attempt %d/%d), retrying in %v: %v",
+			event.Position(msg), attempt, h.maxAttempts, delay, err)
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+		}
+	}
+}
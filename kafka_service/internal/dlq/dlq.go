@@ -0,0 +1,169 @@
+// Package dlq отправляет необработанные сообщения в топик недоставленных сообщений (DLQ)
+// и восстанавливает из него исходные сообщения, чтобы вернуть их в исходный топик.
+package dlq
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/source"
+	"github.com/segmentio/kafka-go"
+)
+
+// Заголовки, которые добавляются к исходным заголовкам сообщения в DLQ
+const (
+	HeaderError     = "dlq-error"
+	HeaderAttempts  = "dlq-attempts"
+	HeaderTopic     = "dlq-source-topic"
+	HeaderPartition = "dlq-source-partition"
+	HeaderOffset    = "dlq-source-offset"
+	HeaderFailedAt  = "dlq-failed-at"
+)
+
+const headerPrefix = "dlq-"
+
+type Writer struct {
+	writer *kafka.Writer
+}
+
+// NewWriter создает синхронного производителя в топик topic: Send возвращает управление
+// только после подтверждения записи всеми репликами
+func NewWriter(brokers []string, topic string) *Writer {
+	return &Writer{
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Topic:                  topic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+		},
+	}
+}
+
+// Send отправляет сообщение в DLQ с исходными ключом, телом и заголовками, причиной ошибки,
+// числом попыток и позицией в исходном топике. Для сообщения, возвращенного из DLQ командой
+// reprocess, попытки суммируются с прежними и сохраняется его первоначальная позиция.
+func (w *Writer) Send(ctx context.Context, msg source.Message, attempts int, reason error) error {
+	topic, partition, offset := msg.Stream, msg.Partition, msg.Offset
+
+	headers := make([]kafka.Header, 0, len(msg.Headers)+6)
+	for _, h := range msg.Headers {
+		var err error
+		switch h.Key {
+		case HeaderAttempts:
+			var previous int
+			previous, err = strconv.Atoi(string(h.Value))
+			attempts += previous
+		case HeaderTopic:
+			topic = string(h.Value)
+		case HeaderPartition:
+			partition, err = strconv.Atoi(string(h.Value))
+		case HeaderOffset:
+			offset, err = strconv.ParseInt(string(h.Value), 10, 64)
+		default:
+			if !strings.HasPrefix(h.Key, headerPrefix) {
+				headers = append(headers, kafka.Header{Key: h.Key, Value: h.Value})
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("invalid header %s: %w", h.Key, err)
+		}
+	}
+	headers = append(headers,
+		kafka.Header{Key: HeaderError, Value: []byte(reason.Error())},
+		kafka.Header{Key: HeaderAttempts, Value: []byte(strconv.Itoa(attempts))},
+		kafka.Header{Key: HeaderTopic, Value: []byte(topic)},
+		kafka.Header{Key: HeaderPartition, Value: []byte(strconv.Itoa(partition))},
+		kafka.Header{Key: HeaderOffset, Value: []byte(strconv.FormatInt(offset, 10))},
+		kafka.Header{Key: HeaderFailedAt, Value: []byte(time.Now().UTC().Format(time.RFC3339))},
+	)
+
+	err := w.writer.WriteMessages(ctx, kafka.Message{
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write message to dead-letter topic: %w", err)
+	}
+	return nil
+}
+
+func (w *Writer) Close() error {
+	return w.writer.Close()
+}
+
+// Failure - сообщение из DLQ
+type Failure struct {
+	// Message - исходное сообщение: поток, партиция, смещение и заголовки как до отправки в DLQ
+	Message  source.Message
+	Attempts int
+	Reason   string
+	FailedAt string
+}
+
+// Parse восстанавливает исходное сообщение из сообщения DLQ
+func Parse(msg kafka.Message) (*Failure, error) {
+	failure := &Failure{
+		Message: source.Message{
+			Key:   msg.Key,
+			Value: msg.Value,
+			Time:  msg.Time,
+		},
+	}
+
+	var hasTopic bool
+	for _, h := range msg.Headers {
+		value := string(h.Value)
+		var err error
+		switch h.Key {
+		case HeaderError:
+			failure.Reason = value
+		case HeaderFailedAt:
+			failure.FailedAt = value
+		case HeaderAttempts:
+			failure.Attempts, err = strconv.Atoi(value)
+		case HeaderTopic:
+			failure.Message.Stream, hasTopic = value, true
+		case HeaderPartition:
+			failure.Message.Partition, err = strconv.Atoi(value)
+		case HeaderOffset:
+			failure.Message.Offset, err = strconv.ParseInt(value, 10, 64)
+		default:
+			failure.Message.Headers = append(failure.Message.Headers, source.Header{Key: h.Key, Value: h.Value})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid header %s: %w", h.Key, err)
+		}
+	}
+	if !hasTopic {
+		return nil, fmt.Errorf("message has no %s header", HeaderTopic)
+	}
+
+	return failure, nil
+}
+
+// Replay возвращает сообщение для записи в исходный топик: исходные ключ, тело и заголовки,
+// а также число попыток и первоначальная позиция, чтобы при новой неудаче Send их сохранил
+func (f *Failure) Replay() kafka.Message {
+	headers := make([]kafka.Header, 0, len(f.Message.Headers)+4)
+	for _, h := range f.Message.Headers {
+		headers = append(headers, kafka.Header{Key: h.Key, Value: h.Value})
+	}
+	headers = append(headers,
+		kafka.Header{Key: HeaderAttempts, Value: []byte(strconv.Itoa(f.Attempts))},
+		kafka.Header{Key: HeaderTopic, Value: []byte(f.Message.Stream)},
+		kafka.Header{Key: HeaderPartition, Value: []byte(strconv.Itoa(f.Message.Partition))},
+		kafka.Header{Key: HeaderOffset, Value: []byte(strconv.FormatInt(f.Message.Offset, 10))},
+	)
+
+	return kafka.Message{
+		Topic:   f.Message.Stream,
+		Key:     f.Message.Key,
+		Value:   f.Message.Value,
+		Headers: headers,
+	}
+}
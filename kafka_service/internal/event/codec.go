@@ -0,0 +1,78 @@
+// Package event разбирает события задач из брокера.
+// Формат задает api_service (internal/producer): protobuf с заголовками content-type
+// и schema-version, а сообщения без content-type отправлены до перехода на protobuf
+// и содержат TaskEvent, сериализованный encoding/json.
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/source"
+	"github.com/bagdasarian/checklist-app/kafka_service/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	HeaderContentType   = "content-type"
+	HeaderSchemaVersion = "schema-version"
+
+	ContentTypeProtobuf = "application/x-protobuf"
+	// SchemaVersion - текущая версия схемы TaskEvent; события версии 1 тоже принимаются
+	SchemaVersion = "2"
+)
+
+// legacySchemaVersion - версия событий в JSON и событий с текстовым details вместо attributes
+const legacySchemaVersion = "1"
+
+// Position возвращает позицию сообщения (поток-партиция-смещение, для Kafka - топик-партиция-смещение).
+// При повторном чтении того же сообщения позиция не меняется.
+func Position(msg source.Message) string {
+	return fmt.Sprintf("%s-%d-%d", msg.Stream, msg.Partition, msg.Offset)
+}
+
+// DedupKey возвращает ключ, по которому потребители пропускают повторно полученное событие:
+// ID события, а для событий без ID (отправленных до его появления) - позицию сообщения.
+// ID не меняется, если событие отправлено повторно (повтор outbox, повторная доставка NATS,
+// отправка из спула api_service), позиция - только при повторном чтении того же сообщения.
+func DedupKey(msg source.Message, taskEvent *pb.TaskEvent) string {
+	if taskEvent.EventId != "" {
+		return taskEvent.EventId
+	}
+	return Position(msg)
+}
+
+// Decode разбирает событие в protobuf или в прежнем JSON формате.
+// У событий версии 1 текст details переносится в attributes["details"].
+func Decode(msg source.Message) (*pb.TaskEvent, error) {
+	var event pb.TaskEvent
+	switch contentType := msg.Header(HeaderContentType); contentType {
+	case "":
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal legacy JSON event: %w", err)
+		}
+		upgradeLegacy(&event)
+	case ContentTypeProtobuf:
+		version := msg.Header(HeaderSchemaVersion)
+		if version != SchemaVersion && version != legacySchemaVersion {
+			return nil, fmt.Errorf("unsupported event schema version %q", version)
+		}
+		if err := proto.Unmarshal(msg.Value, &event); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+		}
+		if version == legacySchemaVersion {
+			upgradeLegacy(&event)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported event content type %q", contentType)
+	}
+	return &event, nil
+}
+
+func upgradeLegacy(event *pb.TaskEvent) {
+	event.SchemaVersion = 1
+	if details := event.Details; details != "" {
+		event.Attributes = map[string]string{"details": details}
+		event.Details = ""
+	}
+}
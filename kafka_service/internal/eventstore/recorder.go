@@ -0,0 +1,84 @@
+package eventstore
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/kafka_service/config"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/event"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/source"
+)
+
+// Пауза перед повторным сохранением события, если PostgreSQL недоступен
+const saveRetryDelay = 5 * time.Second
+
+// Recorder читает события задач в своей группе потребителей и сохраняет их в Store.
+// Сообщение подтверждается только после сохранения, повторно прочитанное событие пропускается.
+type Recorder struct {
+	events source.EventSource
+	store  *Store
+	config *config.Config
+}
+
+// NewRecorder создает запись событий; events должен читать события в группе cfg.EventStore.GroupID
+func NewRecorder(cfg *config.Config, events source.EventSource, store *Store) *Recorder {
+	return &Recorder{
+		events: events,
+		store:  store,
+		config: cfg,
+	}
+}
+
+// Start сохраняет события до отмены контекста
+func (r *Recorder) Start(ctx context.Context) error {
+	log.Printf("Starting event recorder for topic: %s", r.config.Kafka.Topic)
+
+	for {
+		msg, err := r.events.Fetch(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			log.Printf("Event recorder: error reading message: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		for {
+			err := r.handle(ctx, msg)
+			if err == nil || ctx.Err() != nil {
+				break
+			}
+			log.Printf("Event recorder: failed to save event at %s, retrying in %v: %v",
+				event.Position(msg), saveRetryDelay, err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(saveRetryDelay):
+			}
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if err := r.events.Commit(ctx, msg); err != nil && ctx.Err() == nil {
+			log.Printf("Event recorder: failed to commit message: %v", err)
+		}
+	}
+}
+
+// handle сохраняет событие; нераспознанные сообщения пропускаются
+func (r *Recorder) handle(ctx context.Context, msg source.Message) error {
+	taskEvent, err := event.Decode(msg)
+	if err != nil {
+		log.Printf("Event recorder: error decoding message: %v", err)
+		return nil
+	}
+
+	return r.store.Save(ctx, Position{Topic: msg.Stream, Partition: msg.Partition, Offset: msg.Offset}, taskEvent)
+}
+
+// Close закрывает соединение с брокером
+func (r *Recorder) Close() error {
+	return r.events.Close()
+}
@@ -0,0 +1,61 @@
+-- События задач, прочитанные из Kafka. ID события и позиция в топике уникальны,
+-- поэтому ни повторно отправленное, ни повторно прочитанное событие не создает дубль
+CREATE SCHEMA IF NOT EXISTS events;
+
+CREATE TABLE IF NOT EXISTS events.task_events (
+    id BIGSERIAL PRIMARY KEY,
+    topic VARCHAR(255) NOT NULL,
+    kafka_partition INTEGER NOT NULL,
+    kafka_offset BIGINT NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    user_id VARCHAR(64) NOT NULL,
+    task_id VARCHAR(64),
+    event_id VARCHAR(64),
+    request_id VARCHAR(255),
+    trace_id VARCHAR(32),
+    source VARCHAR(64),
+    schema_version SMALLINT NOT NULL DEFAULT 1,
+    attributes JSONB NOT NULL DEFAULT '{}',
+    received_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (topic, kafka_partition, kafka_offset)
+);
+
+-- Поля события версии 2 для таблиц, созданных раньше; текст details переносится в attributes
+ALTER TABLE events.task_events
+    ADD COLUMN IF NOT EXISTS event_id VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS request_id VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS trace_id VARCHAR(32),
+    ADD COLUMN IF NOT EXISTS source VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS schema_version SMALLINT NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}';
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_schema = 'events' AND table_name = 'task_events' AND column_name = 'details'
+    ) THEN
+        UPDATE events.task_events SET attributes = jsonb_build_object('details', details) WHERE details <> '';
+        ALTER TABLE events.task_events DROP COLUMN details;
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_task_events_user_occurred_at ON events.task_events(user_id, occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_task_events_task_occurred_at ON events.task_events(task_id, occurred_at DESC) WHERE task_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_task_events_action_occurred_at ON events.task_events(action, occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_task_events_occurred_at ON events.task_events(occurred_at DESC);
+
+-- Повторно отправленные события (повтор outbox) раньше сохранялись с новой позицией:
+-- перед созданием индекса оставляем первую запись события
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_indexes WHERE schemaname = 'events' AND indexname = 'idx_task_events_event_id'
+    ) THEN
+        DELETE FROM events.task_events a
+        USING events.task_events b
+        WHERE a.event_id = b.event_id AND a.id > b.id;
+        CREATE UNIQUE INDEX idx_task_events_event_id ON events.task_events(event_id) WHERE event_id IS NOT NULL;
+    END IF;
+END $$;
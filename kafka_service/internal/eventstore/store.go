@@ -0,0 +1,223 @@
+// Package eventstore хранит события задач из Kafka в PostgreSQL (схема events)
+// и отвечает на запросы к ним.
+package eventstore
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/kafka_service/config"
+	"github.com/bagdasarian/checklist-app/kafka_service/pkg/pb"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//go:embed schema.sql
+var schema string
+
+// Ключ блокировки, под которой создается схема, чтобы несколько экземпляров не создавали ее одновременно
+const schemaLockKey = 7340129
+
+const eventColumns = `id, occurred_at, action, user_id, COALESCE(task_id, ''), COALESCE(event_id, ''),
+        COALESCE(request_id, ''), COALESCE(trace_id, ''), COALESCE(source, ''), schema_version, attributes, received_at`
+
+// Position - позиция события в брокере: топик Kafka или поток, партиция и смещение (см. source.Message)
+type Position struct {
+	Topic     string
+	Partition int
+	Offset    int64
+}
+
+// Filter - условия выборки событий; пустые поля не ограничивают выборку
+type Filter struct {
+	UserID string
+	Action pb.ActionType
+	TaskID string
+	From   *time.Time
+	To     *time.Time
+}
+
+type Store struct {
+	pool *pgxpool.Pool
+}
+
+// New подключается к PostgreSQL и создает схему events, если ее нет
+func New(ctx context.Context, cfg *config.Config) (*Store, error) {
+	pool, err := pgxpool.New(ctx, cfg.GetEventStoreURL())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection pool: %w", err)
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	s := &Store{pool: pool}
+	if err := s.migrate(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	log.Printf("Event store connected to PostgreSQL at %s:%s", cfg.EventStore.Host, cfg.EventStore.Port)
+	return s, nil
+}
+
+func (s *Store) migrate(ctx context.Context) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, schemaLockKey); err != nil {
+		return fmt.Errorf("failed to lock event store schema: %w", err)
+	}
+	if _, err := tx.Exec(ctx, schema); err != nil {
+		return fmt.Errorf("failed to create event store schema: %w", err)
+	}
+	return tx.Commit(ctx)
+}
+
+// Save сохраняет событие; событие с уже сохраненным ID (отправленное повторно) или позицией пропускается
+func (s *Store) Save(ctx context.Context, pos Position, event *pb.TaskEvent) error {
+	query := `
+        INSERT INTO events.task_events (topic, kafka_partition, kafka_offset, occurred_at, action, user_id, task_id,
+            event_id, request_id, trace_id, source, schema_version, attributes)
+        VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), NULLIF($8, ''), NULLIF($9, ''), NULLIF($10, ''), NULLIF($11, ''), $12, $13)
+        ON CONFLICT DO NOTHING
+    `
+
+	occurredAt := time.Now()
+	if event.Timestamp != nil {
+		occurredAt = event.Timestamp.AsTime()
+	}
+
+	attributes := event.Attributes
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+	schemaVersion := max(event.SchemaVersion, 1)
+
+	_, err := s.pool.Exec(ctx, query, pos.Topic, pos.Partition, pos.Offset,
+		occurredAt, event.Action.String(), event.UserId, event.TaskId,
+		event.EventId, event.RequestId, event.TraceId, event.Source, schemaVersion, attributes)
+	if err != nil {
+		return fmt.Errorf("failed to save event: %w", err)
+	}
+	return nil
+}
+
+// Query возвращает страницу событий, новые первыми, и общее число подходящих событий
+func (s *Store) Query(ctx context.Context, filter Filter, limit, offset int32) ([]*pb.StoredEvent, int32, error) {
+	where, args := filter.where(true)
+
+	var totalCount int32
+	countQuery := `SELECT COUNT(*) FROM events.task_events WHERE ` + where
+	if err := s.pool.QueryRow(ctx, countQuery, args...).Scan(&totalCount); err != nil {
+		return nil, 0, fmt.Errorf("failed to count events: %w", err)
+	}
+
+	query := `
+        SELECT ` + eventColumns + `
+        FROM events.task_events
+        WHERE ` + where + `
+        ORDER BY occurred_at DESC, id DESC
+        LIMIT $6 OFFSET $7
+    `
+	rows, err := s.pool.Query(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get events: %w", err)
+	}
+
+	events, err := pgx.CollectRows(rows, scanEvent)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read events: %w", err)
+	}
+
+	return events, totalCount, nil
+}
+
+// CountByAction возвращает число событий по каждому действию; фильтр по действию не применяется
+func (s *Store) CountByAction(ctx context.Context, filter Filter) ([]*pb.ActionCount, error) {
+	where, args := filter.where(false)
+	query := `
+        SELECT action, COUNT(*)
+        FROM events.task_events
+        WHERE ` + where + `
+        GROUP BY action
+        ORDER BY action
+    `
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count events: %w", err)
+	}
+
+	counts, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.ActionCount, error) {
+		var action string
+		var count pb.ActionCount
+		if err := row.Scan(&action, &count.Count); err != nil {
+			return nil, err
+		}
+		count.Action = pb.ActionType(pb.ActionType_value[action])
+		return &count, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// where возвращает условие выборки с параметрами $1-$5
+func (f Filter) where(withAction bool) (string, []any) {
+	var action *string
+	if withAction && f.Action != pb.ActionType_ACTION_UNKNOWN {
+		name := f.Action.String()
+		action = &name
+	}
+
+	where := `($1::text IS NULL OR user_id = $1)
+          AND ($2::text IS NULL OR action = $2)
+          AND ($3::text IS NULL OR task_id = $3)
+          AND ($4::timestamptz IS NULL OR occurred_at >= $4)
+          AND ($5::timestamptz IS NULL OR occurred_at < $5)`
+	return where, []any{nullableString(f.UserID), action, nullableString(f.TaskID), f.From, f.To}
+}
+
+func scanEvent(row pgx.CollectableRow) (*pb.StoredEvent, error) {
+	var id int64
+	var occurredAt, receivedAt time.Time
+	var action string
+	var schemaVersion int16
+	event := &pb.TaskEvent{}
+	err := row.Scan(&id, &occurredAt, &action, &event.UserId, &event.TaskId, &event.EventId,
+		&event.RequestId, &event.TraceId, &event.Source, &schemaVersion, &event.Attributes, &receivedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	event.Timestamp = timestamppb.New(occurredAt)
+	event.Action = pb.ActionType(pb.ActionType_value[action])
+	event.SchemaVersion = int32(schemaVersion)
+	return &pb.StoredEvent{
+		Id:         id,
+		Event:      event,
+		ReceivedAt: timestamppb.New(receivedAt),
+	}, nil
+}
+
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func (s *Store) Close() {
+	s.pool.Close()
+}
@@ -1,10 +1,16 @@
 package logger
 
 import (
+	"bufio"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
+	"io"
+	"log"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -12,40 +18,103 @@ import (
 	"github.com/bagdasarian/checklist-app/kafka_service/pkg/pb"
 )
 
+// Ротация лога по времени (LOG_ROTATION); пустое значение - только по размеру
+const (
+	RotationHourly = "hourly"
+	RotationDaily  = "daily"
+)
+
+// Формат времени ротации в имени архива: events.log.20060102-150405.000[.gz]
+const archiveTimeFormat = "20060102-150405.000"
+
+// Прежний формат имени архива, без миллисекунд; такие архивы тоже сжимаются и удаляются
+const legacyArchiveTimeFormat = "20060102-150405"
+
+const gzipExt = ".gz"
+
+// Logger пишет события в файл и ротирует его при записи: по размеру и по границе часа или дня.
+// Архивы сжимаются gzip в фоне и удаляются по числу и возрасту.
 type Logger struct {
 	file   *os.File
+	writer *bufio.Writer
+	size   int64
+	// period - начало периода ротации, в котором начат текущий файл
+	period time.Time
 	mu     sync.Mutex
 	config *config.Config
+
+	// archiveMu упорядочивает сжатие и удаление архивов, archives - фоновые задачи
+	archiveMu sync.Mutex
+	archives  sync.WaitGroup
 }
 
 type LogEntry struct {
-	Timestamp time.Time `json:"timestamp"`
-	Action    string    `json:"action"`
-	UserID    string    `json:"user_id"`
-	TaskID    string    `json:"task_id,omitempty"`
-	Details   string    `json:"details,omitempty"`
+	Position   string            `json:"position,omitempty"` // позиция сообщения в топике
+	EventID    string            `json:"event_id,omitempty"`
+	RequestID  string            `json:"request_id,omitempty"`
+	TraceID    string            `json:"trace_id,omitempty"`
+	Source     string            `json:"source,omitempty"`
+	Timestamp  time.Time         `json:"timestamp"`
+	Action     string            `json:"action"`
+	UserID     string            `json:"user_id"`
+	TaskID     string            `json:"task_id,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
 }
 
 func NewLogger(cfg *config.Config) (*Logger, error) {
+	switch cfg.Logging.Rotation {
+	case "", RotationHourly, RotationDaily:
+	default:
+		return nil, fmt.Errorf("unknown log rotation: %s", cfg.Logging.Rotation)
+	}
+
 	// Создаем директорию для логов, если её нет
 	logDir := filepath.Dir(cfg.Logging.FilePath)
 	if err := os.MkdirAll(logDir, 0755); err != nil {
 		return nil, fmt.Errorf("failed to create log directory: %w", err)
 	}
 
-	// Открываем файл для записи (append mode)
-	file, err := os.OpenFile(cfg.Logging.FilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	l := &Logger{config: cfg}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+
+	// Архивы, которые не успели сжать или удалить до остановки
+	l.archives.Add(1)
+	go func() {
+		defer l.archives.Done()
+		l.processArchives()
+	}()
+
+	return l, nil
+}
+
+// open открывает файл лога для дозаписи. Период существующего файла определяется
+// по времени его изменения, поэтому файл, начатый вчера, ротируется при первой записи.
+func (l *Logger) open() error {
+	file, err := os.OpenFile(l.config.Logging.FilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	info, err := file.Stat()
 	if err != nil {
-		return nil, fmt.Errorf("failed to open log file: %w", err)
+		file.Close()
+		return fmt.Errorf("failed to stat log file: %w", err)
 	}
 
-	return &Logger{
-		file:   file,
-		config: cfg,
-	}, nil
+	l.file = file
+	l.writer = bufio.NewWriterSize(file, 64*1024)
+	l.size = info.Size()
+	l.period = l.periodStart(info.ModTime())
+	if l.size == 0 {
+		l.period = l.periodStart(time.Now())
+	}
+	return nil
 }
 
-func (l *Logger) LogEvent(event *pb.TaskEvent) error {
+// LogEvent записывает событие. Если включен SyncEachEvent, файл синхронизируется и после возврата
+// без ошибки запись на диске; иначе запись попадает на диск после Sync.
+func (l *Logger) LogEvent(position string, event *pb.TaskEvent) error {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
@@ -62,11 +131,16 @@ func (l *Logger) LogEvent(event *pb.TaskEvent) error {
 
 	// Создаем запись лога
 	logEntry := LogEntry{
-		Timestamp: timestamp,
-		Action:    actionStr,
-		UserID:    event.UserId,
-		TaskID:    event.TaskId,
-		Details:   event.Details,
+		Position:   position,
+		EventID:    event.EventId,
+		RequestID:  event.RequestId,
+		TraceID:    event.TraceId,
+		Source:     event.Source,
+		Timestamp:  timestamp,
+		Action:     actionStr,
+		UserID:     event.UserId,
+		TaskID:     event.TaskId,
+		Attributes: event.Attributes,
 	}
 
 	// Сериализуем в JSON
@@ -74,85 +148,347 @@ func (l *Logger) LogEvent(event *pb.TaskEvent) error {
 	if err != nil {
 		return fmt.Errorf("failed to marshal log entry: %w", err)
 	}
+	jsonData = append(jsonData, '\n')
+
+	if l.shouldRotate(time.Now(), int64(len(jsonData))) {
+		if err := l.rotate(); err != nil {
+			return fmt.Errorf("failed to rotate log file: %w", err)
+		}
+	}
 
 	// Записываем в файл с новой строкой
-	if _, err := l.file.WriteString(string(jsonData) + "\n"); err != nil {
+	if _, err := l.writer.Write(jsonData); err != nil {
 		return fmt.Errorf("failed to write to log file: %w", err)
 	}
+	l.size += int64(len(jsonData))
 
-	// Синхронизируем файл
-	if err := l.file.Sync(); err != nil {
-		return fmt.Errorf("failed to sync log file: %w", err)
+	if l.config.Logging.SyncEachEvent {
+		return l.sync()
 	}
-
 	return nil
 }
 
-func (l *Logger) Close() error {
+// Sync сбрасывает записанные события на диск. Нужен перед фиксацией смещений, если SyncEachEvent выключен.
+func (l *Logger) Sync() error {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	return l.file.Close()
+	return l.sync()
+}
+
+func (l *Logger) sync() error {
+	if err := l.writer.Flush(); err != nil {
+		return fmt.Errorf("failed to write to log file: %w", err)
+	}
+	if err := l.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync log file: %w", err)
+	}
+	return nil
 }
 
-// RotateLog проверяет размер файла и выполняет ротацию при необходимости
-func (l *Logger) RotateLog() error {
+// RecentEventIDs возвращает ID событий из последних tailBytes байт лога; для записей без ID
+// возвращается позиция сообщения (как в event.DedupKey). Если текущий файл меньше tailBytes
+// (например, сразу после ротации), остаток читается из конца последнего архива.
+func (l *Logger) RecentEventIDs(tailBytes int64) ([]string, error) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	info, err := l.file.Stat()
+	if err := l.writer.Flush(); err != nil {
+		return nil, fmt.Errorf("failed to write to log file: %w", err)
+	}
+
+	ids, size, err := tailIDs(l.config.Logging.FilePath, tailBytes)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if size >= tailBytes {
+		return ids, nil
+	}
+
+	// Архив не сжимается и не удаляется, пока он читается
+	l.archiveMu.Lock()
+	defer l.archiveMu.Unlock()
+	archives := l.listArchives()
+	if len(archives) == 0 {
+		return ids, nil
+	}
+	older, _, err := tailIDs(archives[0].path, tailBytes-size)
+	if err != nil {
+		return nil, err
+	}
+	return append(older, ids...), nil
+}
+
+// tailIDs читает ID событий из последних tailBytes байт файла лога или архива (в том числе
+// сжатого) и возвращает их вместе с размером данных файла
+func tailIDs(path string, tailBytes int64) ([]string, int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer file.Close()
+
+	if strings.HasSuffix(path, gzipExt) {
+		return gzipTailIDs(file, tailBytes)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to stat log file: %w", err)
+	}
+	offset := max(info.Size()-tailBytes, 0)
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return nil, 0, fmt.Errorf("failed to seek log file: %w", err)
+	}
+
+	scanner := newLineScanner(file)
+	// Первая строка после смещения может быть неполной
+	if offset > 0 {
+		scanner.Scan()
+	}
+
+	var ids []string
+	for scanner.Scan() {
+		if id := entryID(scanner.Bytes()); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read log file: %w", err)
+	}
+	return ids, info.Size(), nil
+}
+
+// gzipTailIDs читает сжатый архив целиком и оставляет ID из последних tailBytes байт
+// несжатых данных
+func gzipTailIDs(file *os.File, tailBytes int64) ([]string, int64, error) {
+	zr, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read log archive: %w", err)
+	}
+	defer zr.Close()
+
+	type line struct {
+		id   string
+		size int64
+	}
+	var (
+		lines        []line
+		size, inTail int64
+	)
+	scanner := newLineScanner(zr)
+	for scanner.Scan() {
+		n := int64(len(scanner.Bytes())) + 1
+		size += n
+		inTail += n
+		lines = append(lines, line{id: entryID(scanner.Bytes()), size: n})
+		for inTail > tailBytes && len(lines) > 0 {
+			inTail -= lines[0].size
+			lines = lines[1:]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read log archive: %w", err)
+	}
+
+	var ids []string
+	for _, ln := range lines {
+		if ln.id != "" {
+			ids = append(ids, ln.id)
+		}
+	}
+	return ids, size, nil
+}
+
+func newLineScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	return scanner
+}
+
+// entryID возвращает ID события записи лога или позицию сообщения, если ID нет
+func entryID(data []byte) string {
+	var entry LogEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return ""
+	}
+	if entry.EventID != "" {
+		return entry.EventID
+	}
+	return entry.Position
+}
+
+// Close сбрасывает события на диск, закрывает файл и ждет фоновое сжатие архивов
+func (l *Logger) Close() error {
+	l.mu.Lock()
+	err := l.sync()
+	if closeErr := l.file.Close(); err == nil {
+		err = closeErr
 	}
+	l.mu.Unlock()
 
-	maxSize := int64(l.config.Logging.MaxSize * 1024 * 1024) // Конвертируем MB в байты
-	if info.Size() < maxSize {
-		return nil // Файл еще не достиг максимального размера
+	l.archives.Wait()
+	return err
+}
+
+// periodStart возвращает начало часа или дня, в который попадает t; без ротации по времени - нулевое время
+func (l *Logger) periodStart(t time.Time) time.Time {
+	switch l.config.Logging.Rotation {
+	case RotationHourly:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	case RotationDaily:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	default:
+		return time.Time{}
+	}
+}
+
+// shouldRotate проверяет, нужно ли начать новый файл перед записью n байт.
+// Пустой файл не ротируется, чтобы не создавать пустые архивы.
+func (l *Logger) shouldRotate(now time.Time, n int64) bool {
+	if l.size == 0 {
+		return false
+	}
+	if maxSize := int64(l.config.Logging.MaxSize) << 20; maxSize > 0 && l.size+n > maxSize {
+		return true
 	}
+	return l.period.Before(l.periodStart(now))
+}
 
-	// Закрываем текущий файл
+// rotate переименовывает текущий файл в архив с временем ротации и открывает новый.
+// Сжатие и удаление старых архивов выполняются в фоне.
+func (l *Logger) rotate() error {
+	if err := l.sync(); err != nil {
+		return err
+	}
 	if err := l.file.Close(); err != nil {
 		return err
 	}
 
-	// Переименовываем текущий файл с timestamp
-	timestamp := time.Now().Format("20060102-150405")
-	oldPath := l.config.Logging.FilePath
-	newPath := fmt.Sprintf("%s.%s", oldPath, timestamp)
-	if err := os.Rename(oldPath, newPath); err != nil {
+	path := l.config.Logging.FilePath
+	archive := path + "." + time.Now().Format(archiveTimeFormat)
+	if err := os.Rename(path, archive); err != nil {
+		// Продолжаем писать в прежний файл
+		if openErr := l.open(); openErr != nil {
+			return openErr
+		}
 		return err
 	}
 
-	// Открываем новый файл
-	file, err := os.OpenFile(oldPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
+	if err := l.open(); err != nil {
 		return err
 	}
 
-	l.file = file
+	l.archives.Add(1)
+	go func() {
+		defer l.archives.Done()
+		l.processArchives()
+	}()
+	return nil
+}
+
+// archiveFile - архив лога и время его ротации из имени
+type archiveFile struct {
+	path      string
+	rotatedAt time.Time
+}
+
+// processArchives сжимает несжатые архивы и удаляет архивы сверх MaxFiles и старше MaxAge дней.
+// Ошибки пишутся в лог сервиса.
+func (l *Logger) processArchives() {
+	l.archiveMu.Lock()
+	defer l.archiveMu.Unlock()
+
+	if l.config.Logging.Compress {
+		for _, a := range l.listArchives() {
+			if !strings.HasSuffix(a.path, gzipExt) {
+				if err := compressFile(a.path); err != nil {
+					log.Printf("Failed to compress log archive %s: %v", a.path, err)
+				}
+			}
+		}
+	}
 
-	// Удаляем старые файлы, если их больше чем max_files
 	l.cleanupOldLogs()
+}
 
-	return nil
+// listArchives возвращает архивы лога от новых к старым по времени ротации в имени файла.
+// Файлы с именем в другом формате не учитываются и не удаляются.
+func (l *Logger) listArchives() []archiveFile {
+	prefix := l.config.Logging.FilePath + "."
+	files, err := filepath.Glob(prefix + "*")
+	if err != nil {
+		return nil
+	}
+
+	var archives []archiveFile
+	for _, path := range files {
+		name := strings.TrimSuffix(strings.TrimPrefix(path, prefix), gzipExt)
+		rotatedAt, err := time.ParseInLocation(archiveTimeFormat, name, time.Local)
+		if err != nil {
+			rotatedAt, err = time.ParseInLocation(legacyArchiveTimeFormat, name, time.Local)
+		}
+		if err != nil {
+			continue
+		}
+		archives = append(archives, archiveFile{path: path, rotatedAt: rotatedAt})
+	}
+
+	sort.Slice(archives, func(i, j int) bool {
+		return archives[i].rotatedAt.After(archives[j].rotatedAt)
+	})
+	return archives
 }
 
+// cleanupOldLogs удаляет архивы сверх MaxFiles самых новых и архивы старше MaxAge дней
 func (l *Logger) cleanupOldLogs() {
-	logDir := filepath.Dir(l.config.Logging.FilePath)
-	logBase := filepath.Base(l.config.Logging.FilePath)
+	maxFiles := l.config.Logging.MaxFiles
+	maxAge := time.Duration(l.config.Logging.MaxAge) * 24 * time.Hour
+
+	for i, a := range l.listArchives() {
+		tooMany := maxFiles > 0 && i >= maxFiles
+		tooOld := maxAge > 0 && time.Since(a.rotatedAt) > maxAge
+		if !tooMany && !tooOld {
+			continue
+		}
+		if err := os.Remove(a.path); err != nil {
+			log.Printf("Failed to remove log archive %s: %v", a.path, err)
+		}
+	}
+}
 
-	files, err := filepath.Glob(filepath.Join(logDir, logBase+".*"))
+// compressFile сжимает файл в path.gz и удаляет исходный. Сжатый файл сначала пишется
+// во временный, поэтому после сбоя не остается неполного архива с именем .gz.
+func compressFile(path string) error {
+	src, err := os.Open(path)
 	if err != nil {
-		return
+		return err
 	}
+	defer src.Close()
 
-	// Сортируем файлы по времени модификации (новые первыми)
-	// И удаляем лишние
-	if len(files) > l.config.Logging.MaxFiles {
-		// Простая реализация: удаляем самые старые файлы
-		// В production лучше использовать более сложную логику
-		for i := l.config.Logging.MaxFiles; i < len(files); i++ {
-			os.Remove(files[i])
-		}
+	tmp := path + gzipExt + ".tmp"
+	dst, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	zw := gzip.NewWriter(dst)
+	_, err = io.Copy(zw, src)
+	if err == nil {
+		err = zw.Close()
+	}
+	if err == nil {
+		err = dst.Sync()
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, path+gzipExt)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
 	}
-}
 
+	return os.Remove(path)
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/eventstore"
+	"github.com/bagdasarian/checklist-app/kafka_service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultEventsLimit = 50
+	maxEventsLimit     = 1000
+)
+
+// EventQueryService отвечает на запросы к событиям задач, сохраненным в eventstore
+type EventQueryService struct {
+	pb.UnimplementedEventQueryServiceServer
+	store *eventstore.Store
+}
+
+func NewEventQueryService(store *eventstore.Store) *EventQueryService {
+	return &EventQueryService{store: store}
+}
+
+// QueryEvents возвращает страницу событий, новые первыми
+func (s *EventQueryService) QueryEvents(ctx context.Context, req *pb.QueryEventsRequest) (*pb.QueryEventsResponse, error) {
+	filter, err := toFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultEventsLimit
+	}
+	if limit > maxEventsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit cannot exceed %d", maxEventsLimit)
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	events, totalCount, err := s.store.Query(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.QueryEventsResponse{
+		Events:     events,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// CountEvents возвращает число событий по каждому действию
+func (s *EventQueryService) CountEvents(ctx context.Context, req *pb.CountEventsRequest) (*pb.CountEventsResponse, error) {
+	filter, err := toFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.store.CountByAction(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CountEventsResponse{Counts: counts}, nil
+}
+
+// toFilter проверяет фильтр запроса и преобразует его в фильтр хранилища
+func toFilter(f *pb.EventFilter) (eventstore.Filter, error) {
+	var filter eventstore.Filter
+	if f == nil {
+		return filter, nil
+	}
+
+	if _, ok := pb.ActionType_name[int32(f.Action)]; !ok {
+		return filter, status.Errorf(codes.InvalidArgument, "unknown action: %d", f.Action)
+	}
+	filter.UserID = f.UserId
+	filter.Action = f.Action
+	filter.TaskID = f.TaskId
+
+	if f.From != nil {
+		if err := f.From.CheckValid(); err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid from: %v", err)
+		}
+		from := f.From.AsTime()
+		filter.From = &from
+	}
+	if f.To != nil {
+		if err := f.To.CheckValid(); err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid to: %v", err)
+		}
+		to := f.To.AsTime()
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, status.Errorf(codes.InvalidArgument, "from must be before to")
+	}
+
+	return filter, nil
+}
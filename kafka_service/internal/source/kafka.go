@@ -0,0 +1,65 @@
+package source
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaSource читает топик в группе потребителей Kafka; Commit фиксирует смещения
+type KafkaSource struct {
+	reader *kafka.Reader
+}
+
+func NewKafkaSource(brokers []string, topic, group string) *KafkaSource {
+	return &KafkaSource{
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:  brokers,
+			Topic:    topic,
+			GroupID:  group,
+			MinBytes: 1,
+			MaxBytes: 10e6,
+		}),
+	}
+}
+
+func (s *KafkaSource) Fetch(ctx context.Context) (Message, error) {
+	msg, err := s.reader.FetchMessage(ctx)
+	if err != nil {
+		return Message{}, err
+	}
+	return FromKafka(msg), nil
+}
+
+func (s *KafkaSource) Commit(ctx context.Context, msgs ...Message) error {
+	kafkaMsgs := make([]kafka.Message, len(msgs))
+	for i, msg := range msgs {
+		kafkaMsgs[i] = kafka.Message{Topic: msg.Stream, Partition: msg.Partition, Offset: msg.Offset}
+	}
+	if err := s.reader.CommitMessages(ctx, kafkaMsgs...); err != nil {
+		return fmt.Errorf("failed to commit offsets: %w", err)
+	}
+	return nil
+}
+
+func (s *KafkaSource) Close() error {
+	return s.reader.Close()
+}
+
+// FromKafka преобразует сообщение Kafka
+func FromKafka(msg kafka.Message) Message {
+	headers := make([]Header, len(msg.Headers))
+	for i, h := range msg.Headers {
+		headers[i] = Header{Key: h.Key, Value: h.Value}
+	}
+	return Message{
+		Stream:    msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   headers,
+		Time:      msg.Time,
+	}
+}
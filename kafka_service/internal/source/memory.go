@@ -0,0 +1,50 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// MemorySource читает сообщения из канала в памяти; нужен для тестов.
+// Подтвержденные сообщения доступны через Committed.
+type MemorySource struct {
+	messages <-chan Message
+
+	mu        sync.Mutex
+	committed []Message
+}
+
+func NewMemorySource(messages <-chan Message) *MemorySource {
+	return &MemorySource{messages: messages}
+}
+
+func (s *MemorySource) Fetch(ctx context.Context) (Message, error) {
+	select {
+	case msg, ok := <-s.messages:
+		if !ok {
+			return Message{}, errors.New("source is closed")
+		}
+		return msg, nil
+	case <-ctx.Done():
+		return Message{}, ctx.Err()
+	}
+}
+
+func (s *MemorySource) Commit(ctx context.Context, msgs ...Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.committed = append(s.committed, msgs...)
+	return nil
+}
+
+// Committed возвращает подтвержденные сообщения в порядке подтверждения
+func (s *MemorySource) Committed() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.committed...)
+}
+
+func (s *MemorySource) Close() error {
+	return nil
+}
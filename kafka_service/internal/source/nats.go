@@ -0,0 +1,118 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Сколько NATS ждет подтверждения сообщения, прежде чем доставить его снова.
+// Больше, чем потребитель держит обработанные, но не подтвержденные сообщения.
+const natsAckWait = 5 * time.Minute
+
+// NATSSource читает поток NATS JetStream постоянным потребителем с именем группы; Commit подтверждает сообщения
+type NATSSource struct {
+	conn     *nats.Conn
+	consumer jetstream.Consumer
+}
+
+// NewNATSSource подключается к NATS, создает поток stream для subject и потребителя group, если их еще нет
+func NewNATSSource(ctx context.Context, url, stream, subject, group string) (*NATSSource, error) {
+	conn, err := nats.Connect(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     stream,
+		Subjects: []string{subject},
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create NATS stream %s: %w", stream, err)
+	}
+
+	consumer, err := js.CreateOrUpdateConsumer(ctx, stream, jetstream.ConsumerConfig{
+		Durable:       group,
+		FilterSubject: subject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       natsAckWait,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create NATS consumer %s: %w", group, err)
+	}
+
+	return &NATSSource{
+		conn:     conn,
+		consumer: consumer,
+	}, nil
+}
+
+func (s *NATSSource) Fetch(ctx context.Context) (Message, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return Message{}, err
+		}
+
+		msg, err := s.consumer.Next(jetstream.FetchMaxWait(waitTime(ctx)))
+		if errors.Is(err, nats.ErrTimeout) {
+			continue
+		}
+		if err != nil {
+			return Message{}, fmt.Errorf("failed to fetch message: %w", err)
+		}
+		return fromNATS(msg)
+	}
+}
+
+func (s *NATSSource) Commit(ctx context.Context, msgs ...Message) error {
+	for _, msg := range msgs {
+		if err := msg.raw.(jetstream.Msg).DoubleAck(ctx); err != nil {
+			return fmt.Errorf("failed to acknowledge message %d: %w", msg.Offset, err)
+		}
+	}
+	return nil
+}
+
+func (s *NATSSource) Close() error {
+	return s.conn.Drain()
+}
+
+// fromNATS преобразует сообщение JetStream; номер сообщения в потоке становится смещением
+func fromNATS(natsMsg jetstream.Msg) (Message, error) {
+	meta, err := natsMsg.Metadata()
+	if err != nil {
+		return Message{}, fmt.Errorf("failed to read message metadata: %w", err)
+	}
+
+	msg := Message{
+		Stream: meta.Stream,
+		Offset: int64(meta.Sequence.Stream),
+		Value:  natsMsg.Data(),
+		Time:   meta.Timestamp,
+		raw:    natsMsg,
+	}
+	for key, values := range natsMsg.Headers() {
+		if len(values) == 0 || strings.HasPrefix(key, "Nats-") {
+			continue
+		}
+		if key == NATSHeaderKey {
+			msg.Key = []byte(values[0])
+			continue
+		}
+		msg.Headers = append(msg.Headers, Header{Key: key, Value: []byte(values[0])})
+	}
+	return msg, nil
+}
@@ -0,0 +1,165 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Сколько записей читается из потока за один запрос
+const redisReadCount = 100
+
+// RedisSource читает поток Redis Streams в группе потребителей; Commit выполняет XACK.
+// При запуске сначала перечитываются записи, которые этот потребитель получил, но не подтвердил.
+type RedisSource struct {
+	client   *redis.Client
+	stream   string
+	group    string
+	consumer string
+	// pendingFrom - ID, после которого читаются неподтвержденные записи; пустой - они прочитаны
+	pendingFrom string
+	buffer      []Message
+}
+
+// NewRedisSource подключается к Redis и создает группу group, читающую поток с начала, если ее еще нет.
+// Имя потребителя в группе - имя хоста, поэтому после перезапуска контейнера ему достаются его записи.
+func NewRedisSource(ctx context.Context, addr, password string, db int, stream, group string) (*RedisSource, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: password,
+		DB:       db,
+	})
+
+	err := client.XGroupCreateMkStream(ctx, stream, group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		client.Close()
+		return nil, fmt.Errorf("failed to create consumer group %s: %w", group, err)
+	}
+
+	consumer, err := os.Hostname()
+	if err != nil {
+		consumer = group
+	}
+
+	return &RedisSource{
+		client:      client,
+		stream:      stream,
+		group:       group,
+		consumer:    consumer,
+		pendingFrom: "0",
+	}, nil
+}
+
+func (s *RedisSource) Fetch(ctx context.Context) (Message, error) {
+	for len(s.buffer) == 0 {
+		if err := ctx.Err(); err != nil {
+			return Message{}, err
+		}
+
+		id := ">"
+		if s.pendingFrom != "" {
+			id = s.pendingFrom
+		}
+		streams, err := s.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    s.group,
+			Consumer: s.consumer,
+			Streams:  []string{s.stream, id},
+			Count:    redisReadCount,
+			Block:    waitTime(ctx),
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return Message{}, ctx.Err()
+			}
+			return Message{}, fmt.Errorf("failed to read stream %s: %w", s.stream, err)
+		}
+
+		var entries []redis.XMessage
+		for _, stream := range streams {
+			entries = append(entries, stream.Messages...)
+		}
+		if s.pendingFrom != "" {
+			if len(entries) == 0 {
+				s.pendingFrom = ""
+				continue
+			}
+			s.pendingFrom = entries[len(entries)-1].ID
+		}
+
+		for _, entry := range entries {
+			// Неподтвержденная запись, удаленная из потока при обрезке, приходит без полей
+			if len(entry.Values) == 0 {
+				if err := s.client.XAck(ctx, s.stream, s.group, entry.ID).Err(); err != nil {
+					return Message{}, fmt.Errorf("failed to acknowledge deleted entry %s: %w", entry.ID, err)
+				}
+				continue
+			}
+			msg, err := fromRedis(s.stream, entry)
+			if err != nil {
+				return Message{}, err
+			}
+			s.buffer = append(s.buffer, msg)
+		}
+	}
+
+	msg := s.buffer[0]
+	s.buffer = s.buffer[1:]
+	return msg, nil
+}
+
+func (s *RedisSource) Commit(ctx context.Context, msgs ...Message) error {
+	ids := make([]string, len(msgs))
+	for i, msg := range msgs {
+		ids[i] = msg.raw.(string)
+	}
+	if err := s.client.XAck(ctx, s.stream, s.group, ids...).Err(); err != nil {
+		return fmt.Errorf("failed to acknowledge entries: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisSource) Close() error {
+	return s.client.Close()
+}
+
+// fromRedis преобразует запись потока; ID записи (миллисекунды-номер) становится позицией сообщения
+func fromRedis(stream string, entry redis.XMessage) (Message, error) {
+	ms, seq, ok := strings.Cut(entry.ID, "-")
+	offset, err := strconv.ParseInt(ms, 10, 64)
+	if !ok || err != nil {
+		return Message{}, fmt.Errorf("invalid stream entry ID %q", entry.ID)
+	}
+	partition, err := strconv.Atoi(seq)
+	if err != nil {
+		return Message{}, fmt.Errorf("invalid stream entry ID %q", entry.ID)
+	}
+
+	msg := Message{
+		Stream:    stream,
+		Partition: partition,
+		Offset:    offset,
+		Time:      time.UnixMilli(offset),
+		raw:       entry.ID,
+	}
+	for field, value := range entry.Values {
+		data, _ := value.(string)
+		switch {
+		case field == RedisFieldKey:
+			msg.Key = []byte(data)
+		case field == RedisFieldValue:
+			msg.Value = []byte(data)
+		case strings.HasPrefix(field, RedisHeaderPrefix):
+			msg.Headers = append(msg.Headers, Header{Key: strings.TrimPrefix(field, RedisHeaderPrefix), Value: []byte(data)})
+		}
+	}
+	return msg, nil
+}
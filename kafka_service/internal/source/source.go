@@ -0,0 +1,100 @@
+// Package source читает события задач из брокера, выбранного в конфигурации: Kafka,
+// Redis Streams или NATS JetStream. Формат записей Redis и сообщений NATS задает
+// api_service (internal/producer): ключ, тело и заголовки те же, что у сообщения Kafka.
+package source
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bagdasarian/checklist-app/kafka_service/config"
+)
+
+// Брокеры событий (EVENT_BACKEND)
+const (
+	BackendKafka = "kafka"
+	BackendRedis = "redis"
+	BackendNATS  = "nats"
+)
+
+// Поля записи в потоке Redis и заголовок NATS с ключом сообщения
+const (
+	RedisFieldKey     = "key"
+	RedisFieldValue   = "value"
+	RedisHeaderPrefix = "header:"
+	NATSHeaderKey     = "message-key"
+)
+
+// Сколько ждать сообщения за один запрос к Redis или NATS; между запросами проверяется контекст
+const pollWait = time.Second
+
+type Header struct {
+	Key   string
+	Value []byte
+}
+
+// Message - сообщение с событием. Stream, Partition и Offset - позиция сообщения, которая не меняется
+// при повторном чтении: в Kafka это топик, партиция и смещение; в Redis Streams - поток и ID записи
+// (Offset - миллисекунды, Partition - номер записи в пределах миллисекунды); в NATS JetStream - поток
+// и номер сообщения в нем, Partition всегда 0.
+type Message struct {
+	Stream    string
+	Partition int
+	Offset    int64
+	Key       []byte
+	Value     []byte
+	Headers   []Header
+	Time      time.Time
+
+	// raw - данные брокера для подтверждения сообщения
+	raw any
+}
+
+// Header возвращает значение заголовка key или пустую строку
+func (m Message) Header(key string) string {
+	for _, h := range m.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+// EventSource - чтение событий в группе потребителей. Сообщения, обработка которых
+// не подтверждена Commit, после перезапуска читаются повторно.
+type EventSource interface {
+	// Fetch возвращает следующее сообщение, ожидая его до отмены ctx
+	Fetch(ctx context.Context) (Message, error)
+	// Commit подтверждает обработку сообщений
+	Commit(ctx context.Context, msgs ...Message) error
+	Close() error
+}
+
+// New создает чтение событий группой group из брокера cfg.Events.Backend
+func New(ctx context.Context, cfg *config.Config, group string) (EventSource, error) {
+	switch cfg.Events.Backend {
+	case "", BackendKafka:
+		return NewKafkaSource(cfg.GetKafkaBrokers(), cfg.Kafka.Topic, group), nil
+	case BackendRedis:
+		return NewRedisSource(ctx, cfg.GetRedisAddr(), cfg.Redis.Password, cfg.Redis.DB, cfg.Kafka.Topic, group)
+	case BackendNATS:
+		return NewNATSSource(ctx, cfg.NATS.URL, cfg.NATS.Stream, cfg.Kafka.Topic, group)
+	default:
+		return nil, fmt.Errorf("unknown event backend: %s", cfg.Events.Backend)
+	}
+}
+
+// waitTime возвращает время ожидания одного запроса: не больше pollWait и не дольше дедлайна ctx
+func waitTime(ctx context.Context) time.Duration {
+	wait := pollWait
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining < wait {
+			wait = remaining
+		}
+	}
+	if wait < 10*time.Millisecond {
+		wait = 10 * time.Millisecond
+	}
+	return wait
+}
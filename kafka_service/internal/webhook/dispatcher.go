@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"context"
+	"log"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/bagdasarian/checklist-app/kafka_service/config"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DeliveryStore - очередь доставок в db_service
+type DeliveryStore interface {
+	ClaimWebhookDeliveries(ctx context.Context, req *dbpb.ClaimWebhookDeliveriesRequest) (*dbpb.ClaimWebhookDeliveriesResponse, error)
+	CompleteWebhookDelivery(ctx context.Context, req *dbpb.CompleteWebhookDeliveryRequest) (*dbpb.CompleteWebhookDeliveryResponse, error)
+}
+
+// Dispatcher периодически забирает готовые доставки и отправляет их. Неудачная попытка
+// повторяется с экспоненциальной задержкой, после MaxAttempts попыток доставка считается
+// неудачной, а после DisableAfter неудачных доставок подряд db_service отключает вебхук.
+type Dispatcher struct {
+	store  DeliveryStore
+	sender *Sender
+	config *config.Config
+}
+
+func NewDispatcher(cfg *config.Config, store DeliveryStore, sender *Sender) *Dispatcher {
+	return &Dispatcher{
+		store:  store,
+		sender: sender,
+		config: cfg,
+	}
+}
+
+// Run отправляет доставки до отмены контекста
+func (d *Dispatcher) Run(ctx context.Context) {
+	log.Printf("Starting webhook dispatcher")
+
+	ticker := time.NewTicker(d.config.GetWebhookPollInterval())
+	defer ticker.Stop()
+
+	for {
+		// Полная пачка означает, что в очереди могут быть еще доставки, поэтому пауза не нужна
+		for ctx.Err() == nil {
+			if d.dispatchBatch(ctx) < d.batchSize() {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping webhook dispatcher...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatchBatch отправляет одну пачку доставок параллельно и возвращает ее размер
+func (d *Dispatcher) dispatchBatch(ctx context.Context) int {
+	// Доставка возвращается в очередь, если результат не записан до конца аренды
+	lease := d.config.GetWebhookTimeout() + time.Minute
+	resp, err := d.store.ClaimWebhookDeliveries(ctx, &dbpb.ClaimWebhookDeliveriesRequest{
+		Limit:        int32(d.batchSize()),
+		LeaseSeconds: int32(lease / time.Second),
+	})
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Error claiming webhook deliveries: %v", err)
+		}
+		return 0
+	}
+
+	var wg sync.WaitGroup
+	for _, claimed := range resp.Deliveries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.deliver(ctx, claimed)
+		}()
+	}
+	wg.Wait()
+
+	return len(resp.Deliveries)
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, claimed *dbpb.ClaimedWebhookDelivery) {
+	delivery := claimed.Delivery
+	result := d.sender.Send(ctx, Request{
+		WebhookID:  delivery.WebhookId,
+		DeliveryID: delivery.Id,
+		EventType:  delivery.EventType,
+		URL:        claimed.Url,
+		Secret:     claimed.Secret,
+		Payload:    []byte(delivery.Payload),
+	})
+	if !result.OK() && ctx.Err() != nil {
+		// Запрос прерван остановкой сервиса: доставка вернется в очередь после окончания аренды
+		return
+	}
+
+	req := &dbpb.CompleteWebhookDeliveryRequest{
+		Id:           delivery.Id,
+		Success:      result.OK(),
+		StatusCode:   int32(result.StatusCode),
+		DisableAfter: int32(d.config.Webhook.DisableAfter),
+	}
+	if !result.OK() {
+		req.Error = result.Err.Error()
+		attempt := int(delivery.Attempts) + 1
+		if attempt < d.maxAttempts() {
+			req.RetryAt = timestamppb.New(time.Now().Add(d.retryDelay(attempt)))
+		}
+		log.Printf("Webhook %s delivery %s attempt %d failed: %v", delivery.WebhookId, delivery.Id, attempt, result.Err)
+	}
+
+	// Результат записывается и при остановке сервиса, иначе успешная доставка будет отправлена повторно
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	resp, err := d.store.CompleteWebhookDelivery(saveCtx, req)
+	if err != nil {
+		log.Printf("Error saving webhook delivery %s result: %v", delivery.Id, err)
+		return
+	}
+	if resp.WebhookDisabled {
+		log.Printf("Webhook %s disabled after %d failed deliveries in a row", delivery.WebhookId, d.config.Webhook.DisableAfter)
+	}
+}
+
+// retryDelay возвращает задержку перед попыткой attempt+1: базовая задержка удваивается
+// с каждой попыткой до максимальной, плюс до 20% случайного разброса, чтобы повторы
+// к одному получателю не приходили одновременно
+func (d *Dispatcher) retryDelay(attempt int) time.Duration {
+	maxDelay := d.config.GetWebhookRetryMaxDelay()
+	delay := d.config.GetWebhookRetryBaseDelay()
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxDelay)
+	return delay + rand.N(delay/5+1)
+}
+
+func (d *Dispatcher) batchSize() int {
+	if d.config.Webhook.BatchSize <= 0 {
+		return 20
+	}
+	return d.config.Webhook.BatchSize
+}
+
+func (d *Dispatcher) maxAttempts() int {
+	if d.config.Webhook.MaxAttempts <= 0 {
+		return 8
+	}
+	return d.config.Webhook.MaxAttempts
+}
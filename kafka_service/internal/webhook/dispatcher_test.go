@@ -0,0 +1,178 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/bagdasarian/checklist-app/kafka_service/config"
+)
+
+// fakeStore - очередь доставок одного вебхука, повторяющая правила db_service:
+// неудачная доставка без retry_at считается окончательно неудачной, и после
+// disable_after таких доставок подряд вебхук отключается и доставки больше не выдаются
+type fakeStore struct {
+	mu        sync.Mutex
+	url       string
+	pending   []*dbpb.WebhookDelivery
+	completed []*dbpb.CompleteWebhookDeliveryRequest
+	failures  int
+	disabled  bool
+}
+
+func (s *fakeStore) ClaimWebhookDeliveries(ctx context.Context, req *dbpb.ClaimWebhookDeliveriesRequest) (*dbpb.ClaimWebhookDeliveriesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := &dbpb.ClaimWebhookDeliveriesResponse{}
+	if s.disabled {
+		return resp, nil
+	}
+	for _, delivery := range s.pending[:min(len(s.pending), int(req.Limit))] {
+		resp.Deliveries = append(resp.Deliveries, &dbpb.ClaimedWebhookDelivery{
+			Delivery: delivery,
+			Url:      s.url,
+			Secret:   "secret",
+		})
+	}
+	return resp, nil
+}
+
+func (s *fakeStore) CompleteWebhookDelivery(ctx context.Context, req *dbpb.CompleteWebhookDeliveryRequest) (*dbpb.CompleteWebhookDeliveryResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.completed = append(s.completed, req)
+	i := slices.IndexFunc(s.pending, func(d *dbpb.WebhookDelivery) bool { return d.Id == req.Id })
+	delivery := s.pending[i]
+	delivery.Attempts++
+
+	resp := &dbpb.CompleteWebhookDeliveryResponse{Delivery: delivery}
+	switch {
+	case req.Success:
+		s.pending = slices.Delete(s.pending, i, i+1)
+		s.failures = 0
+	case req.RetryAt == nil:
+		s.pending = slices.Delete(s.pending, i, i+1)
+		s.failures++
+		if req.DisableAfter > 0 && s.failures >= int(req.DisableAfter) {
+			s.disabled = true
+			resp.WebhookDisabled = true
+		}
+	}
+	return resp, nil
+}
+
+func newTestDispatcher(t *testing.T, status int, deliveries ...string) (*Dispatcher, *fakeStore, *atomic.Int32) {
+	hits := &atomic.Int32{}
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(receiver.Close)
+
+	store := &fakeStore{url: receiver.URL}
+	for _, id := range deliveries {
+		store.pending = append(store.pending, &dbpb.WebhookDelivery{
+			Id:        id,
+			WebhookId: "webhook-1",
+			EventId:   "event-" + id,
+			EventType: "task.created",
+			Payload:   `{}`,
+		})
+	}
+
+	cfg := &config.Config{}
+	cfg.Webhook.Timeout = 1
+	cfg.Webhook.MaxAttempts = 3
+	cfg.Webhook.RetryBaseDelay = 30
+	cfg.Webhook.RetryMaxDelay = 3600
+	cfg.Webhook.DisableAfter = 2
+	cfg.Webhook.BatchSize = 1
+	return NewDispatcher(cfg, store, NewSender(time.Second, true)), store, hits
+}
+
+func TestDispatcherCompletesSuccessfulDelivery(t *testing.T) {
+	d, store, hits := newTestDispatcher(t, http.StatusOK, "1")
+
+	d.dispatchBatch(context.Background())
+
+	if hits.Load() != 1 || len(store.completed) != 1 {
+		t.Fatalf("got %d requests and %d results, want 1 and 1", hits.Load(), len(store.completed))
+	}
+	if req := store.completed[0]; !req.Success || req.StatusCode != http.StatusOK || req.RetryAt != nil {
+		t.Fatalf("result = %+v, want success without retry", req)
+	}
+}
+
+func TestDispatcherRetriesServerErrorsWithBackoff(t *testing.T) {
+	d, store, hits := newTestDispatcher(t, http.StatusInternalServerError, "1")
+
+	// Попытки 1 и 2 назначают повтор через 30 и 60 секунд (плюс до 20% разброса), попытка 3 - последняя
+	wantDelays := []time.Duration{30 * time.Second, 60 * time.Second}
+	for attempt := 1; attempt <= 3; attempt++ {
+		before := time.Now()
+		d.dispatchBatch(context.Background())
+
+		req := store.completed[len(store.completed)-1]
+		if req.Success || req.StatusCode != http.StatusInternalServerError || req.Error == "" {
+			t.Fatalf("attempt %d: result = %+v, want failure with status 500", attempt, req)
+		}
+		if attempt == 3 {
+			if req.RetryAt != nil {
+				t.Fatalf("attempt %d: retry scheduled after the last attempt", attempt)
+			}
+			continue
+		}
+		if req.RetryAt == nil {
+			t.Fatalf("attempt %d: retry was not scheduled", attempt)
+		}
+		delay := req.RetryAt.AsTime().Sub(before)
+		want := wantDelays[attempt-1]
+		if delay < want || delay > want+want/5+time.Second {
+			t.Fatalf("attempt %d: retry in %v, want %v..%v", attempt, delay, want, want+want/5)
+		}
+	}
+	if hits.Load() != 3 {
+		t.Fatalf("got %d requests, want 3", hits.Load())
+	}
+}
+
+func TestDispatcherRetryDelayIsCapped(t *testing.T) {
+	d, _, _ := newTestDispatcher(t, http.StatusOK)
+
+	for attempt := 1; attempt <= 12; attempt++ {
+		want := min(30*time.Second<<(attempt-1), time.Hour)
+		if delay := d.retryDelay(attempt); delay < want || delay > want+want/5 {
+			t.Fatalf("retryDelay(%d) = %v, want %v..%v", attempt, delay, want, want+want/5)
+		}
+	}
+}
+
+func TestDispatcherWebhookDisabledAfterFailedDeliveries(t *testing.T) {
+	d, store, hits := newTestDispatcher(t, http.StatusBadGateway, "1", "2", "3")
+	d.config.Webhook.MaxAttempts = 1
+
+	for range 3 {
+		d.dispatchBatch(context.Background())
+	}
+
+	// После DisableAfter неудачных доставок подряд вебхук отключен, третья доставка не отправляется
+	if hits.Load() != 2 {
+		t.Fatalf("got %d requests, want 2", hits.Load())
+	}
+	if !store.disabled {
+		t.Fatal("webhook was not disabled")
+	}
+	for _, req := range store.completed {
+		if req.DisableAfter != 2 {
+			t.Fatalf("DisableAfter = %d, want 2", req.DisableAfter)
+		}
+	}
+}
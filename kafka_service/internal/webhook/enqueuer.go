@@ -0,0 +1,138 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/bagdasarian/checklist-app/kafka_service/config"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/event"
+	"github.com/bagdasarian/checklist-app/kafka_service/internal/source"
+	"github.com/bagdasarian/checklist-app/kafka_service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Пауза перед повторной постановкой события в очередь, если db_service недоступен
+const enqueueRetryDelay = 5 * time.Second
+
+// EventStore - доступ к задачам и очереди доставок в db_service
+type EventStore interface {
+	GetTask(ctx context.Context, req *dbpb.GetTaskRequest) (*dbpb.GetTaskResponse, error)
+	EnqueueWebhookDeliveries(ctx context.Context, req *dbpb.EnqueueWebhookDeliveriesRequest) (*dbpb.EnqueueWebhookDeliveriesResponse, error)
+}
+
+// Enqueuer читает события задач в своей группе потребителей и ставит доставки в очередь.
+// Сообщение подтверждается только после постановки в очередь, а повторно прочитанное событие
+// не создает дублей: ID события - его event_id (UUIDv7), который не меняется при повторной отправке
+// (для старых событий без event_id - позиция в брокере).
+type Enqueuer struct {
+	events source.EventSource
+	store  EventStore
+	config *config.Config
+}
+
+// NewEnqueuer создает постановщик доставок; events должен читать события в группе cfg.Webhook.GroupID
+func NewEnqueuer(cfg *config.Config, events source.EventSource, store EventStore) *Enqueuer {
+	return &Enqueuer{
+		events: events,
+		store:  store,
+		config: cfg,
+	}
+}
+
+// Start обрабатывает события до отмены контекста
+func (e *Enqueuer) Start(ctx context.Context) error {
+	log.Printf("Starting webhook enqueuer for topic: %s", e.config.Kafka.Topic)
+
+	for {
+		msg, err := e.events.Fetch(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			log.Printf("Webhook enqueuer: error reading message: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		for {
+			err := e.handle(ctx, msg)
+			if err == nil || ctx.Err() != nil {
+				break
+			}
+			log.Printf("Webhook enqueuer: failed to enqueue event at %s, retrying in %v: %v",
+				event.Position(msg), enqueueRetryDelay, err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(enqueueRetryDelay):
+			}
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if err := e.events.Commit(ctx, msg); err != nil && ctx.Err() == nil {
+			log.Printf("Webhook enqueuer: failed to commit message: %v", err)
+		}
+	}
+}
+
+// handle ставит событие в очередь вебхукам пользователя. Нераспознанные события и события без
+// пользователя или задачи пропускаются с записью в лог; в DLQ их отправляет основной потребитель,
+// который читает тот же топик. События без типа вебхука (например, ACTION_GET_TASKS) пропускаются молча.
+func (e *Enqueuer) handle(ctx context.Context, msg source.Message) error {
+	taskEvent, err := event.Decode(msg)
+	if err != nil {
+		log.Printf("Webhook enqueuer: skipping message at %s that could not be decoded: %v", event.Position(msg), err)
+		return nil
+	}
+
+	eventType := EventType(taskEvent.Action)
+	if eventType == "" {
+		return nil
+	}
+	if taskEvent.UserId == "" || taskEvent.TaskId == "" {
+		log.Printf("Webhook enqueuer: skipping %s event %s at %s without user or task ID",
+			eventType, event.DedupKey(msg, taskEvent), event.Position(msg))
+		return nil
+	}
+
+	var task *dbpb.DbTask
+	if taskEvent.Action != pb.ActionType_ACTION_DELETE_TASK {
+		resp, err := e.store.GetTask(ctx, &dbpb.GetTaskRequest{Id: taskEvent.TaskId, UserId: taskEvent.UserId})
+		switch {
+		case status.Code(err) == codes.NotFound:
+			// Задача удалена позже события: вебхук получит событие без состояния задачи
+		case err != nil:
+			return fmt.Errorf("failed to get task: %w", err)
+		default:
+			task = resp.Task
+		}
+	}
+
+	// ID события не меняется при повторной отправке, поэтому повтор не создает новую доставку
+	eventID := event.DedupKey(msg, taskEvent)
+	payload, err := NewPayload(eventID, eventType, taskEvent, task)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	_, err = e.store.EnqueueWebhookDeliveries(ctx, &dbpb.EnqueueWebhookDeliveriesRequest{
+		UserId:    taskEvent.UserId,
+		EventId:   eventID,
+		EventType: eventType,
+		Payload:   string(payload),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to enqueue deliveries: %w", err)
+	}
+	return nil
+}
+
+// Close закрывает соединение с брокером
+func (e *Enqueuer) Close() error {
+	return e.events.Close()
+}
@@ -0,0 +1,102 @@
+// Package webhook отправляет события задач на вебхуки пользователей.
+// Enqueuer читает топик событий и ставит доставки в очередь db_service,
+// Dispatcher забирает готовые доставки и отправляет их через Sender с повторами.
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	dbpb "github.com/bagdasarian/checklist-app/db_service/pkg/pb"
+	"github.com/bagdasarian/checklist-app/kafka_service/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Типы событий вебхуков; события без типа (например, GetTasks) не отправляются
+var eventTypes = map[pb.ActionType]string{
+	pb.ActionType_ACTION_CREATE_TASK:    "task.created",
+	pb.ActionType_ACTION_UPDATE_TASK:    "task.updated",
+	pb.ActionType_ACTION_COMPLETE_TASK:  "task.completed",
+	pb.ActionType_ACTION_DELETE_TASK:    "task.deleted",
+	pb.ActionType_ACTION_ARCHIVE_TASK:   "task.archived",
+	pb.ActionType_ACTION_UNARCHIVE_TASK: "task.unarchived",
+	pb.ActionType_ACTION_REVERT_TASK:    "task.reverted",
+}
+
+// EventType возвращает тип события вебхука для действия или пустую строку
+func EventType(action pb.ActionType) string {
+	return eventTypes[action]
+}
+
+// Payload - тело запроса вебхука
+type Payload struct {
+	ID         string            `json:"id"` // одинаковый при повторной доставке
+	Type       string            `json:"type"`
+	OccurredAt time.Time         `json:"occurred_at"`
+	UserID     string            `json:"user_id"`
+	TaskID     string            `json:"task_id"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Task       *Task             `json:"task,omitempty"` // состояние задачи на момент постановки в очередь
+}
+
+// Task - задача в теле вебхука
+type Task struct {
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Completed   bool       `json:"completed"`
+	Version     int64      `json:"version"`
+	Tags        []string   `json:"tags"`
+	ParentID    string     `json:"parent_id,omitempty"`
+	ListID      string     `json:"list_id,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+}
+
+// NewPayload собирает тело вебхука; task может быть nil, если задача удалена
+func NewPayload(id, eventType string, event *pb.TaskEvent, task *dbpb.DbTask) ([]byte, error) {
+	occurredAt := time.Now().UTC()
+	if event.Timestamp != nil {
+		occurredAt = event.Timestamp.AsTime()
+	}
+
+	payload := Payload{
+		ID:         id,
+		Type:       eventType,
+		OccurredAt: occurredAt,
+		UserID:     event.UserId,
+		TaskID:     event.TaskId,
+		Attributes: event.Attributes,
+	}
+	if task != nil {
+		payload.Task = &Task{
+			ID:          task.Id,
+			Title:       task.Title,
+			Description: task.Description,
+			Completed:   task.Completed,
+			Version:     task.Version,
+			Tags:        task.Tags,
+			ParentID:    task.ParentId,
+			ListID:      task.ListId,
+			CreatedAt:   task.CreatedAt.AsTime(),
+			CompletedAt: optionalTime(task.CompletedAt),
+			DueAt:       optionalTime(task.DueAt),
+			ArchivedAt:  optionalTime(task.ArchivedAt),
+		}
+		if payload.Task.Tags == nil {
+			payload.Task.Tags = []string{}
+		}
+	}
+
+	return json.Marshal(payload)
+}
+
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
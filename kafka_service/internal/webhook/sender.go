@@ -0,0 +1,180 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Заголовки запроса вебхука
+const (
+	HeaderWebhookID = "X-Webhook-Id"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Сколько байт ответа сохраняется в журнал доставок при ошибке
+const maxErrorBody = 256
+
+// Request - одна попытка доставки
+type Request struct {
+	WebhookID  string
+	DeliveryID string
+	EventType  string
+	URL        string
+	Secret     string
+	Payload    []byte
+}
+
+// Result - результат попытки доставки
+type Result struct {
+	StatusCode int // 0, если ответ не получен
+	Err        error
+}
+
+// OK сообщает, что получатель принял доставку
+func (r Result) OK() bool {
+	return r.Err == nil
+}
+
+// ErrForbiddenAddress - адрес получателя во внутренней сети (loopback, частные и link-local адреса)
+var ErrForbiddenAddress = errors.New("webhook address is not publicly routable")
+
+// Диапазоны, которые не считаются публичными, кроме проверяемых методами netip.Addr
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// Sender отправляет подписанные запросы вебхуков
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender создает отправителя с таймаутом на запрос. Перенаправления не выполняются:
+// ответ 3xx считается неудачной доставкой. Адрес проверяется при подключении, после
+// разрешения имени: без allowPrivateNetworks запросы во внутреннюю сеть (loopback,
+// частные и link-local адреса, в том числе метаданные облака) отклоняются с ErrForbiddenAddress.
+// allowPrivateNetworks нужен для разработки и тестов с локальным получателем.
+func NewSender(timeout time.Duration, allowPrivateNetworks bool) *Sender {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivateNetworks {
+		dialer.Control = checkPublicAddress
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Через прокси проверялся бы адрес прокси, а не получателя
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &Sender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// checkPublicAddress запрещает подключение к непубличным адресам; вызывается для каждого
+// адреса, к которому подключается net.Dialer, поэтому имя, разрешающееся во внутренний адрес,
+// тоже отклоняется
+func checkPublicAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !isPublicAddr(ip) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, ip)
+	}
+	return nil
+}
+
+func isPublicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap().WithZone("")
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// Send выполняет POST запрос с телом Payload. Успешной считается доставка с ответом 2xx.
+func (s *Sender) Send(ctx context.Context, req Request) Result {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Payload))
+	if err != nil {
+		return Result{Err: fmt.Errorf("invalid request: %w", err)}
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "checklist-app-webhooks/1.0")
+	httpReq.Header.Set(HeaderWebhookID, req.WebhookID)
+	httpReq.Header.Set(HeaderDelivery, req.DeliveryID)
+	httpReq.Header.Set(HeaderEvent, req.EventType)
+	httpReq.Header.Set(HeaderTimestamp, timestamp)
+	httpReq.Header.Set(HeaderSignature, Sign(req.Secret, timestamp, req.Payload))
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return Result{Err: err}
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	// Остаток тела дочитывается, чтобы соединение вернулось в пул
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		err := fmt.Errorf("unexpected status %d", resp.StatusCode)
+		if text := strings.TrimSpace(string(body)); text != "" {
+			err = fmt.Errorf("unexpected status %d: %s", resp.StatusCode, text)
+		}
+		return Result{StatusCode: resp.StatusCode, Err: err}
+	}
+	return Result{StatusCode: resp.StatusCode}
+}
+
+// Sign возвращает подпись запроса: "sha256=" и hex HMAC-SHA256 от "<timestamp>.<тело>".
+// Получатель вычисляет ее по заголовку X-Webhook-Timestamp и сравнивает с X-Webhook-Signature;
+// метка времени в подписи защищает от повтора старых запросов.
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверяет подпись запроса вебхука так, как это должен делать получатель
+func Verify(secret, timestamp string, payload []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, payload)), []byte(signature))
+}
@@ -0,0 +1,157 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRequest(url string) Request {
+	return Request{
+		WebhookID:  "webhook-1",
+		DeliveryID: "delivery-1",
+		EventType:  "task.created",
+		URL:        url,
+		Secret:     "secret",
+		Payload:    []byte(`{"id":"event-1","type":"task.created"}`),
+	}
+}
+
+func TestSendSignsTimestampAndBody(t *testing.T) {
+	var header http.Header
+	var body []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	req := testRequest(receiver.URL)
+	result := NewSender(time.Second, true).Send(context.Background(), req)
+	if !result.OK() || result.StatusCode != http.StatusNoContent {
+		t.Fatalf("Send() = %+v, want success", result)
+	}
+
+	if string(body) != string(req.Payload) {
+		t.Fatalf("body = %s, want %s", body, req.Payload)
+	}
+	if header.Get(HeaderWebhookID) != req.WebhookID || header.Get(HeaderDelivery) != req.DeliveryID ||
+		header.Get(HeaderEvent) != req.EventType {
+		t.Fatalf("unexpected webhook headers: %v", header)
+	}
+
+	// Подпись - HMAC-SHA256 от "<timestamp>.<тело>"
+	timestamp := header.Get(HeaderTimestamp)
+	mac := hmac.New(sha256.New, []byte(req.Secret))
+	mac.Write([]byte(timestamp + "." + string(req.Payload)))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if signature := header.Get(HeaderSignature); signature != want {
+		t.Fatalf("signature = %s, want %s", signature, want)
+	}
+	if !Verify(req.Secret, timestamp, body, header.Get(HeaderSignature)) {
+		t.Fatal("Verify() rejected a valid signature")
+	}
+	if Verify("other", timestamp, body, header.Get(HeaderSignature)) {
+		t.Fatal("Verify() accepted a signature made with another secret")
+	}
+	if Verify(req.Secret, "0", body, header.Get(HeaderSignature)) {
+		t.Fatal("Verify() accepted a signature for another timestamp")
+	}
+}
+
+func TestSendReportsServerError(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "temporarily unavailable", http.StatusServiceUnavailable)
+	}))
+	defer receiver.Close()
+
+	result := NewSender(time.Second, true).Send(context.Background(), testRequest(receiver.URL))
+	if result.OK() {
+		t.Fatal("Send() succeeded on 503")
+	}
+	if result.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("StatusCode = %d, want 503", result.StatusCode)
+	}
+	if !strings.Contains(result.Err.Error(), "temporarily unavailable") {
+		t.Fatalf("error %q does not contain the response body", result.Err)
+	}
+}
+
+func TestSendDoesNotFollowRedirects(t *testing.T) {
+	var redirected atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hook", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/other", http.StatusTemporaryRedirect)
+	})
+	mux.HandleFunc("/other", func(w http.ResponseWriter, r *http.Request) {
+		redirected.Add(1)
+	})
+	receiver := httptest.NewServer(mux)
+	defer receiver.Close()
+
+	result := NewSender(time.Second, true).Send(context.Background(), testRequest(receiver.URL+"/hook"))
+	if result.OK() || result.StatusCode != http.StatusTemporaryRedirect {
+		t.Fatalf("Send() = %+v, want failure with status 307", result)
+	}
+	if redirected.Load() != 0 {
+		t.Fatal("redirect was followed")
+	}
+}
+
+func TestSendRejectsPrivateAddresses(t *testing.T) {
+	var hits atomic.Int32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	defer receiver.Close()
+
+	sender := NewSender(time.Second, false)
+	for _, url := range []string{
+		receiver.URL,
+		strings.Replace(receiver.URL, "127.0.0.1", "localhost", 1),
+	} {
+		result := sender.Send(context.Background(), testRequest(url))
+		if !errors.Is(result.Err, ErrForbiddenAddress) {
+			t.Fatalf("Send(%s) error = %v, want ErrForbiddenAddress", url, result.Err)
+		}
+	}
+	if hits.Load() != 0 {
+		t.Fatal("request reached a loopback receiver")
+	}
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := map[string]bool{
+		"93.184.216.34":        true,
+		"2606:2800:220:1::248": true,
+		"127.0.0.1":            false,
+		"::1":                  false,
+		"10.1.2.3":             false,
+		"172.16.0.1":           false,
+		"192.168.1.1":          false,
+		"169.254.169.254":      false,
+		"fe80::1":              false,
+		"fd00::1":              false,
+		"100.64.0.1":           false,
+		"0.0.0.0":              false,
+		"::ffff:10.0.0.1":      false,
+		"::ffff:127.0.0.1":     false,
+		"224.0.0.1":            false,
+	}
+	for addr, want := range tests {
+		if got := isPublicAddr(netip.MustParseAddr(addr)); got != want {
+			t.Errorf("isPublicAddr(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
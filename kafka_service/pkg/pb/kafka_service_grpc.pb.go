@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: kafka_service.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EventQueryService_QueryEvents_FullMethodName = "/checklist.EventQueryService/QueryEvents"
+	EventQueryService_CountEvents_FullMethodName = "/checklist.EventQueryService/CountEvents"
+)
+
+// EventQueryServiceClient is the client API for EventQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Запросы к сохраненным событиям задач
+type EventQueryServiceClient interface {
+	// События с фильтрами по пользователю, действию, задаче и времени, новые первыми
+	QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsResponse, error)
+	// Число событий по каждому действию
+	CountEvents(ctx context.Context, in *CountEventsRequest, opts ...grpc.CallOption) (*CountEventsResponse, error)
+}
+
+type eventQueryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventQueryServiceClient(cc grpc.ClientConnInterface) EventQueryServiceClient {
+	return &eventQueryServiceClient{cc}
+}
+
+func (c *eventQueryServiceClient) QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEventsResponse)
+	err := c.cc.Invoke(ctx, EventQueryService_QueryEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventQueryServiceClient) CountEvents(ctx context.Context, in *CountEventsRequest, opts ...grpc.CallOption) (*CountEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountEventsResponse)
+	err := c.cc.Invoke(ctx, EventQueryService_CountEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventQueryServiceServer is the server API for EventQueryService service.
+// All implementations must embed UnimplementedEventQueryServiceServer
+// for forward compatibility.
+//
+// Запросы к сохраненным событиям задач
+type EventQueryServiceServer interface {
+	// События с фильтрами по пользователю, действию, задаче и времени, новые первыми
+	QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsResponse, error)
+	// Число событий по каждому действию
+	CountEvents(context.Context, *CountEventsRequest) (*CountEventsResponse, error)
+	mustEmbedUnimplementedEventQueryServiceServer()
+}
+
+// UnimplementedEventQueryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventQueryServiceServer struct{}
+
+func (UnimplementedEventQueryServiceServer) QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEvents not implemented")
+}
+func (UnimplementedEventQueryServiceServer) CountEvents(context.Context, *CountEventsRequest) (*CountEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountEvents not implemented")
+}
+func (UnimplementedEventQueryServiceServer) mustEmbedUnimplementedEventQueryServiceServer() {}
+func (UnimplementedEventQueryServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeEventQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventQueryServiceServer will
+// result in compilation errors.
+type UnsafeEventQueryServiceServer interface {
+	mustEmbedUnimplementedEventQueryServiceServer()
+}
+
+func RegisterEventQueryServiceServer(s grpc.ServiceRegistrar, srv EventQueryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEventQueryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EventQueryService_ServiceDesc, srv)
+}
+
+func _EventQueryService_QueryEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventQueryServiceServer).QueryEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventQueryService_QueryEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventQueryServiceServer).QueryEvents(ctx, req.(*QueryEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventQueryService_CountEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventQueryServiceServer).CountEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventQueryService_CountEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventQueryServiceServer).CountEvents(ctx, req.(*CountEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventQueryService_ServiceDesc is the grpc.ServiceDesc for EventQueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventQueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "checklist.EventQueryService",
+	HandlerType: (*EventQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryEvents",
+			Handler:    _EventQueryService_QueryEvents_Handler,
+		},
+		{
+			MethodName: "CountEvents",
+			Handler:    _EventQueryService_CountEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kafka_service.proto",
+}